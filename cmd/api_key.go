@@ -0,0 +1,307 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database"
+	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database/pgx"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+)
+
+var (
+	rotateKeyID       string
+	rotateGracePeriod string
+	cidrsKeyID        string
+	cidrsAllowed      []string
+	cidrsClear        bool
+	scopesKeyID       string
+	scopesSet         []string
+	scopesAdd         []string
+)
+
+var apiKeyCmd = &cobra.Command{
+	Use:   "api-key",
+	Short: "Manage API keys",
+	Long:  `Manage existing API keys. Use create-api-key to create a new API key.`,
+}
+
+var apiKeyRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Rotate an API key",
+	Long: `Rotate an API key by issuing a successor key with the same identity, name,
+description, scopes, plan, allowed CIDR ranges and expiration. The new key is
+printed once.
+
+The old key stays valid until the end of the grace period, so clients can
+switch to the new key, and is deactivated afterwards. Without --grace-period
+the AUTH_API_KEY_ROTATION_GRACE_PERIOD setting (default 24h) is used.
+
+Examples:
+  # Rotate an API key with the configured grace period
+  master-data-api api-key rotate --id 3f2b7c1e-8d4a-4e6b-9c1f-2a5d7e9b0c3d
+
+  # Rotate an API key and keep the old key valid for a week
+  master-data-api api-key rotate --id 3f2b7c1e-8d4a-4e6b-9c1f-2a5d7e9b0c3d --grace-period 168h
+
+  # Rotate a leaked API key without a grace period
+  master-data-api api-key rotate --id 3f2b7c1e-8d4a-4e6b-9c1f-2a5d7e9b0c3d --grace-period 0s`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := CheckConfig(cmd); err != nil {
+			return err
+		}
+		return rotateAPIKey()
+	},
+}
+
+var apiKeySetCIDRsCmd = &cobra.Command{
+	Use:   "set-cidrs",
+	Short: "Set the IP ranges an API key may be used from",
+	Long: `Replace the IPv4 and IPv6 ranges an API key may be used from. Requests with
+the key from other addresses are rejected with 403. A single address is a
+range of one address. Use --clear to allow any address again.
+
+Examples:
+  # Allow an API key only from a partner network
+  master-data-api api-key set-cidrs --id 3f2b7c1e-8d4a-4e6b-9c1f-2a5d7e9b0c3d --cidrs 203.0.113.0/24,2001:db8::/32
+
+  # Allow an API key from any address
+  master-data-api api-key set-cidrs --id 3f2b7c1e-8d4a-4e6b-9c1f-2a5d7e9b0c3d --clear`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := CheckConfig(cmd); err != nil {
+			return err
+		}
+		if !cidrsClear && len(cidrsAllowed) == 0 {
+			return fmt.Errorf("either --cidrs or --clear is required")
+		}
+		return setAPIKeyCIDRs()
+	},
+}
+
+var apiKeySetScopesCmd = &cobra.Command{
+	Use:   "set-scopes",
+	Short: "Set the scopes of an API key",
+	Long: `Replace the scopes of an API key with --scopes, or grant additional scopes
+with --add while keeping the scopes the key already holds.
+
+Keys that were listed in AUTH_PRIVILEGED_KEY_IDS before scopes were introduced
+only have the read scopes after upgrading; grant them the write and admin
+scopes they need with --add.
+
+Examples:
+  # Grant a formerly privileged API key write access to banks and translations
+  master-data-api api-key set-scopes --id 3f2b7c1e-8d4a-4e6b-9c1f-2a5d7e9b0c3d --add banks:write,translations:write
+
+  # Restrict an API key to reading banks
+  master-data-api api-key set-scopes --id 3f2b7c1e-8d4a-4e6b-9c1f-2a5d7e9b0c3d --scopes banks:read`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := CheckConfig(cmd); err != nil {
+			return err
+		}
+		if len(scopesSet) == 0 && len(scopesAdd) == 0 {
+			return fmt.Errorf("either --scopes or --add is required")
+		}
+		return setAPIKeyScopes()
+	},
+}
+
+func init() {
+	apiKeyCmd.AddCommand(apiKeyRotateCmd)
+	apiKeyCmd.AddCommand(apiKeySetCIDRsCmd)
+	apiKeyCmd.AddCommand(apiKeySetScopesCmd)
+	rootCmd.AddCommand(apiKeyCmd)
+
+	apiKeyRotateCmd.Flags().StringVar(&rotateKeyID, "id", "", "ID of the API key to rotate (required)")
+	apiKeyRotateCmd.Flags().StringVarP(&rotateGracePeriod, "grace-period", "g", "", "how long the old key stays valid, e.g. 24h (default: AUTH_API_KEY_ROTATION_GRACE_PERIOD)")
+	_ = apiKeyRotateCmd.MarkFlagRequired("id")
+
+	apiKeySetCIDRsCmd.Flags().StringVar(&cidrsKeyID, "id", "", "ID of the API key (required)")
+	apiKeySetCIDRsCmd.Flags().StringSliceVar(&cidrsAllowed, "cidrs", nil, "comma-separated IPv4 and IPv6 ranges, e.g. 203.0.113.0/24,2001:db8::/32")
+	apiKeySetCIDRsCmd.Flags().BoolVar(&cidrsClear, "clear", false, "allow the API key from any address")
+	apiKeySetCIDRsCmd.MarkFlagsMutuallyExclusive("cidrs", "clear")
+	_ = apiKeySetCIDRsCmd.MarkFlagRequired("id")
+
+	apiKeySetScopesCmd.Flags().StringVar(&scopesKeyID, "id", "", "ID of the API key (required)")
+	apiKeySetScopesCmd.Flags().StringSliceVar(&scopesSet, "scopes", nil, "comma-separated scopes replacing those of the key ("+strings.Join(entities.APIKeyScopes, ", ")+")")
+	apiKeySetScopesCmd.Flags().StringSliceVar(&scopesAdd, "add", nil, "comma-separated scopes granted in addition to those of the key")
+	apiKeySetScopesCmd.MarkFlagsMutuallyExclusive("scopes", "add")
+	_ = apiKeySetScopesCmd.MarkFlagRequired("id")
+}
+
+func rotateAPIKey() error {
+	config := GetConfig()
+	log := GetLogger()
+
+	id, err := uuid.Parse(rotateKeyID)
+	if err != nil {
+		return fmt.Errorf("invalid API key ID: %w", err)
+	}
+
+	gracePeriod := config.Auth.APIKeyRotationGracePeriod
+	if rotateGracePeriod != "" {
+		gracePeriod, err = time.ParseDuration(rotateGracePeriod)
+		if err != nil {
+			return fmt.Errorf("invalid grace period. Use a duration (e.g., 24h, 90m): %w", err)
+		}
+	}
+
+	// Initialize database connection
+	dbConnection := database.NewPgxConnectionWithLogger(config.Database, log)
+	if err := dbConnection.Connect(); err != nil {
+		log.WithError(err).Error("Failed to connect to database")
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer dbConnection.Close()
+
+	// Run migrations to ensure the rotation columns exist
+	migrator := database.NewMigrator(config.Database)
+	if err := migrator.RunMigrations("migrations"); err != nil {
+		log.WithError(err).Error("Failed to run migrations")
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	apiKeyService := services.NewAPIKeyService(pgx.NewAPIKeyRepository(dbConnection.GetPool()), config.Auth.APIKeyPepper)
+
+	successor, rotated, err := apiKeyService.RotateAPIKey(context.Background(), id, gracePeriod)
+	if err != nil {
+		log.WithError(err).WithField("api_key_id", id.String()).Error("Failed to rotate API key")
+		return fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	log.WithFields(map[string]interface{}{
+		"api_key_id":          successor.ID.String(),
+		"api_key_identity_id": successor.IdentityID.String(),
+		"rotated_from_id":     rotated.ID.String(),
+		"rotation_deadline":   rotated.RotationDeadline,
+	}).Info("API key rotated successfully")
+
+	fmt.Println("✅ API Key rotated successfully!")
+	fmt.Printf("📝 Name: %s\n", successor.Name)
+	fmt.Printf("🔑 New API Key: %s\n", successor.Key)
+	fmt.Println("⚠️  Store this key now: only its hash is kept and it cannot be shown again")
+	fmt.Printf("🏷️  Prefix: %s\n", successor.KeyPrefix)
+	fmt.Printf("🆔 ID: %s\n", successor.ID.String())
+	fmt.Printf("🪪 Identity: %s\n", successor.IdentityID.String())
+	fmt.Printf("↩️  Rotated from: %s (%s)\n", rotated.ID.String(), rotated.KeyPrefix)
+	fmt.Printf("⏰ Old key valid until: %s\n", rotated.RotationDeadline.Format("2006-01-02 15:04:05 MST"))
+
+	return nil
+}
+
+func setAPIKeyCIDRs() error {
+	config := GetConfig()
+	log := GetLogger()
+
+	id, err := uuid.Parse(cidrsKeyID)
+	if err != nil {
+		return fmt.Errorf("invalid API key ID: %w", err)
+	}
+
+	// Initialize database connection
+	dbConnection := database.NewPgxConnectionWithLogger(config.Database, log)
+	if err := dbConnection.Connect(); err != nil {
+		log.WithError(err).Error("Failed to connect to database")
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer dbConnection.Close()
+
+	// Run migrations to ensure the allowed CIDRs column exists
+	migrator := database.NewMigrator(config.Database)
+	if err := migrator.RunMigrations("migrations"); err != nil {
+		log.WithError(err).Error("Failed to run migrations")
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	apiKeyService := services.NewAPIKeyService(pgx.NewAPIKeyRepository(dbConnection.GetPool()), config.Auth.APIKeyPepper)
+
+	apiKey, err := apiKeyService.GetAPIKeyByID(context.Background(), id)
+	if err != nil {
+		return err
+	}
+
+	apiKey.SetAllowedCIDRs(cidrsAllowed)
+	if err := apiKeyService.UpdateAPIKey(context.Background(), apiKey); err != nil {
+		log.WithError(err).WithField("api_key_id", id.String()).Error("Failed to set allowed CIDRs")
+		return fmt.Errorf("failed to set allowed CIDRs: %w", err)
+	}
+
+	log.WithFields(map[string]interface{}{
+		"api_key_id":    apiKey.ID.String(),
+		"allowed_cidrs": apiKey.AllowedCIDRs,
+	}).Info("API key allowed CIDRs updated")
+
+	fmt.Println("✅ API Key allowed CIDRs updated successfully!")
+	fmt.Printf("📝 Name: %s\n", apiKey.Name)
+	fmt.Printf("🆔 ID: %s\n", apiKey.ID.String())
+	printAllowedCIDRs(apiKey)
+
+	return nil
+}
+
+func setAPIKeyScopes() error {
+	config := GetConfig()
+	log := GetLogger()
+
+	id, err := uuid.Parse(scopesKeyID)
+	if err != nil {
+		return fmt.Errorf("invalid API key ID: %w", err)
+	}
+
+	// Initialize database connection
+	dbConnection := database.NewPgxConnectionWithLogger(config.Database, log)
+	if err := dbConnection.Connect(); err != nil {
+		log.WithError(err).Error("Failed to connect to database")
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer dbConnection.Close()
+
+	// Run migrations to ensure the scopes column exists
+	migrator := database.NewMigrator(config.Database)
+	if err := migrator.RunMigrations("migrations"); err != nil {
+		log.WithError(err).Error("Failed to run migrations")
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	apiKeyService := services.NewAPIKeyService(pgx.NewAPIKeyRepository(dbConnection.GetPool()), config.Auth.APIKeyPepper)
+
+	apiKey, err := apiKeyService.GetAPIKeyByID(context.Background(), id)
+	if err != nil {
+		return err
+	}
+
+	if len(scopesSet) > 0 {
+		apiKey.SetScopes(scopesSet)
+	} else {
+		apiKey.SetScopes(append(apiKey.Scopes, scopesAdd...))
+	}
+	if err := apiKeyService.UpdateAPIKey(context.Background(), apiKey); err != nil {
+		log.WithError(err).WithField("api_key_id", id.String()).Error("Failed to set scopes")
+		return fmt.Errorf("failed to set scopes: %w", err)
+	}
+
+	log.WithFields(map[string]interface{}{
+		"api_key_id": apiKey.ID.String(),
+		"scopes":     apiKey.Scopes,
+	}).Info("API key scopes updated")
+
+	fmt.Println("✅ API Key scopes updated successfully!")
+	fmt.Printf("📝 Name: %s\n", apiKey.Name)
+	fmt.Printf("🆔 ID: %s\n", apiKey.ID.String())
+	fmt.Printf("🔐 Scopes: %s\n", strings.Join(apiKey.Scopes, ", "))
+
+	return nil
+}
+
+// printAllowedCIDRs prints the IP ranges an API key may be used from
+func printAllowedCIDRs(apiKey *entities.APIKey) {
+	if len(apiKey.AllowedCIDRs) == 0 {
+		fmt.Println("🌐 Allowed CIDRs: any address")
+		return
+	}
+	fmt.Printf("🌐 Allowed CIDRs: %s\n", strings.Join(apiKey.AllowedCIDRs, ", "))
+}
@@ -3,11 +3,13 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database"
 	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database/pgx"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/internal/domain/services"
 )
 
@@ -15,6 +17,9 @@ var (
 	keyName        string
 	keyDescription string
 	keyExpires     string
+	keyScopes      []string
+	keyPlan        entities.APIKeyPlan
+	keyCIDRs       []string
 )
 
 // createAPIKeyCmd represents the create-api-key command
@@ -25,7 +30,9 @@ var createAPIKeyCmd = &cobra.Command{
 
 This command generates a secure API key that can be used to authenticate
 requests to the API endpoints. The key can optionally have an expiration
-date and a custom description.
+date, a custom description and scopes. Without --scopes the key gets read
+access to all master data. Only a hash of the key is stored, so the key
+is printed once and cannot be retrieved later.
 
 Examples:
   # Create a basic API key
@@ -35,7 +42,16 @@ Examples:
   master-data-api create-api-key --name "Production Key" --description "API key for production environment"
 
   # Create an API key with expiration (ISO 8601 format)
-  master-data-api create-api-key --expires "2024-12-31T23:59:59Z"`,
+  master-data-api create-api-key --expires "2024-12-31T23:59:59Z"
+
+  # Create an API key that can manage API keys and write banks
+  master-data-api create-api-key --name "Admin Key" --scopes admin:api-keys,banks:write
+
+  # Create an API key that can only be used from a partner network
+  master-data-api create-api-key --name "Partner" --allowed-cidrs 203.0.113.0/24,2001:db8::/32
+
+  # Create an API key with a partner plan and a monthly quota
+  master-data-api create-api-key --name "Partner" --plan partner --requests-per-minute 6000 --burst 500 --quota 1000000 --quota-period month`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := CheckConfig(cmd); err != nil {
 			return err
@@ -52,6 +68,14 @@ func init() {
 	createAPIKeyCmd.Flags().StringVarP(&keyName, "name", "n", "Default API Key", "name for the API key")
 	createAPIKeyCmd.Flags().StringVarP(&keyDescription, "description", "d", "API key for accessing Master Data REST API", "description for the API key")
 	createAPIKeyCmd.Flags().StringVarP(&keyExpires, "expires", "e", "", "expiration date in ISO 8601 format (e.g., 2024-12-31T23:59:59Z)")
+	defaultPlan := entities.DefaultAPIKeyPlan()
+	createAPIKeyCmd.Flags().StringVar(&keyPlan.Name, "plan", defaultPlan.Name, "name of the rate limit plan")
+	createAPIKeyCmd.Flags().IntVar(&keyPlan.RequestsPerMinute, "requests-per-minute", defaultPlan.RequestsPerMinute, "sustained number of requests per minute")
+	createAPIKeyCmd.Flags().IntVar(&keyPlan.Burst, "burst", defaultPlan.Burst, "number of requests allowed at once")
+	createAPIKeyCmd.Flags().Int64Var(&keyPlan.Quota, "quota", defaultPlan.Quota, "number of requests per quota period (0 for no quota)")
+	createAPIKeyCmd.Flags().StringVar(&keyPlan.QuotaPeriod, "quota-period", defaultPlan.QuotaPeriod, "quota period (day or month)")
+	createAPIKeyCmd.Flags().StringSliceVarP(&keyScopes, "scopes", "s", nil, "comma-separated scopes ("+strings.Join(entities.APIKeyScopes, ", ")+")")
+	createAPIKeyCmd.Flags().StringSliceVar(&keyCIDRs, "allowed-cidrs", nil, "comma-separated IPv4 and IPv6 ranges the key may be used from (default: any address)")
 }
 
 func createAPIKey() error {
@@ -82,7 +106,7 @@ func createAPIKey() error {
 
 	// Initialize repository and service
 	apiKeyRepo := pgx.NewAPIKeyRepository(dbConnection.GetPool())
-	apiKeyService := services.NewAPIKeyService(apiKeyRepo)
+	apiKeyService := services.NewAPIKeyService(apiKeyRepo, config.Auth.APIKeyPepper)
 
 	// Parse expiration date if provided
 	var expiresAt *time.Time
@@ -97,12 +121,21 @@ func createAPIKey() error {
 
 	// Create API key
 	log.WithFields(map[string]interface{}{
-		"name":        keyName,
-		"description": keyDescription,
-		"expires_at":  expiresAt,
+		"name":          keyName,
+		"description":   keyDescription,
+		"expires_at":    expiresAt,
+		"scopes":        keyScopes,
+		"plan":          keyPlan.Name,
+		"allowed_cidrs": keyCIDRs,
 	}).Info("Creating API key")
 
-	apiKey, err := apiKeyService.CreateAPIKey(context.Background(), keyName, keyDescription, expiresAt)
+	apiKey, err := apiKeyService.CreateAPIKey(context.Background(), keyName, services.APIKeyOptions{
+		Description:  keyDescription,
+		ExpiresAt:    expiresAt,
+		Scopes:       keyScopes,
+		Plan:         &keyPlan,
+		AllowedCIDRs: keyCIDRs,
+	})
 	if err != nil {
 		log.WithError(err).Error("Failed to create API key")
 		return fmt.Errorf("failed to create API key: %w", err)
@@ -123,7 +156,16 @@ func createAPIKey() error {
 		fmt.Printf("📄 Description: %s\n", *apiKey.Description)
 	}
 	fmt.Printf("🔑 API Key: %s\n", apiKey.Key)
+	fmt.Println("⚠️  Store this key now: only its hash is kept and it cannot be shown again")
+	fmt.Printf("🏷️  Prefix: %s\n", apiKey.KeyPrefix)
 	fmt.Printf("🆔 ID: %s\n", apiKey.ID.String())
+	fmt.Printf("🔐 Scopes: %s\n", strings.Join(apiKey.Scopes, ", "))
+	printAllowedCIDRs(apiKey)
+	fmt.Printf("📈 Plan: %s (%d requests/minute, burst %d", apiKey.Plan.Name, apiKey.Plan.RequestsPerMinute, apiKey.Plan.Burst)
+	if apiKey.Plan.Quota > 0 {
+		fmt.Printf(", %d requests per %s", apiKey.Plan.Quota, apiKey.Plan.QuotaPeriod)
+	}
+	fmt.Println(")")
 	fmt.Printf("✅ Active: %v\n", apiKey.IsActive)
 	fmt.Printf("📅 Created: %s\n", apiKey.CreatedAt.Format("2006-01-02 15:04:05"))
 	if apiKey.ExpiresAt != nil {
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database"
+	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database/pgx"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+)
+
+var (
+	ratesFile   string
+	ratesFormat string
+	ratesSource string
+)
+
+var exchangeRatesCmd = &cobra.Command{
+	Use:   "exchange-rates",
+	Short: "Manage exchange rates",
+	Long:  `Manage the exchange rates used for currency conversion`,
+}
+
+var exchangeRatesImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import exchange rates from a file",
+	Long: `Import exchange rates from a local file. Rates of the same currency pair,
+date and source are replaced. The file is imported in one transaction: when
+a rate cannot be stored, no rate of the file is.
+
+Supported formats:
+  xml  ECB euro foreign exchange reference rates (eurofxref-daily.xml,
+       eurofxref-hist.xml), EUR based
+  csv  ECB CSV files (a Date column followed by one column per currency,
+       EUR based) or one rate per row with the columns base, quote, rate,
+       date (YYYY-MM-DD) and an optional source
+
+The format is detected from the file extension unless --format is given.
+
+Examples:
+  # Import the ECB daily reference rates
+  master-data-api exchange-rates import --file eurofxref-daily.xml
+
+  # Import rates from a CSV file with a custom source
+  master-data-api exchange-rates import --file rates.csv --source "Bank Indonesia"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := CheckConfig(cmd); err != nil {
+			return err
+		}
+		return importExchangeRates()
+	},
+}
+
+func init() {
+	exchangeRatesCmd.AddCommand(exchangeRatesImportCmd)
+	rootCmd.AddCommand(exchangeRatesCmd)
+
+	exchangeRatesImportCmd.Flags().StringVarP(&ratesFile, "file", "f", "", "path to the exchange rates file (required)")
+	exchangeRatesImportCmd.Flags().StringVar(&ratesFormat, "format", "", "file format: xml or csv (default: detected from the file extension)")
+	exchangeRatesImportCmd.Flags().StringVarP(&ratesSource, "source", "s", "", "source of the rates (default: ECB for ECB files)")
+	_ = exchangeRatesImportCmd.MarkFlagRequired("file")
+}
+
+func importExchangeRates() error {
+	config := GetConfig()
+	log := GetLogger()
+
+	format := strings.ToLower(ratesFormat)
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(ratesFile)), ".")
+	}
+	if format != "xml" && format != "csv" {
+		return fmt.Errorf("unsupported exchange rates format '%s'. Use --format xml or --format csv", format)
+	}
+
+	file, err := os.Open(ratesFile)
+	if err != nil {
+		return fmt.Errorf("failed to open exchange rates file: %w", err)
+	}
+	defer file.Close()
+
+	var rates []*entities.ExchangeRate
+	if format == "xml" {
+		rates, err = services.ParseECBXML(file, ratesSource)
+	} else {
+		rates, err = services.ParseExchangeRateCSV(file, ratesSource)
+	}
+	if err != nil {
+		log.WithError(err).Error("Failed to parse exchange rates file")
+		return fmt.Errorf("failed to parse exchange rates file: %w", err)
+	}
+
+	log.WithFields(map[string]interface{}{
+		"file":   ratesFile,
+		"format": format,
+		"rates":  len(rates),
+	}).Info("Exchange rates file parsed")
+
+	// Initialize database connection
+	dbConnection := database.NewPgxConnectionWithLogger(config.Database, log)
+	if err := dbConnection.Connect(); err != nil {
+		log.WithError(err).Error("Failed to connect to database")
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer dbConnection.Close()
+
+	// Run migrations to ensure the exchange rates table exists
+	migrator := database.NewMigrator(config.Database)
+	if err := migrator.RunMigrations("migrations"); err != nil {
+		log.WithError(err).Error("Failed to run migrations")
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	exchangeRateService := services.NewExchangeRateService(
+		pgx.NewExchangeRateRepository(dbConnection.GetPool()),
+		pgx.NewCurrencyRepository(dbConnection.GetPool()),
+	)
+
+	imported, err := exchangeRateService.ImportRates(context.Background(), rates)
+	if err != nil {
+		log.WithError(err).WithField("imported", imported).Error("Failed to import exchange rates")
+		return fmt.Errorf("failed to import exchange rates: %w", err)
+	}
+
+	log.WithField("imported", imported).Info("Exchange rates imported successfully")
+
+	fmt.Printf("✅ Exchange rates imported successfully: %d rates\n", imported)
+	return nil
+}
@@ -25,9 +25,9 @@ var seedCmd = &cobra.Command{
 
 This command can populate the database with initial data for:
 - Geographical data (countries, provinces, cities, districts, villages)
-- Banking information
-- Currency data
-- Language information
+- Banking information (banks, payment network memberships, branches, account number rules) and IBAN formats
+- Currency data and the currencies used in each country
+- Language information and locales
 
 The seeder supports various options for clearing existing data using TRUNCATE
 (for fast bulk deletion) and specifying custom data directories.
@@ -39,8 +39,13 @@ Examples:
   # Seed specific data type
   master-data-api seed --name languages
   master-data-api seed --name banks
+  master-data-api seed --name bank-branches
+  master-data-api seed --name bank-account-rules
   master-data-api seed --name currencies
+  master-data-api seed --name country-currencies
   master-data-api seed --name geodirectories
+  master-data-api seed --name iban-formats
+  master-data-api seed --name locales
 
   # TRUNCATE existing data and seed fresh (fast bulk deletion)
   master-data-api seed --clear
@@ -102,6 +107,15 @@ func runSeeder() error {
 	bankRepo := pgx.NewBankRepository(dbConnection.GetPool())
 	currencyRepo := pgx.NewCurrencyRepository(dbConnection.GetPool())
 	languageRepo := pgx.NewLanguageRepository(dbConnection.GetPool())
+	hierarchySchemaRepo := pgx.NewHierarchySchemaRepository(dbConnection.GetPool())
+	geoTypeRepo := pgx.NewGeoTypeRepository(dbConnection.GetPool())
+	ibanFormatRepo := pgx.NewIBANFormatRepository(dbConnection.GetPool())
+	bankBranchRepo := pgx.NewBankBranchRepository(dbConnection.GetPool())
+	bankAccountRuleRepo := pgx.NewBankAccountRuleRepository(dbConnection.GetPool())
+	paymentNetworkRepo := pgx.NewPaymentNetworkRepository(dbConnection.GetPool())
+	bankNetworkMembershipRepo := pgx.NewBankNetworkMembershipRepository(dbConnection.GetPool())
+	countryCurrencyRepo := pgx.NewCountryCurrencyRepository(dbConnection.GetPool())
+	localeRepo := pgx.NewLocaleRepository(dbConnection.GetPool())
 
 	// Initialize seeder manager
 	seederManager := seeders.NewSeederManager(
@@ -109,6 +123,15 @@ func runSeeder() error {
 		bankRepo,
 		currencyRepo,
 		languageRepo,
+		hierarchySchemaRepo,
+		geoTypeRepo,
+		ibanFormatRepo,
+		bankBranchRepo,
+		bankAccountRuleRepo,
+		paymentNetworkRepo,
+		bankNetworkMembershipRepo,
+		countryCurrencyRepo,
+		localeRepo,
 		log,
 	)
 
@@ -121,7 +144,7 @@ func runSeeder() error {
 
 	log.Info("Successfully created repositories using pgx:")
 	log.WithField("repositories", []string{
-		"Geodirectory", "Bank", "Currency", "Language",
+		"Geodirectory", "HierarchySchema", "GeoType", "Bank", "Currency", "CountryCurrency", "Language", "Locale", "IBANFormat", "BankBranch",
 	}).Info("All repositories initialized with pgx driver")
 
 	// Clear data if requested using TRUNCATE for efficient bulk deletion
@@ -23,6 +23,9 @@
 // @tag.name geodirectories
 // @tag.description Operations for managing geographical directories (countries, provinces, cities, districts, villages)
 //
+// @tag.name geo-types
+// @tag.description Registry of geodirectory types with their default depth and hierarchy rank
+//
 // @tag.name banks
 // @tag.description Operations for managing bank information
 //
@@ -37,6 +40,12 @@
 package cmd
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/turahe/master-data-rest-api/internal/adapters/primary/http"
 	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database"
@@ -53,6 +62,9 @@ var (
 	serverPort string
 )
 
+// shutdownTimeout is how long the server waits for active requests to finish when it is stopped
+const shutdownTimeout = 10 * time.Second
+
 // serveCmd represents the serve command
 var serveCmd = &cobra.Command{
 	Use:   "serve",
@@ -89,6 +101,10 @@ func runServer(cmd *cobra.Command) error {
 
 	log.WithField("app", config.App.Name).Info("Starting application")
 
+	if os.Getenv("AUTH_PRIVILEGED_KEY_IDS") != "" {
+		log.Warn("AUTH_PRIVILEGED_KEY_IDS is no longer used; grant API keys write scopes with 'api-key set-scopes' instead")
+	}
+
 	// Override config with flags if provided
 	if serverHost != "" {
 		config.Server.Host = serverHost
@@ -123,9 +139,22 @@ func runServer(cmd *cobra.Command) error {
 	log.Info("Initializing repositories")
 	geodirectoryRepo := pgx.NewGeodirectoryRepository(dbConnection.GetPool())
 	apiKeyRepo := pgx.NewAPIKeyRepository(dbConnection.GetPool())
+	apiKeyUsageRepo := pgx.NewAPIKeyUsageRepository(dbConnection.GetPool())
 	bankRepo := pgx.NewBankRepository(dbConnection.GetPool())
 	currencyRepo := pgx.NewCurrencyRepository(dbConnection.GetPool())
 	languageRepo := pgx.NewLanguageRepository(dbConnection.GetPool())
+	hierarchySchemaRepo := pgx.NewHierarchySchemaRepository(dbConnection.GetPool())
+	geoTypeRepo := pgx.NewGeoTypeRepository(dbConnection.GetPool())
+	ibanFormatRepo := pgx.NewIBANFormatRepository(dbConnection.GetPool())
+	bankBranchRepo := pgx.NewBankBranchRepository(dbConnection.GetPool())
+	bankAccountRuleRepo := pgx.NewBankAccountRuleRepository(dbConnection.GetPool())
+	paymentNetworkRepo := pgx.NewPaymentNetworkRepository(dbConnection.GetPool())
+	bankNetworkMembershipRepo := pgx.NewBankNetworkMembershipRepository(dbConnection.GetPool())
+	bankHistoryRepo := pgx.NewBankHistoryRepository(dbConnection.GetPool())
+	exchangeRateRepo := pgx.NewExchangeRateRepository(dbConnection.GetPool())
+	countryCurrencyRepo := pgx.NewCountryCurrencyRepository(dbConnection.GetPool())
+	localeRepo := pgx.NewLocaleRepository(dbConnection.GetPool())
+	translationRepo := pgx.NewTranslationRepository(dbConnection.GetPool())
 
 	// Initialize search service
 	log.Info("Initializing search service")
@@ -137,31 +166,152 @@ func runServer(cmd *cobra.Command) error {
 
 	// Initialize services
 	log.Info("Initializing services")
-	geodirectoryService := services.NewGeodirectoryService(geodirectoryRepo)
-	apiKeyService := services.NewAPIKeyService(apiKeyRepo)
+	geodirectoryService := services.NewGeodirectoryService(geodirectoryRepo, hierarchySchemaRepo)
+	apiKeyService := services.NewAPIKeyService(apiKeyRepo, config.Auth.APIKeyPepper)
+	apiKeyUsageService := services.NewAPIKeyUsageService(apiKeyUsageRepo)
 	bankService := services.NewBankService(bankRepo)
+	bankLifecycleService := services.NewBankLifecycleService(bankRepo, bankHistoryRepo)
 	currencyService := services.NewCurrencyService(currencyRepo)
 	languageService := services.NewLanguageService(languageRepo)
+	geoTypeService := services.NewGeoTypeService(geoTypeRepo)
+	ibanService := services.NewIBANService(ibanFormatRepo, geodirectoryRepo, bankRepo)
+	bankBranchService := services.NewBankBranchService(bankBranchRepo, bankRepo, geodirectoryRepo)
+	bankAccountRuleService := services.NewBankAccountRuleService(bankAccountRuleRepo, bankRepo)
+	paymentNetworkService := services.NewPaymentNetworkService(paymentNetworkRepo, bankNetworkMembershipRepo, bankRepo)
+	exchangeRateService := services.NewExchangeRateService(exchangeRateRepo, currencyRepo)
+	countryCurrencyService := services.NewCountryCurrencyService(countryCurrencyRepo, geodirectoryRepo, currencyRepo)
+	localeService := services.NewLocaleService(localeRepo, currencyRepo)
+	translationService := services.NewTranslationService(translationRepo, languageRepo)
+
+	// Background jobs run until the server is stopped with SIGINT or SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Deactivate rotated API keys once their grace period ends
+	go deactivateRotatedAPIKeys(ctx, apiKeyService, time.Minute)
+
+	// Store the counted API key usage
+	usageFlushStopped := make(chan struct{})
+	go func() {
+		defer close(usageFlushStopped)
+		flushAPIKeyUsage(ctx, apiKeyUsageService, config.Auth.APIKeyUsageFlushInterval)
+	}()
+
+	// Load the geo types registry so type validation reflects the database
+	if err := geoTypeService.LoadRegistry(context.Background()); err != nil {
+		log.WithError(err).Warn("Failed to load geo types registry, using built-in types")
+	}
 
 	// Initialize handlers
 	log.Info("Initializing HTTP handlers")
-	geodirectoryHandler := http.NewGeodirectoryHTTPHandler(geodirectoryService, searchService)
-	apiKeyHandler := http.NewAPIKeyHTTPHandler(apiKeyService)
-	bankHandler := http.NewBankHTTPHandler(bankService, searchService)
-	currencyHandler := http.NewCurrencyHTTPHandler(currencyService, searchService)
-	languageHandler := http.NewLanguageHTTPHandler(languageService, searchService)
+	localizer := http.NewLocalizer(translationService)
+	geodirectoryHandler := http.NewGeodirectoryHTTPHandler(geodirectoryService, searchService, localizer)
+	apiKeyHandler := http.NewAPIKeyHTTPHandler(apiKeyService, apiKeyUsageService, config.Auth.APIKeyRotationGracePeriod)
+	bankHandler := http.NewBankHTTPHandler(bankService, bankLifecycleService, searchService, localizer)
+	currencyHandler := http.NewCurrencyHTTPHandler(currencyService, searchService, localizer)
+	languageHandler := http.NewLanguageHTTPHandler(languageService, searchService, localizer)
+	geoTypeHandler := http.NewGeoTypeHTTPHandler(geoTypeService)
+	ibanHandler := http.NewIBANHTTPHandler(ibanService)
+	bankBranchHandler := http.NewBankBranchHTTPHandler(bankBranchService, searchService)
+	bankAccountRuleHandler := http.NewBankAccountRuleHTTPHandler(bankAccountRuleService)
+	paymentNetworkHandler := http.NewPaymentNetworkHTTPHandler(paymentNetworkService)
+	exchangeRateHandler := http.NewExchangeRateHTTPHandler(exchangeRateService)
+	countryCurrencyHandler := http.NewCountryCurrencyHTTPHandler(countryCurrencyService)
+	localeHandler := http.NewLocaleHTTPHandler(localeService)
+	translationHandler := http.NewTranslationHTTPHandler(translationService)
 
 	// Setup router
-	app := http.SetupRouter(config, log, geodirectoryHandler, apiKeyHandler, bankHandler, currencyHandler, languageHandler, apiKeyService, geodirectoryService)
+	app := http.SetupRouter(config, log, geodirectoryHandler, apiKeyHandler, bankHandler, currencyHandler, languageHandler, geoTypeHandler, ibanHandler, bankBranchHandler, bankAccountRuleHandler, paymentNetworkHandler, exchangeRateHandler, countryCurrencyHandler, localeHandler, translationHandler, apiKeyService, apiKeyUsageService, geodirectoryService)
 
 	// Start server
 	port := ":" + config.Server.Port
 	log.WithField("port", config.Server.Port).Info("Starting HTTP server")
 
-	if err := app.Listen(port); err != nil {
-		log.WithError(err).Fatal("Failed to start HTTP server")
-		return err
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- app.Listen(port)
+	}()
+
+	var err error
+	select {
+	case err = <-listenErr:
+		if err != nil {
+			log.WithError(err).Error("Failed to start HTTP server")
+		}
+	case <-ctx.Done():
+		log.Info("Shutting down HTTP server")
+		if shutdownErr := app.ShutdownWithTimeout(shutdownTimeout); shutdownErr != nil {
+			log.WithError(shutdownErr).Error("Failed to shut down HTTP server gracefully")
+		}
+	}
+
+	// Store the usage counted since the last flush, including that of the requests finished during shutdown
+	stop()
+	<-usageFlushStopped
+	storeAPIKeyUsage(apiKeyUsageService)
+	log.Info("HTTP server stopped")
+
+	return err
+}
+
+// deactivateRotatedAPIKeys deactivates rotated API keys past their rotation deadline at every interval until
+// ctx is done
+func deactivateRotatedAPIKeys(ctx context.Context, apiKeyService *services.APIKeyService, interval time.Duration) {
+	log := GetLogger()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		deactivated, err := apiKeyService.DeactivateRotatedAPIKeys(ctx)
+		if err != nil {
+			log.WithError(err).Error("Failed to deactivate rotated API keys")
+			continue
+		}
+		if deactivated > 0 {
+			log.WithField("deactivated", deactivated).Info("Deactivated rotated API keys")
+		}
+	}
+}
+
+// flushAPIKeyUsage stores the API key usage counted in memory at every interval until ctx is done
+func flushAPIKeyUsage(ctx context.Context, apiKeyUsageService *services.APIKeyUsageService, interval time.Duration) {
+	log := GetLogger()
+	if interval <= 0 {
+		interval = 10 * time.Second
 	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-	return nil
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// A flush in progress is finished rather than cancelled so that its counts are not lost on shutdown
+		if _, err := apiKeyUsageService.Flush(context.Background()); err != nil {
+			log.WithError(err).Error("Failed to store API key usage")
+		}
+	}
+}
+
+// storeAPIKeyUsage stores the API key usage still counted in memory when the server stops
+func storeAPIKeyUsage(apiKeyUsageService *services.APIKeyUsageService) {
+	log := GetLogger()
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	stored, err := apiKeyUsageService.Flush(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to store API key usage on shutdown")
+		return
+	}
+	log.WithField("requests", stored).Info("Stored API key usage")
 }
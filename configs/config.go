@@ -49,13 +49,18 @@ type DatabaseConfig struct {
 
 // ServerConfig holds server configuration
 type ServerConfig struct {
-	Host string
-	Port string
+	Host           string
+	Port           string
+	ProxyHeader    string   // Header with the client IP set by a reverse proxy, e.g. X-Forwarded-For
+	TrustedProxies []string // IPs and CIDR ranges of the proxies whose ProxyHeader is trusted
 }
 
 // AuthConfig holds authentication configuration
 type AuthConfig struct {
-	Required bool // Whether API key authentication is required
+	Required                  bool          // Whether API key authentication is required
+	APIKeyPepper              string        // Secret mixed into API key hashes (HMAC-SHA256); empty stores plain SHA-256 hashes
+	APIKeyRotationGracePeriod time.Duration // How long a rotated API key stays valid next to its successor
+	APIKeyUsageFlushInterval  time.Duration // How often the API key usage counted in memory is stored
 }
 
 // LoggingConfig holds logging configuration
@@ -113,11 +118,16 @@ func Load() *Config {
 			LogSlowQuery: getEnvAsDuration("DB_LOG_SLOW_QUERY", 100*time.Millisecond),
 		},
 		Server: ServerConfig{
-			Host: getEnv("SERVER_HOST", "0.0.0.0"),
-			Port: getEnv("SERVER_PORT", "8080"),
+			Host:           getEnv("SERVER_HOST", "0.0.0.0"),
+			Port:           getEnv("SERVER_PORT", "8080"),
+			ProxyHeader:    getEnv("SERVER_PROXY_HEADER", ""),
+			TrustedProxies: getEnvAsSlice("SERVER_TRUSTED_PROXIES", nil),
 		},
 		Auth: AuthConfig{
-			Required: getEnvAsBool("AUTH_REQUIRED", false),
+			Required:                  getEnvAsBool("AUTH_REQUIRED", false),
+			APIKeyPepper:              getEnv("AUTH_API_KEY_PEPPER", ""),
+			APIKeyRotationGracePeriod: getEnvAsDuration("AUTH_API_KEY_ROTATION_GRACE_PERIOD", 24*time.Hour),
+			APIKeyUsageFlushInterval:  getEnvAsDuration("AUTH_API_KEY_USAGE_FLUSH_INTERVAL", 10*time.Second),
 		},
 		Logging: LoggingConfig{
 			Level:  getEnv("LOG_LEVEL", "info"),
@@ -186,3 +196,19 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 		return defaultValue
 	}
 }
+
+// getEnvAsSlice gets a comma-separated environment variable as a slice or returns a default value
+func getEnvAsSlice(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var values []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
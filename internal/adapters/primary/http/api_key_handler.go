@@ -2,30 +2,38 @@ package http
 
 import (
 	"context"
+	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/internal/domain/services"
 	"github.com/turahe/master-data-rest-api/pkg/response"
 )
 
 // APIKeyHTTPHandler handles HTTP requests for API key operations
 type APIKeyHTTPHandler struct {
-	apiKeyService *services.APIKeyService
+	apiKeyService       *services.APIKeyService
+	apiKeyUsageService  *services.APIKeyUsageService
+	rotationGracePeriod time.Duration
 }
 
-// NewAPIKeyHTTPHandler creates a new APIKeyHTTPHandler instance
-func NewAPIKeyHTTPHandler(apiKeyService *services.APIKeyService) *APIKeyHTTPHandler {
+// NewAPIKeyHTTPHandler creates a new APIKeyHTTPHandler instance. Rotated API keys stay valid for the
+// rotation grace period unless a rotation request asks for another one.
+func NewAPIKeyHTTPHandler(apiKeyService *services.APIKeyService, apiKeyUsageService *services.APIKeyUsageService, rotationGracePeriod time.Duration) *APIKeyHTTPHandler {
 	return &APIKeyHTTPHandler{
-		apiKeyService: apiKeyService,
+		apiKeyService:       apiKeyService,
+		apiKeyUsageService:  apiKeyUsageService,
+		rotationGracePeriod: rotationGracePeriod,
 	}
 }
 
 // CreateAPIKey handles POST /api/v1/api-keys
 // @Summary Create a new API key
-// @Description Create a new API key with the provided information
+// @Description Create a new API key with the provided information. The key is returned only in this response; afterwards only its key_prefix is shown. Keys created without scopes get read access to all master data (geo:read, banks:read, currencies:read, languages:read, translations:read); keys created without a plan get the standard plan of 1000 requests per minute with bursts of 100 and no quota. Keys with allowed_cidrs can only be used from those IPv4 and IPv6 ranges. Requires the admin:api-keys scope.
 // @Tags api-keys
 // @Accept json
 // @Produce json
@@ -33,6 +41,7 @@ func NewAPIKeyHTTPHandler(apiKeyService *services.APIKeyService) *APIKeyHTTPHand
 // @Success 201 {object} response.Response "API key created successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Security ApiKeyAuth
 // @Router /api/v1/api-keys [post]
@@ -51,9 +60,15 @@ func (h *APIKeyHTTPHandler) CreateAPIKey(c *fiber.Ctx) error {
 		expiresAt = &parsed
 	}
 
-	apiKey, err := h.apiKeyService.CreateAPIKey(context.Background(), req.Name, req.Description, expiresAt)
+	apiKey, err := h.apiKeyService.CreateAPIKey(context.Background(), req.Name, services.APIKeyOptions{
+		Description:  req.Description,
+		ExpiresAt:    expiresAt,
+		Scopes:       req.Scopes,
+		Plan:         req.Plan,
+		AllowedCIDRs: req.AllowedCIDRs,
+	})
 	if err != nil {
-		return response.InternalServerError(c, "Failed to create API key: "+err.Error())
+		return h.writeError(c, err, "Failed to create API key: ")
 	}
 
 	return response.Created(c, apiKey, "API key created successfully")
@@ -68,6 +83,7 @@ func (h *APIKeyHTTPHandler) CreateAPIKey(c *fiber.Ctx) error {
 // @Param offset query int false "Offset" default(0)
 // @Success 200 {object} response.Response "API keys retrieved successfully"
 // @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Security ApiKeyAuth
 // @Router /api/v1/api-keys [get]
@@ -92,6 +108,7 @@ func (h *APIKeyHTTPHandler) GetAllAPIKeys(c *fiber.Ctx) error {
 // @Success 200 {object} response.Response "API key retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
 // @Failure 404 {object} response.Response "API key not found"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Security ApiKeyAuth
@@ -113,7 +130,7 @@ func (h *APIKeyHTTPHandler) GetAPIKeyByID(c *fiber.Ctx) error {
 
 // UpdateAPIKey handles PUT /api/v1/api-keys/:id
 // @Summary Update an API key
-// @Description Update an existing API key
+// @Description Update an existing API key. Scopes, plan and allowed_cidrs, when given, replace the scopes, rate limit plan and allowed CIDR ranges of the key; an empty allowed_cidrs list allows any address. Requires the admin:api-keys scope.
 // @Tags api-keys
 // @Accept json
 // @Produce json
@@ -122,6 +139,7 @@ func (h *APIKeyHTTPHandler) GetAPIKeyByID(c *fiber.Ctx) error {
 // @Success 200 {object} response.Response "API key updated successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
 // @Failure 404 {object} response.Response "API key not found"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Security ApiKeyAuth
@@ -162,8 +180,18 @@ func (h *APIKeyHTTPHandler) UpdateAPIKey(c *fiber.Ctx) error {
 		}
 	}
 
+	if req.Scopes != nil {
+		apiKey.SetScopes(*req.Scopes)
+	}
+	if req.Plan != nil {
+		apiKey.Plan = *req.Plan
+	}
+	if req.AllowedCIDRs != nil {
+		apiKey.SetAllowedCIDRs(*req.AllowedCIDRs)
+	}
+
 	if err := h.apiKeyService.UpdateAPIKey(context.Background(), apiKey); err != nil {
-		return response.InternalServerError(c, "Failed to update API key: "+err.Error())
+		return h.writeError(c, err, "Failed to update API key: ")
 	}
 
 	return response.Success(c, apiKey, "API key updated successfully")
@@ -178,6 +206,7 @@ func (h *APIKeyHTTPHandler) UpdateAPIKey(c *fiber.Ctx) error {
 // @Success 200 {object} response.Response "API key activated successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
 // @Failure 404 {object} response.Response "API key not found"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Security ApiKeyAuth
@@ -205,6 +234,7 @@ func (h *APIKeyHTTPHandler) ActivateAPIKey(c *fiber.Ctx) error {
 // @Success 200 {object} response.Response "API key deactivated successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
 // @Failure 404 {object} response.Response "API key not found"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Security ApiKeyAuth
@@ -223,6 +253,140 @@ func (h *APIKeyHTTPHandler) DeactivateAPIKey(c *fiber.Ctx) error {
 	return response.Success(c, nil, "API key deactivated successfully")
 }
 
+// RotateAPIKey handles POST /api/v1/api-keys/:id/rotate
+// @Summary Rotate an API key
+// @Description Issue a successor of an API key with the same identity, name, description, scopes, plan, allowed CIDR ranges and expiration. The new key is returned only in this response. The rotated key stays valid until the end of the grace period (AUTH_API_KEY_ROTATION_GRACE_PERIOD unless grace_period is given) and is deactivated afterwards. Requires the admin:api-keys scope.
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Param id path string true "API Key ID (UUID)"
+// @Param request body RotateAPIKeyRequest false "Rotation options"
+// @Success 201 {object} response.Response "API key rotated successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "API key not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/api-keys/{id}/rotate [post]
+func (h *APIKeyHTTPHandler) RotateAPIKey(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return response.BadRequest(c, "Invalid API key ID: "+err.Error())
+	}
+
+	var req RotateAPIKeyRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return response.BadRequest(c, "Invalid request body: "+err.Error())
+		}
+	}
+
+	gracePeriod := h.rotationGracePeriod
+	if req.GracePeriod != "" {
+		gracePeriod, err = time.ParseDuration(req.GracePeriod)
+		if err != nil {
+			return response.BadRequest(c, "Invalid grace_period format. Use a duration (e.g., 24h, 90m)")
+		}
+	}
+
+	successor, rotated, err := h.apiKeyService.RotateAPIKey(context.Background(), id, gracePeriod)
+	if err != nil {
+		return h.writeError(c, err, "Failed to rotate API key: ")
+	}
+
+	return response.Created(c, map[string]interface{}{
+		"api_key":         successor,
+		"rotated_api_key": rotated,
+	}, "API key rotated successfully")
+}
+
+// GetAPIKeyHistory handles GET /api/v1/api-keys/:id/history
+// @Summary Get the rotation history of an API key
+// @Description Get an API key together with the keys it was rotated from and into, oldest first. The keys share an identity_id. Requires the admin:api-keys scope.
+// @Tags api-keys
+// @Produce json
+// @Param id path string true "API Key ID (UUID)"
+// @Success 200 {object} response.Response "API key history retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "API key not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/api-keys/{id}/history [get]
+func (h *APIKeyHTTPHandler) GetAPIKeyHistory(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return response.BadRequest(c, "Invalid API key ID: "+err.Error())
+	}
+
+	history, err := h.apiKeyService.GetAPIKeyHistory(context.Background(), id)
+	if err != nil {
+		return h.writeError(c, err, "Failed to retrieve API key history: ")
+	}
+
+	return response.Success(c, history, "API key history retrieved successfully")
+}
+
+// GetAPIKeyUsage handles GET /api/v1/api-keys/:id/usage
+// @Summary Get the usage of an API key
+// @Description Get the number of requests made with an API key per hour or day, route and status class (2xx, 4xx, ...) for the periods starting from from until before to, with totals per status class and route. Periods are in UTC; requests are counted in memory and stored every AUTH_API_KEY_USAGE_FLUSH_INTERVAL, so the latest requests may not be included yet. At most 31 days of hourly or 366 days of daily usage can be requested. Requires the admin:api-keys scope.
+// @Tags api-keys
+// @Produce json
+// @Param id path string true "API Key ID (UUID)"
+// @Param from query string false "Start of the range, RFC 3339 or YYYY-MM-DD (default: 24 hours or 30 days before to)"
+// @Param to query string false "End of the range (exclusive), RFC 3339 or YYYY-MM-DD (default: now)"
+// @Param granularity query string false "hour or day" default(hour)
+// @Success 200 {object} response.Response "API key usage retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "API key not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/api-keys/{id}/usage [get]
+func (h *APIKeyHTTPHandler) GetAPIKeyUsage(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return response.BadRequest(c, "Invalid API key ID: "+err.Error())
+	}
+
+	from, err := parseUsageTime(c.Query("from"))
+	if err != nil {
+		return response.BadRequest(c, "Invalid from format. Use RFC 3339 (e.g., 2024-01-31T00:00:00Z) or YYYY-MM-DD")
+	}
+	to, err := parseUsageTime(c.Query("to"))
+	if err != nil {
+		return response.BadRequest(c, "Invalid to format. Use RFC 3339 (e.g., 2024-01-31T00:00:00Z) or YYYY-MM-DD")
+	}
+
+	if _, err := h.apiKeyService.GetAPIKeyByID(context.Background(), id); err != nil {
+		return h.writeError(c, err, "Failed to retrieve API key usage: ")
+	}
+
+	report, err := h.apiKeyUsageService.GetUsage(context.Background(), id, strings.ToLower(c.Query("granularity")), from, to)
+	if err != nil {
+		return h.writeError(c, err, "Failed to retrieve API key usage: ")
+	}
+
+	return response.Success(c, report, "API key usage retrieved successfully")
+}
+
+// parseUsageTime parses an RFC 3339 time or a YYYY-MM-DD date in UTC; an empty value is the zero time
+func parseUsageTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if parsed, err := time.Parse(time.DateOnly, value); err == nil {
+		return parsed, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
 // DeleteAPIKey handles DELETE /api/v1/api-keys/:id
 // @Summary Delete an API key
 // @Description Soft delete an API key
@@ -232,6 +396,7 @@ func (h *APIKeyHTTPHandler) DeactivateAPIKey(c *fiber.Ctx) error {
 // @Success 200 {object} response.Response "API key deleted successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
 // @Failure 404 {object} response.Response "API key not found"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Security ApiKeyAuth
@@ -250,16 +415,37 @@ func (h *APIKeyHTTPHandler) DeleteAPIKey(c *fiber.Ctx) error {
 	return response.Success(c, nil, "API key deleted successfully")
 }
 
+// writeError maps API key service errors to HTTP responses
+func (h *APIKeyHTTPHandler) writeError(c *fiber.Ctx, err error, prefix string) error {
+	if errors.Is(err, services.ErrInvalidInput) {
+		return response.BadRequest(c, err.Error())
+	}
+	if errors.Is(err, services.ErrNotFound) {
+		return response.NotFound(c, err.Error())
+	}
+	return response.InternalServerError(c, prefix+err.Error())
+}
+
 // Request/Response DTOs
 
 type CreateAPIKeyRequest struct {
-	Name        string `json:"name" validate:"required"`
-	Description string `json:"description,omitempty"`
-	ExpiresAt   string `json:"expires_at,omitempty"` // ISO 8601 format
+	Name         string               `json:"name" validate:"required"`
+	Description  string               `json:"description,omitempty"`
+	ExpiresAt    string               `json:"expires_at,omitempty"` // ISO 8601 format
+	Scopes       []string             `json:"scopes,omitempty"`
+	Plan         *entities.APIKeyPlan `json:"plan,omitempty"`
+	AllowedCIDRs []string             `json:"allowed_cidrs,omitempty"` // IPv4 and IPv6 ranges, e.g. 203.0.113.0/24
 }
 
 type UpdateAPIKeyRequest struct {
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
-	ExpiresAt   *string `json:"expires_at,omitempty"` // ISO 8601 format
+	Name         *string              `json:"name,omitempty"`
+	Description  *string              `json:"description,omitempty"`
+	ExpiresAt    *string              `json:"expires_at,omitempty"` // ISO 8601 format
+	Scopes       *[]string            `json:"scopes,omitempty"`
+	Plan         *entities.APIKeyPlan `json:"plan,omitempty"`
+	AllowedCIDRs *[]string            `json:"allowed_cidrs,omitempty"`
+}
+
+type RotateAPIKeyRequest struct {
+	GracePeriod string `json:"grace_period,omitempty"` // Duration such as 24h; defaults to AUTH_API_KEY_ROTATION_GRACE_PERIOD
 }
@@ -0,0 +1,168 @@
+package http
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/pkg/response"
+)
+
+// BankAccountRuleHTTPHandler handles HTTP requests for bank account number rules and account validation
+type BankAccountRuleHTTPHandler struct {
+	ruleService *services.BankAccountRuleService
+}
+
+// NewBankAccountRuleHTTPHandler creates a new BankAccountRuleHTTPHandler instance
+func NewBankAccountRuleHTTPHandler(ruleService *services.BankAccountRuleService) *BankAccountRuleHTTPHandler {
+	return &BankAccountRuleHTTPHandler{
+		ruleService: ruleService,
+	}
+}
+
+// ValidateAccount handles POST /api/v1/banks/:code/validate-account
+// @Summary Validate a bank account number
+// @Description Validate an account number against the rules of a bank: allowed lengths, digits only, prefix patterns and check digit. Spaces, dashes and dots are ignored.
+// @Tags bank-account-rules
+// @Accept json
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Param request body ValidateBankAccountRequest true "Account number to validate"
+// @Success 200 {object} response.Response "Account number validated, see the valid flag and errors"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Bank or account number rules not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/validate-account [post]
+func (h *BankAccountRuleHTTPHandler) ValidateAccount(c *fiber.Ctx) error {
+	var req ValidateBankAccountRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
+
+	if strings.TrimSpace(req.AccountNumber) == "" {
+		return response.BadRequest(c, "Account number is required")
+	}
+
+	result, err := h.ruleService.ValidateAccount(c.Context(), c.Params("code"), req.AccountNumber)
+	if err != nil {
+		return h.writeError(c, err, "Failed to validate account number: ")
+	}
+
+	if !result.Valid {
+		return response.Success(c, result, "Account number is invalid")
+	}
+
+	return response.Success(c, result, "Account number is valid")
+}
+
+// GetAccountRule handles GET /api/v1/banks/:code/account-rules
+// @Summary Get account number rules of a bank
+// @Description Get the account number rules of a bank
+// @Tags bank-account-rules
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Success 200 {object} response.Response "Bank account rules retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Bank or account number rules not found"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/account-rules [get]
+func (h *BankAccountRuleHTTPHandler) GetAccountRule(c *fiber.Ctx) error {
+	rule, err := h.ruleService.GetRule(c.Context(), c.Params("code"))
+	if err != nil {
+		return h.writeError(c, err, "Failed to retrieve bank account rules: ")
+	}
+
+	return response.Success(c, rule, "Bank account rules retrieved successfully")
+}
+
+// SaveAccountRule handles PUT /api/v1/banks/:code/account-rules
+// @Summary Create or replace account number rules of a bank
+// @Description Create or replace the account number rules of a bank. Prefix patterns are regular expressions matched against the start of the account number. Requires the banks:write scope.
+// @Tags bank-account-rules
+// @Accept json
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Param request body SaveBankAccountRuleRequest true "Account number rules"
+// @Success 200 {object} response.Response "Bank account rules updated successfully"
+// @Success 201 {object} response.Response "Bank account rules created successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "Bank not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/account-rules [put]
+func (h *BankAccountRuleHTTPHandler) SaveAccountRule(c *fiber.Ctx) error {
+	var req SaveBankAccountRuleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
+
+	digitsOnly := true
+	if req.DigitsOnly != nil {
+		digitsOnly = *req.DigitsOnly
+	}
+
+	rule, created, err := h.ruleService.SaveRule(
+		c.Context(), c.Params("code"),
+		req.Lengths, digitsOnly, strings.TrimSpace(req.CheckDigitAlgorithm), req.PrefixPatterns,
+	)
+	if err != nil {
+		return h.writeError(c, err, "Failed to save bank account rules: ")
+	}
+
+	if created {
+		return response.Created(c, rule, "Bank account rules created successfully")
+	}
+
+	return response.Success(c, rule, "Bank account rules updated successfully")
+}
+
+// DeleteAccountRule handles DELETE /api/v1/banks/:code/account-rules
+// @Summary Delete account number rules of a bank
+// @Description Delete the account number rules of a bank. Requires the banks:write scope.
+// @Tags bank-account-rules
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Success 200 {object} response.Response "Bank account rules deleted successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "Bank or account number rules not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/account-rules [delete]
+func (h *BankAccountRuleHTTPHandler) DeleteAccountRule(c *fiber.Ctx) error {
+	if err := h.ruleService.DeleteRule(c.Context(), c.Params("code")); err != nil {
+		return h.writeError(c, err, "Failed to delete bank account rules: ")
+	}
+
+	return response.Success(c, nil, "Bank account rules deleted successfully")
+}
+
+// writeError maps bank account rule service errors to HTTP responses
+func (h *BankAccountRuleHTTPHandler) writeError(c *fiber.Ctx, err error, prefix string) error {
+	switch {
+	case errors.Is(err, services.ErrNotFound):
+		return response.NotFound(c, err.Error())
+	case errors.Is(err, services.ErrInvalidInput):
+		return response.BadRequest(c, err.Error())
+	default:
+		return response.InternalServerError(c, prefix+err.Error())
+	}
+}
+
+// Request/Response DTOs
+
+type ValidateBankAccountRequest struct {
+	AccountNumber string `json:"account_number" validate:"required"`
+}
+
+type SaveBankAccountRuleRequest struct {
+	Lengths             []int    `json:"lengths,omitempty"`
+	DigitsOnly          *bool    `json:"digits_only,omitempty"`
+	CheckDigitAlgorithm string   `json:"check_digit_algorithm,omitempty"`
+	PrefixPatterns      []string `json:"prefix_patterns,omitempty"`
+}
@@ -0,0 +1,301 @@
+package http
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+	"github.com/turahe/master-data-rest-api/pkg/response"
+)
+
+// BankBranchHTTPHandler handles HTTP requests for bank branch operations
+type BankBranchHTTPHandler struct {
+	branchService *services.BankBranchService
+	searchService repositories.SearchRepository
+}
+
+// NewBankBranchHTTPHandler creates a new BankBranchHTTPHandler instance
+func NewBankBranchHTTPHandler(branchService *services.BankBranchService, searchService repositories.SearchRepository) *BankBranchHTTPHandler {
+	return &BankBranchHTTPHandler{
+		branchService: branchService,
+		searchService: searchService,
+	}
+}
+
+// GetBranches handles GET /api/v1/banks/:code/branches
+// @Summary Get branches of a bank
+// @Description Get the branches of a bank with pagination. With city_id, only branches located in that geodirectory node or any of its descendants (e.g. districts of the city) are returned.
+// @Tags bank-branches
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Param city_id query string false "Geodirectory ID (UUID) of the city or any other node to filter by"
+// @Param limit query int false "Limit" default(50)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} response.Response "Bank branches retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Bank not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/branches [get]
+func (h *BankBranchHTTPHandler) GetBranches(c *fiber.Ctx) error {
+	code := c.Params("code")
+	limit, _ := strconv.Atoi(c.Query("limit", "50"))
+	offset, _ := strconv.Atoi(c.Query("offset", "0"))
+
+	var geodirectoryID *uuid.UUID
+	if cityID := c.Query("city_id"); cityID != "" {
+		id, err := uuid.Parse(cityID)
+		if err != nil {
+			return response.BadRequest(c, "Invalid city_id format")
+		}
+		geodirectoryID = &id
+	}
+
+	branches, err := h.branchService.GetBranchesByBank(c.Context(), code, geodirectoryID, limit, offset)
+	if err != nil {
+		return h.writeError(c, err, "Failed to retrieve bank branches: ")
+	}
+
+	return response.Success(c, branches, "Bank branches retrieved successfully")
+}
+
+// GetBranchByCode handles GET /api/v1/banks/:code/branches/:branch_code
+// @Summary Get bank branch by code
+// @Description Get a branch of a bank by its branch code
+// @Tags bank-branches
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Param branch_code path string true "Branch Code"
+// @Success 200 {object} response.Response "Bank branch retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Bank or branch not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/branches/{branch_code} [get]
+func (h *BankBranchHTTPHandler) GetBranchByCode(c *fiber.Ctx) error {
+	branch, err := h.branchService.GetBranchByCode(c.Context(), c.Params("code"), c.Params("branch_code"))
+	if err != nil {
+		return h.writeError(c, err, "Failed to retrieve bank branch: ")
+	}
+
+	return response.Success(c, branch, "Bank branch retrieved successfully")
+}
+
+// SearchBranches handles GET /api/v1/bank-branches/search
+// @Summary Search bank branches
+// @Description Search bank branches of all banks by name, code, address or BIC
+// @Tags bank-branches
+// @Produce json
+// @Param q query string true "Search query"
+// @Param limit query int false "Limit" default(50)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} response.Response "Bank branches found"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/bank-branches/search [get]
+func (h *BankBranchHTTPHandler) SearchBranches(c *fiber.Ctx) error {
+	query := c.Query("q")
+	if query == "" {
+		return response.BadRequest(c, "Search query is required")
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "50"))
+	offset, _ := strconv.Atoi(c.Query("offset", "0"))
+
+	branches, err := h.searchService.SearchBankBranches(c.Context(), query, limit, offset)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to search bank branches: "+err.Error())
+	}
+
+	return response.Success(c, branches, "Bank branches found")
+}
+
+// CreateBranch handles POST /api/v1/banks/:code/branches
+// @Summary Create a bank branch
+// @Description Create a branch for a bank. The branch code must be unique within the bank. Requires the banks:write scope.
+// @Tags bank-branches
+// @Accept json
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Param request body CreateBankBranchRequest true "Bank branch information"
+// @Success 201 {object} response.Response "Bank branch created successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "Bank not found"
+// @Failure 409 {object} response.Response "Branch code already exists"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/branches [post]
+func (h *BankBranchHTTPHandler) CreateBranch(c *fiber.Ctx) error {
+	var req CreateBankBranchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
+
+	var geodirectoryID *uuid.UUID
+	if req.GeodirectoryID != "" {
+		id, err := uuid.Parse(req.GeodirectoryID)
+		if err != nil {
+			return response.BadRequest(c, "Invalid geodirectory_id format")
+		}
+		geodirectoryID = &id
+	}
+
+	branch, err := h.branchService.CreateBranch(
+		c.Context(), c.Params("code"),
+		strings.TrimSpace(req.Name), strings.TrimSpace(req.Code), strings.TrimSpace(req.Address), strings.TrimSpace(req.BIC),
+		geodirectoryID,
+	)
+	if err != nil {
+		return h.writeError(c, err, "Failed to create bank branch: ")
+	}
+
+	// Keep the search index in sync; search falls back to the database when this fails
+	_ = h.searchService.IndexBankBranch(c.Context(), branch)
+
+	return response.Created(c, branch, "Bank branch created successfully")
+}
+
+// UpdateBranch handles PUT /api/v1/banks/:code/branches/:branch_code
+// @Summary Update a bank branch
+// @Description Update a branch of a bank. The branch code must stay unique within the bank. Requires the banks:write scope.
+// @Tags bank-branches
+// @Accept json
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Param branch_code path string true "Branch Code"
+// @Param request body UpdateBankBranchRequest true "Bank branch information"
+// @Success 200 {object} response.Response "Bank branch updated successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "Bank or branch not found"
+// @Failure 409 {object} response.Response "Branch code already exists"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/branches/{branch_code} [put]
+func (h *BankBranchHTTPHandler) UpdateBranch(c *fiber.Ctx) error {
+	branch, err := h.branchService.GetBranchByCode(c.Context(), c.Params("code"), c.Params("branch_code"))
+	if err != nil {
+		return h.writeError(c, err, "Failed to retrieve bank branch: ")
+	}
+
+	var req UpdateBankBranchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
+
+	if req.Name != nil {
+		branch.SetName(strings.TrimSpace(*req.Name))
+	}
+	if req.Code != nil {
+		branch.SetCode(strings.TrimSpace(*req.Code))
+	}
+	if req.Address != nil {
+		branch.SetAddress(strings.TrimSpace(*req.Address))
+	}
+	if req.BIC != nil {
+		// An empty BIC removes it from the branch
+		if strings.TrimSpace(*req.BIC) == "" {
+			branch.SetBIC(nil)
+		} else {
+			bic, err := valueobjects.NewBIC(*req.BIC)
+			if err != nil {
+				return response.BadRequest(c, "Invalid BIC: "+err.Error())
+			}
+			branch.SetBIC(bic)
+		}
+	}
+	if req.GeodirectoryID != nil {
+		// An empty geodirectory ID removes the location from the branch
+		if *req.GeodirectoryID == "" {
+			branch.SetGeodirectory(nil)
+		} else {
+			id, err := uuid.Parse(*req.GeodirectoryID)
+			if err != nil {
+				return response.BadRequest(c, "Invalid geodirectory_id format")
+			}
+			branch.SetGeodirectory(&id)
+		}
+	}
+
+	if err := h.branchService.UpdateBranch(c.Context(), branch); err != nil {
+		return h.writeError(c, err, "Failed to update bank branch: ")
+	}
+
+	// Keep the search index in sync; search falls back to the database when this fails
+	_ = h.searchService.IndexBankBranch(c.Context(), branch)
+
+	return response.Success(c, branch, "Bank branch updated successfully")
+}
+
+// DeleteBranch handles DELETE /api/v1/banks/:code/branches/:branch_code
+// @Summary Delete a bank branch
+// @Description Delete a branch of a bank. Requires the banks:write scope.
+// @Tags bank-branches
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Param branch_code path string true "Branch Code"
+// @Success 200 {object} response.Response "Bank branch deleted successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "Bank or branch not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/branches/{branch_code} [delete]
+func (h *BankBranchHTTPHandler) DeleteBranch(c *fiber.Ctx) error {
+	branch, err := h.branchService.GetBranchByCode(c.Context(), c.Params("code"), c.Params("branch_code"))
+	if err != nil {
+		return h.writeError(c, err, "Failed to retrieve bank branch: ")
+	}
+
+	if err := h.branchService.DeleteBranch(c.Context(), branch.ID); err != nil {
+		return response.InternalServerError(c, "Failed to delete bank branch: "+err.Error())
+	}
+
+	// Keep the search index in sync; search falls back to the database when this fails
+	_ = h.searchService.DeleteBankBranchFromIndex(c.Context(), branch.ID.String())
+
+	return response.Success(c, nil, "Bank branch deleted successfully")
+}
+
+// writeError maps bank branch service errors to HTTP responses
+func (h *BankBranchHTTPHandler) writeError(c *fiber.Ctx, err error, prefix string) error {
+	switch {
+	case errors.Is(err, services.ErrAlreadyExists):
+		return response.Error(c, fiber.StatusConflict, "Bank branch already exists: "+err.Error())
+	case errors.Is(err, services.ErrNotFound):
+		return response.NotFound(c, err.Error())
+	case errors.Is(err, services.ErrInvalidInput):
+		return response.BadRequest(c, err.Error())
+	default:
+		return response.InternalServerError(c, prefix+err.Error())
+	}
+}
+
+// Request/Response DTOs
+
+type CreateBankBranchRequest struct {
+	Name           string `json:"name" validate:"required"`
+	Code           string `json:"code" validate:"required"`
+	Address        string `json:"address,omitempty"`
+	BIC            string `json:"bic,omitempty"`
+	GeodirectoryID string `json:"geodirectory_id,omitempty"`
+}
+
+type UpdateBankBranchRequest struct {
+	Name           *string `json:"name,omitempty"`
+	Code           *string `json:"code,omitempty"`
+	Address        *string `json:"address,omitempty"`
+	BIC            *string `json:"bic,omitempty"`
+	GeodirectoryID *string `json:"geodirectory_id,omitempty"`
+}
@@ -1,36 +1,49 @@
 package http
 
 import (
+	"errors"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
 	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 	"github.com/turahe/master-data-rest-api/pkg/response"
 )
 
 // BankHTTPHandler handles HTTP requests for bank operations
 type BankHTTPHandler struct {
-	bankService   *services.BankService
-	searchService repositories.SearchRepository
+	bankService      *services.BankService
+	lifecycleService *services.BankLifecycleService
+	searchService    repositories.SearchRepository
+	localizer        *Localizer
 }
 
 // NewBankHTTPHandler creates a new BankHTTPHandler instance
-func NewBankHTTPHandler(bankService *services.BankService, searchService repositories.SearchRepository) *BankHTTPHandler {
+func NewBankHTTPHandler(bankService *services.BankService, lifecycleService *services.BankLifecycleService, searchService repositories.SearchRepository, localizer *Localizer) *BankHTTPHandler {
 	return &BankHTTPHandler{
-		bankService:   bankService,
-		searchService: searchService,
+		bankService:      bankService,
+		lifecycleService: lifecycleService,
+		searchService:    searchService,
+		localizer:        localizer,
 	}
 }
 
 // GetBanks handles GET /api/v1/banks
-// @Summary Get or search banks
-// @Description Get all banks or search banks by name, alias, company, or code with pagination
+// @Summary Get, filter or search banks
+// @Description Get all banks or search banks by name, alias, company, or code with pagination. With network, only banks with an active membership in that payment network are returned. Lists can be filtered with filter[field][op]=value (fields: name, alias, company, code, bic, status, effective_from, effective_until, successor_id, created_at, updated_at; operators: eq, ne, lt, lte, gt, gte, like, in, null) and ordered with sort=-field,field.
 // @Tags banks
 // @Produce json
 // @Param q query string false "Search query (optional - if provided, searches banks; if not provided, gets all banks)"
+// @Param network query string false "Payment network code (e.g. BI-FAST); cannot be combined with q"
+// @Param filter[field][op] query string false "Filter, e.g. filter[status][eq]=active or filter[name][like]=mandiri; cannot be combined with q or network"
+// @Param sort query string false "Comma separated sort fields, prefixed with - for descending order, e.g. -created_at,name"
 // @Param limit query int false "Limit" default(50)
 // @Param offset query int false "Offset" default(0)
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Banks retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -39,14 +52,41 @@ func NewBankHTTPHandler(bankService *services.BankService, searchService reposit
 // @Router /api/v1/banks [get]
 func (h *BankHTTPHandler) GetBanks(c *fiber.Ctx) error {
 	query := c.Query("q")
+	network := strings.TrimSpace(c.Query("network"))
 	limit, _ := strconv.Atoi(c.Query("limit", "50"))
 	offset, _ := strconv.Atoi(c.Query("offset", "0"))
 
+	if query != "" && network != "" {
+		return response.BadRequest(c, "The network filter cannot be combined with a search query")
+	}
+
+	listQuery, err := parseListQuery(c, entities.BankListSchema)
+	if err != nil {
+		return response.BadRequest(c, err.Error())
+	}
+
+	if (query != "" || network != "") && !listQuery.IsEmpty() {
+		return response.BadRequest(c, "Filters and sort cannot be combined with a search query or the network filter")
+	}
+
 	var banks interface{}
-	var err error
 	var message string
 
-	if query != "" {
+	if !listQuery.IsEmpty() {
+		// Filter and sort banks
+		banks, err = h.bankService.ListBanks(c.Context(), listQuery)
+		if err != nil {
+			return writeListError(c, err, "Failed to retrieve banks: ")
+		}
+		message = "Banks retrieved successfully"
+	} else if network != "" {
+		// Get banks participating in a payment network
+		banks, err = h.bankService.GetBanksByNetwork(c.Context(), network, limit, offset)
+		if err != nil {
+			return response.InternalServerError(c, "Failed to retrieve banks: "+err.Error())
+		}
+		message = "Banks retrieved successfully"
+	} else if query != "" {
 		// Search banks by query
 		// Use Meilisearch for fast search functionality
 		banks, err = h.searchService.SearchBanks(c.Context(), query, limit, offset)
@@ -67,15 +107,16 @@ func (h *BankHTTPHandler) GetBanks(c *fiber.Ctx) error {
 		message = "Banks retrieved successfully"
 	}
 
-	return response.Success(c, banks, message)
+	return h.localizer.Success(c, banks, message)
 }
 
 // GetBankByCode handles GET /api/v1/banks/code/:code
 // @Summary Get bank by code
-// @Description Get a bank by its code
+// @Description Get a bank by its current or any former code. Merged and closed banks are returned with their successor chain.
 // @Tags banks
 // @Produce json
 // @Param code path string true "Bank Code"
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Bank retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -86,12 +127,311 @@ func (h *BankHTTPHandler) GetBanks(c *fiber.Ctx) error {
 func (h *BankHTTPHandler) GetBankByCode(c *fiber.Ctx) error {
 	code := c.Params("code")
 
+	bank, err := h.lifecycleService.ResolveBankByCode(c.Context(), code)
+	if err != nil {
+		if errors.Is(err, services.ErrNotFound) {
+			return response.NotFound(c, err.Error())
+		}
+		return response.InternalServerError(c, "Failed to retrieve bank: "+err.Error())
+	}
+
+	switch {
+	case bank.Code != code:
+		return h.localizer.Success(c, bank, "Bank resolved from former code "+code)
+	case !bank.IsActive():
+		return h.localizer.Success(c, bank, "Bank is "+bank.Status+", see successor")
+	default:
+		return h.localizer.Success(c, bank, "Bank retrieved successfully")
+	}
+}
+
+// GetBankHistory handles GET /api/v1/banks/:code/history
+// @Summary Get bank history
+// @Description Get the lifecycle of a bank by its current or any former code: former names and codes with their validity period, the banks merged into it and its successor chain
+// @Tags banks
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Success 200 {object} response.Response "Bank history retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Bank not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/history [get]
+func (h *BankHTTPHandler) GetBankHistory(c *fiber.Ctx) error {
+	history, err := h.lifecycleService.GetBankHistory(c.Context(), c.Params("code"))
+	if err != nil {
+		if errors.Is(err, services.ErrNotFound) {
+			return response.NotFound(c, err.Error())
+		}
+		return response.InternalServerError(c, "Failed to retrieve bank history: "+err.Error())
+	}
+
+	return response.Success(c, history, "Bank history retrieved successfully")
+}
+
+// ChangeBankStatus handles PUT /api/v1/banks/:code/status
+// @Summary Change bank status
+// @Description Mark a bank as merged into a successor, closed (optionally with a successor) or active again as of an effective date. The former state is kept in the bank history. Requires the banks:write scope.
+// @Tags banks
+// @Accept json
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Param request body ChangeBankStatusRequest true "Lifecycle status"
+// @Success 200 {object} response.Response "Bank status updated successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "Bank not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/status [put]
+func (h *BankHTTPHandler) ChangeBankStatus(c *fiber.Ctx) error {
+	var req ChangeBankStatusRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
+
+	if strings.TrimSpace(req.Status) == "" {
+		return response.BadRequest(c, "Status is required")
+	}
+
+	effectiveDate := time.Now()
+	if req.EffectiveDate != "" {
+		parsed, err := time.Parse("2006-01-02", req.EffectiveDate)
+		if err != nil {
+			return response.BadRequest(c, "Invalid effective_date format, expected YYYY-MM-DD")
+		}
+		effectiveDate = parsed
+	}
+
+	bank, err := h.lifecycleService.ChangeStatus(
+		c.Context(), c.Params("code"), strings.TrimSpace(req.Status), strings.TrimSpace(req.SuccessorCode), effectiveDate,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidInput):
+			return response.BadRequest(c, err.Error())
+		case errors.Is(err, services.ErrNotFound):
+			return response.NotFound(c, err.Error())
+		default:
+			return response.InternalServerError(c, "Failed to update bank status: "+err.Error())
+		}
+	}
+
+	h.indexBank(c, bank)
+
+	return response.Success(c, bank, "Bank status updated successfully")
+}
+
+// GetBanksByBIC handles GET /api/v1/banks/bic/:bic
+// @Summary Get banks by BIC
+// @Description Get banks by ISO 9362 BIC. An 8-character BIC also matches every 11-character BIC of the same institution and location.
+// @Tags banks
+// @Produce json
+// @Param bic path string true "BIC (8 or 11 characters)"
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
+// @Success 200 {object} response.Response "Banks retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid BIC"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Bank not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/bic/{bic} [get]
+func (h *BankHTTPHandler) GetBanksByBIC(c *fiber.Ctx) error {
+	bic, err := valueobjects.NewBIC(c.Params("bic"))
+	if err != nil {
+		return response.BadRequest(c, "Invalid BIC: "+err.Error())
+	}
+
+	banks, err := h.bankService.GetBanksByBIC(c.Context(), bic.Value())
+	if err != nil {
+		return response.InternalServerError(c, "Failed to retrieve banks: "+err.Error())
+	}
+
+	if len(banks) == 0 {
+		return response.NotFound(c, "No bank found with BIC "+bic.Value())
+	}
+
+	return h.localizer.Success(c, banks, "Banks retrieved successfully")
+}
+
+// CreateBank handles POST /api/v1/banks
+// @Summary Create a new bank
+// @Description Create a new bank. Code and name must be unique. Requires the banks:write scope.
+// @Tags banks
+// @Accept json
+// @Produce json
+// @Param request body CreateBankRequest true "Bank information"
+// @Success 201 {object} response.Response "Bank created successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 409 {object} response.Response "Bank code or name already exists"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks [post]
+func (h *BankHTTPHandler) CreateBank(c *fiber.Ctx) error {
+	var req CreateBankRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
+
+	bank, err := h.bankService.CreateBank(c.Context(), strings.TrimSpace(req.Name), strings.TrimSpace(req.Alias), strings.TrimSpace(req.Company), strings.TrimSpace(req.Code), strings.TrimSpace(req.BIC))
+	if err != nil {
+		if errors.Is(err, services.ErrAlreadyExists) {
+			return response.Error(c, fiber.StatusConflict, "Bank already exists: "+err.Error())
+		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			return response.BadRequest(c, err.Error())
+		}
+		return response.InternalServerError(c, "Failed to create bank: "+err.Error())
+	}
+
+	// Keep the search index in sync; search falls back to the database when this fails
+	_ = h.searchService.IndexBank(c.Context(), bank)
+
+	return response.Created(c, bank, "Bank created successfully")
+}
+
+// UpdateBank handles PUT /api/v1/banks/:code
+// @Summary Update a bank
+// @Description Update a bank identified by its code. Code and name must stay unique. Requires the banks:write scope.
+// @Tags banks
+// @Accept json
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Param request body UpdateBankRequest true "Bank information"
+// @Success 200 {object} response.Response "Bank updated successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "Bank not found"
+// @Failure 409 {object} response.Response "Bank code or name already exists"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code} [put]
+func (h *BankHTTPHandler) UpdateBank(c *fiber.Ctx) error {
+	code := c.Params("code")
+
 	bank, err := h.bankService.GetBankByCode(c.Context(), code)
 	if err != nil {
 		return response.NotFound(c, "Bank not found: "+err.Error())
 	}
+	previous := *bank
+
+	var req UpdateBankRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
 
-	return response.Success(c, bank, "Bank retrieved successfully")
+	if req.Name != nil {
+		bank.SetName(strings.TrimSpace(*req.Name))
+	}
+	if req.Alias != nil {
+		bank.SetAlias(strings.TrimSpace(*req.Alias))
+	}
+	if req.Company != nil {
+		bank.SetCompany(strings.TrimSpace(*req.Company))
+	}
+	if req.Code != nil {
+		bank.SetCode(strings.TrimSpace(*req.Code))
+	}
+	if req.BIC != nil {
+		// An empty BIC removes it from the bank
+		if strings.TrimSpace(*req.BIC) == "" {
+			bank.SetBIC(nil)
+		} else {
+			bic, err := valueobjects.NewBIC(*req.BIC)
+			if err != nil {
+				return response.BadRequest(c, "Invalid BIC: "+err.Error())
+			}
+			bank.SetBIC(bic)
+		}
+	}
+
+	if err := h.bankService.ValidateUpdate(c.Context(), bank); err != nil {
+		if errors.Is(err, services.ErrAlreadyExists) {
+			return response.Error(c, fiber.StatusConflict, "Bank already exists: "+err.Error())
+		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			return response.BadRequest(c, err.Error())
+		}
+		return response.InternalServerError(c, "Failed to update bank: "+err.Error())
+	}
+
+	// Store the former name and code with the update so they stay resolvable
+	if err := h.lifecycleService.SaveChange(c.Context(), &previous, bank, time.Now()); err != nil {
+		return response.InternalServerError(c, "Failed to update bank: "+err.Error())
+	}
+
+	h.indexBank(c, bank)
+
+	return response.Success(c, bank, "Bank updated successfully")
+}
+
+// DeleteBank handles DELETE /api/v1/banks/:code
+// @Summary Delete a bank
+// @Description Delete a bank identified by its code. Requires the banks:write scope.
+// @Tags banks
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Success 200 {object} response.Response "Bank deleted successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "Bank not found"
+// @Failure 409 {object} response.Response "Bank is the successor of other banks"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code} [delete]
+func (h *BankHTTPHandler) DeleteBank(c *fiber.Ctx) error {
+	code := c.Params("code")
+
+	bank, err := h.bankService.GetBankByCode(c.Context(), code)
+	if err != nil {
+		return response.NotFound(c, "Bank not found: "+err.Error())
+	}
+
+	if err := h.bankService.DeleteBank(c.Context(), bank.ID); err != nil {
+		if errors.Is(err, services.ErrInUse) {
+			return response.Error(c, fiber.StatusConflict, "Bank cannot be deleted: "+err.Error())
+		}
+		return response.InternalServerError(c, "Failed to delete bank: "+err.Error())
+	}
+
+	// Keep the search index in sync; search falls back to the database when this fails
+	_ = h.searchService.DeleteBankFromIndex(c.Context(), bank.ID.String())
+
+	return response.Success(c, nil, "Bank deleted successfully")
+}
+
+// indexBank keeps the search index in sync; search falls back to the database when this fails.
+// The successor chain is resolved on lookup and not stored in the index.
+func (h *BankHTTPHandler) indexBank(c *fiber.Ctx, bank *entities.Bank) {
+	indexed := *bank
+	indexed.Successor = nil
+	_ = h.searchService.IndexBank(c.Context(), &indexed)
 }
 
 // Request/Response DTOs
+
+type CreateBankRequest struct {
+	Name    string `json:"name" validate:"required"`
+	Alias   string `json:"alias,omitempty"`
+	Company string `json:"company,omitempty"`
+	Code    string `json:"code" validate:"required"`
+	BIC     string `json:"bic,omitempty"`
+}
+
+type UpdateBankRequest struct {
+	Name    *string `json:"name,omitempty"`
+	Alias   *string `json:"alias,omitempty"`
+	Company *string `json:"company,omitempty"`
+	Code    *string `json:"code,omitempty"`
+	BIC     *string `json:"bic,omitempty"`
+}
+
+type ChangeBankStatusRequest struct {
+	Status        string `json:"status" validate:"required"`
+	SuccessorCode string `json:"successor_code,omitempty"`
+	EffectiveDate string `json:"effective_date,omitempty"`
+}
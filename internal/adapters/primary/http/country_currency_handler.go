@@ -0,0 +1,103 @@
+package http
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/pkg/response"
+)
+
+// CountryCurrencyHTTPHandler handles HTTP requests for the currencies used in countries
+type CountryCurrencyHTTPHandler struct {
+	countryCurrencyService *services.CountryCurrencyService
+}
+
+// NewCountryCurrencyHTTPHandler creates a new CountryCurrencyHTTPHandler instance
+func NewCountryCurrencyHTTPHandler(countryCurrencyService *services.CountryCurrencyService) *CountryCurrencyHTTPHandler {
+	return &CountryCurrencyHTTPHandler{
+		countryCurrencyService: countryCurrencyService,
+	}
+}
+
+// GetCountryCurrencies handles GET /api/v1/countries/:code/currencies
+// @Summary Get currencies of a country
+// @Description Get the currencies used in a country with their legal tender status, whether they are the primary currency and their effective dates. Current currencies are listed first.
+// @Tags countries
+// @Produce json
+// @Param code path string true "Country code (ISO 3166-1 alpha-2)"
+// @Param date query string false "Only currencies used on this date (YYYY-MM-DD); all currencies including former ones when omitted"
+// @Success 200 {object} response.Response "Country currencies retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Country not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/countries/{code}/currencies [get]
+func (h *CountryCurrencyHTTPHandler) GetCountryCurrencies(c *fiber.Ctx) error {
+	date, err := parseEffectiveDate(c.Query("date"))
+	if err != nil {
+		return response.BadRequest(c, "Invalid date format, expected YYYY-MM-DD")
+	}
+
+	countryCurrencies, err := h.countryCurrencyService.GetCountryCurrencies(c.Context(), c.Params("code"), date)
+	if err != nil {
+		return h.writeError(c, err, "Failed to retrieve country currencies: ")
+	}
+
+	return response.Success(c, countryCurrencies, "Country currencies retrieved successfully")
+}
+
+// GetCurrencyCountries handles GET /api/v1/currencies/:code/countries
+// @Summary Get countries of a currency
+// @Description Get the countries a currency is used in with its legal tender status, whether it is the primary currency and the effective dates
+// @Tags currencies
+// @Produce json
+// @Param code path string true "Currency code (ISO 4217)"
+// @Param date query string false "Only countries using the currency on this date (YYYY-MM-DD); all countries including former ones when omitted"
+// @Success 200 {object} response.Response "Currency countries retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Currency not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/currencies/{code}/countries [get]
+func (h *CountryCurrencyHTTPHandler) GetCurrencyCountries(c *fiber.Ctx) error {
+	date, err := parseEffectiveDate(c.Query("date"))
+	if err != nil {
+		return response.BadRequest(c, "Invalid date format, expected YYYY-MM-DD")
+	}
+
+	countryCurrencies, err := h.countryCurrencyService.GetCurrencyCountries(c.Context(), c.Params("code"), date)
+	if err != nil {
+		return h.writeError(c, err, "Failed to retrieve currency countries: ")
+	}
+
+	return response.Success(c, countryCurrencies, "Currency countries retrieved successfully")
+}
+
+// writeError maps country currency service errors to HTTP responses
+func (h *CountryCurrencyHTTPHandler) writeError(c *fiber.Ctx, err error, prefix string) error {
+	switch {
+	case errors.Is(err, services.ErrNotFound):
+		return response.NotFound(c, err.Error())
+	case errors.Is(err, services.ErrInvalidInput):
+		return response.BadRequest(c, err.Error())
+	default:
+		return response.InternalServerError(c, prefix+err.Error())
+	}
+}
+
+// parseEffectiveDate parses an optional date query parameter; an empty value yields nil
+func parseEffectiveDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
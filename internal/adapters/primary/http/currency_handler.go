@@ -1,11 +1,14 @@
 package http
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
 	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 	"github.com/turahe/master-data-rest-api/pkg/response"
 )
 
@@ -13,25 +16,30 @@ import (
 type CurrencyHTTPHandler struct {
 	currencyService *services.CurrencyService
 	searchService   repositories.SearchRepository
+	localizer       *Localizer
 }
 
 // NewCurrencyHTTPHandler creates a new CurrencyHTTPHandler instance
-func NewCurrencyHTTPHandler(currencyService *services.CurrencyService, searchService repositories.SearchRepository) *CurrencyHTTPHandler {
+func NewCurrencyHTTPHandler(currencyService *services.CurrencyService, searchService repositories.SearchRepository, localizer *Localizer) *CurrencyHTTPHandler {
 	return &CurrencyHTTPHandler{
 		currencyService: currencyService,
 		searchService:   searchService,
+		localizer:       localizer,
 	}
 }
 
 // GetCurrencies handles GET /api/v1/currencies
-// @Summary Get or search currencies
-// @Description Get all currencies, active currencies, or search currencies by name, code, or symbol with pagination
+// @Summary Get, filter or search currencies
+// @Description Get all currencies, active or inactive currencies, or search currencies by name, code, or symbol with pagination. Lists can be filtered with filter[field][op]=value (fields: name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by, cash_rounding_mode, created_at, updated_at; operators: eq, ne, lt, lte, gt, gte, like, in, null) and ordered with sort=-field,field.
 // @Tags currencies
 // @Produce json
 // @Param q query string false "Search query (optional - if provided, searches currencies)"
 // @Param active query bool false "Filter by active status (true for active only, false for inactive only, omit for all)"
+// @Param filter[field][op] query string false "Filter, e.g. filter[decimal_places][eq]=0 or filter[code][in]=USD,EUR"
+// @Param sort query string false "Comma separated sort fields, prefixed with - for descending order, e.g. -decimal_places,code"
 // @Param limit query int false "Limit" default(50)
 // @Param offset query int false "Offset" default(0)
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Currencies retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -44,8 +52,25 @@ func (h *CurrencyHTTPHandler) GetCurrencies(c *fiber.Ctx) error {
 	limit, _ := strconv.Atoi(c.Query("limit", "50"))
 	offset, _ := strconv.Atoi(c.Query("offset", "0"))
 
+	listQuery, err := parseListQuery(c, entities.CurrencyListSchema)
+	if err != nil {
+		return response.BadRequest(c, err.Error())
+	}
+
+	if query != "" && !listQuery.IsEmpty() {
+		return response.BadRequest(c, "Filters and sort cannot be combined with a search query")
+	}
+
+	var active *bool
+	if activeStr != "" {
+		value, parseErr := strconv.ParseBool(activeStr)
+		if parseErr != nil {
+			return response.BadRequest(c, "Invalid active parameter: must be true or false")
+		}
+		active = &value
+	}
+
 	var currencies interface{}
-	var err error
 	var message string
 
 	if query != "" {
@@ -55,19 +80,24 @@ func (h *CurrencyHTTPHandler) GetCurrencies(c *fiber.Ctx) error {
 			return response.InternalServerError(c, "Failed to search currencies: "+err.Error())
 		}
 		message = "Currencies found"
-	} else if activeStr != "" {
-		// Filter by active status
-		active, parseErr := strconv.ParseBool(activeStr)
-		if parseErr != nil {
-			return response.BadRequest(c, "Invalid active parameter: must be true or false")
+	} else if !listQuery.IsEmpty() {
+		// Filter and sort currencies, applying the active flag as a filter
+		if active != nil {
+			listQuery.Filters = append(listQuery.Filters, valueobjects.Filter{Field: "is_active", Operator: valueobjects.FilterEq, Value: *active})
 		}
 
-		if active {
+		currencies, err = h.currencyService.ListCurrencies(c.Context(), listQuery)
+		if err != nil {
+			return writeListError(c, err, "Failed to retrieve currencies: ")
+		}
+		message = "Currencies retrieved successfully"
+	} else if active != nil {
+		// Filter by active status
+		if *active {
 			currencies, err = h.currencyService.GetActiveCurrencies(c.Context(), limit, offset)
 			message = "Active currencies retrieved successfully"
 		} else {
-			// For inactive currencies, we'll get all and filter (assuming there's no direct inactive method)
-			currencies, err = h.currencyService.GetAllCurrencies(c.Context(), limit, offset)
+			currencies, err = h.currencyService.GetInactiveCurrencies(c.Context(), limit, offset)
 			message = "Inactive currencies retrieved successfully"
 		}
 
@@ -83,7 +113,7 @@ func (h *CurrencyHTTPHandler) GetCurrencies(c *fiber.Ctx) error {
 		message = "Currencies retrieved successfully"
 	}
 
-	return response.Success(c, currencies, message)
+	return h.localizer.Success(c, currencies, message)
 }
 
 // GetCurrencyByCode handles GET /api/v1/currencies/code/:code
@@ -92,6 +122,7 @@ func (h *CurrencyHTTPHandler) GetCurrencies(c *fiber.Ctx) error {
 // @Tags currencies
 // @Produce json
 // @Param code path string true "Currency Code"
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Currency retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -105,5 +136,156 @@ func (h *CurrencyHTTPHandler) GetCurrencyByCode(c *fiber.Ctx) error {
 	if err != nil {
 		return response.NotFound(c, "Currency not found: "+err.Error())
 	}
-	return response.Success(c, currency, "Currency retrieved successfully")
+	return h.localizer.Success(c, currency, "Currency retrieved successfully")
+}
+
+// GetCurrencyByNumericCode handles GET /api/v1/currencies/numeric/:num
+// @Summary Get currency by ISO 4217 numeric code
+// @Description Get a currency by its ISO 4217 numeric code, e.g. 360 or 008. Withdrawn currencies are returned with their withdrawal date and replacement; when a numeric code was reused the current currency is returned.
+// @Tags currencies
+// @Produce json
+// @Param num path string true "ISO 4217 numeric code"
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
+// @Success 200 {object} response.Response "Currency retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Currency not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/currencies/numeric/{num} [get]
+func (h *CurrencyHTTPHandler) GetCurrencyByNumericCode(c *fiber.Ctx) error {
+	currency, err := h.currencyService.GetCurrencyByNumericCode(c.Context(), c.Params("num"))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrNotFound):
+			return response.NotFound(c, err.Error())
+		case errors.Is(err, services.ErrInvalidInput):
+			return response.BadRequest(c, err.Error())
+		default:
+			return response.InternalServerError(c, "Failed to retrieve currency: "+err.Error())
+		}
+	}
+
+	return h.localizer.Success(c, currency, "Currency retrieved successfully")
+}
+
+// FormatAmount handles GET /api/v1/currencies/:code/format
+// @Summary Format an amount of a currency
+// @Description Format an amount for display in a locale: rounded to the decimal places of the currency, with the grouping, decimal separator and symbol placement of the locale. Locales fall back to the default locale of their language.
+// @Tags currencies
+// @Produce json
+// @Param code path string true "Currency Code"
+// @Param amount query string true "Amount, e.g. 1234.5"
+// @Param locale query string false "Locale, e.g. id-ID" default(en-US)
+// @Success 200 {object} response.Response "Amount formatted successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Currency not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/currencies/{code}/format [get]
+func (h *CurrencyHTTPHandler) FormatAmount(c *fiber.Ctx) error {
+	if c.Query("amount") == "" {
+		return response.BadRequest(c, "Amount is required")
+	}
+	amount, err := valueobjects.NewDecimal(c.Query("amount"))
+	if err != nil {
+		return response.BadRequest(c, "Invalid amount: "+err.Error())
+	}
+
+	result, err := h.currencyService.FormatAmount(c.Context(), c.Params("code"), amount, c.Query("locale"))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrNotFound):
+			return response.NotFound(c, err.Error())
+		case errors.Is(err, services.ErrInvalidInput):
+			return response.BadRequest(c, err.Error())
+		default:
+			return response.InternalServerError(c, "Failed to format amount: "+err.Error())
+		}
+	}
+
+	return response.Success(c, result, "Amount formatted successfully")
+}
+
+// FormatAmounts handles POST /api/v1/currencies/format
+// @Summary Format a batch of amounts
+// @Description Format up to 100 amounts of any currency. Items without a locale use the locale of the request. Items that cannot be formatted carry an error instead of failing the batch.
+// @Tags currencies
+// @Accept json
+// @Produce json
+// @Param request body FormatAmountsRequest true "Amounts to format"
+// @Success 200 {object} response.Response "Amounts formatted successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/currencies/format [post]
+func (h *CurrencyHTTPHandler) FormatAmounts(c *fiber.Ctx) error {
+	var req FormatAmountsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
+
+	if len(req.Items) == 0 {
+		return response.BadRequest(c, "At least one item is required")
+	}
+
+	results, err := h.currencyService.FormatAmounts(c.Context(), req.Items, req.Locale)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidInput) {
+			return response.BadRequest(c, err.Error())
+		}
+		return response.InternalServerError(c, "Failed to format amounts: "+err.Error())
+	}
+
+	return response.Success(c, results, "Amounts formatted successfully")
+}
+
+// RoundAmounts handles POST /api/v1/currencies/:code/round
+// @Summary Round amounts of a currency
+// @Description Round up to 100 amounts with exact decimal arithmetic. Electronic rounding rounds to the decimal places of the currency; cash rounding rounds to the cash rounding increment of the currency (e.g. 0.05 CHF) with its rounding mode. Each amount is returned with the rounded value and the rounding adjustment.
+// @Tags currencies
+// @Accept json
+// @Produce json
+// @Param code path string true "Currency code (ISO 4217)"
+// @Param request body RoundAmountsRequest true "Amounts to round"
+// @Success 200 {object} response.Response "Amounts rounded successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Currency not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/currencies/{code}/round [post]
+func (h *CurrencyHTTPHandler) RoundAmounts(c *fiber.Ctx) error {
+	var req RoundAmountsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
+
+	result, err := h.currencyService.RoundAmounts(c.Context(), c.Params("code"), req.Type, req.Amounts)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrNotFound):
+			return response.NotFound(c, err.Error())
+		case errors.Is(err, services.ErrInvalidInput):
+			return response.BadRequest(c, err.Error())
+		default:
+			return response.InternalServerError(c, "Failed to round amounts: "+err.Error())
+		}
+	}
+
+	return response.Success(c, result, "Amounts rounded successfully")
+}
+
+// Request/Response DTOs
+
+type FormatAmountsRequest struct {
+	Locale string                     `json:"locale,omitempty"`
+	Items  []services.MoneyFormatItem `json:"items" validate:"required"`
+}
+
+type RoundAmountsRequest struct {
+	Type    string   `json:"type,omitempty"` // electronic (default) or cash
+	Amounts []string `json:"amounts" validate:"required"`
 }
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+	"github.com/turahe/master-data-rest-api/pkg/response"
+)
+
+// ExchangeRateHTTPHandler handles HTTP requests for exchange rates and currency conversion
+type ExchangeRateHTTPHandler struct {
+	exchangeRateService *services.ExchangeRateService
+}
+
+// NewExchangeRateHTTPHandler creates a new ExchangeRateHTTPHandler instance
+func NewExchangeRateHTTPHandler(exchangeRateService *services.ExchangeRateService) *ExchangeRateHTTPHandler {
+	return &ExchangeRateHTTPHandler{
+		exchangeRateService: exchangeRateService,
+	}
+}
+
+// GetExchangeRates handles GET /api/v1/exchange-rates
+// @Summary Get exchange rates of a base currency
+// @Description Get the latest rate of every quote currency of a base currency published on or before the given date
+// @Tags exchange-rates
+// @Produce json
+// @Param base query string true "Base currency code (ISO 4217)"
+// @Param date query string false "Date in YYYY-MM-DD format, defaults to today"
+// @Success 200 {object} response.Response "Exchange rates retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/exchange-rates [get]
+func (h *ExchangeRateHTTPHandler) GetExchangeRates(c *fiber.Ctx) error {
+	base := c.Query("base")
+	if base == "" {
+		return response.BadRequest(c, "Base currency is required")
+	}
+
+	date, err := parseRateDate(c.Query("date"))
+	if err != nil {
+		return response.BadRequest(c, err.Error())
+	}
+
+	rates, err := h.exchangeRateService.GetRates(c.Context(), base, date)
+	if err != nil {
+		return h.writeError(c, err, "Failed to retrieve exchange rates: ")
+	}
+
+	return response.Success(c, rates, "Exchange rates retrieved successfully")
+}
+
+// Convert handles GET /api/v1/convert
+// @Summary Convert an amount between currencies
+// @Description Convert an amount with the rates published on or before the given date. Without a direct rate the inverse rate is used, otherwise the conversion is triangulated through a base currency. The result is rounded to the decimal places of the target currency.
+// @Tags exchange-rates
+// @Produce json
+// @Param from query string true "Source currency code (ISO 4217)"
+// @Param to query string true "Target currency code (ISO 4217)"
+// @Param amount query string true "Amount to convert, e.g. 100.50"
+// @Param date query string false "Date in YYYY-MM-DD format, defaults to today"
+// @Success 200 {object} response.Response "Amount converted successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Currency or exchange rate not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/convert [get]
+func (h *ExchangeRateHTTPHandler) Convert(c *fiber.Ctx) error {
+	from := c.Query("from")
+	to := c.Query("to")
+	if from == "" || to == "" {
+		return response.BadRequest(c, "Both from and to currencies are required")
+	}
+
+	if c.Query("amount") == "" {
+		return response.BadRequest(c, "Amount is required")
+	}
+	amount, err := valueobjects.NewDecimal(c.Query("amount"))
+	if err != nil {
+		return response.BadRequest(c, "Invalid amount: "+err.Error())
+	}
+
+	date, err := parseRateDate(c.Query("date"))
+	if err != nil {
+		return response.BadRequest(c, err.Error())
+	}
+
+	conversion, err := h.exchangeRateService.Convert(c.Context(), from, to, amount, date)
+	if err != nil {
+		return h.writeError(c, err, "Failed to convert amount: ")
+	}
+
+	return response.Success(c, conversion, "Amount converted successfully")
+}
+
+// writeError maps exchange rate service errors to HTTP responses
+func (h *ExchangeRateHTTPHandler) writeError(c *fiber.Ctx, err error, prefix string) error {
+	switch {
+	case errors.Is(err, services.ErrNotFound):
+		return response.NotFound(c, err.Error())
+	case errors.Is(err, services.ErrInvalidInput):
+		return response.BadRequest(c, err.Error())
+	default:
+		return response.InternalServerError(c, prefix+err.Error())
+	}
+}
+
+// parseRateDate parses the date query parameter, defaulting to today
+func parseRateDate(value string) (time.Time, error) {
+	if value == "" {
+		now := time.Now().UTC()
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+
+	date, err := time.Parse(entities.ExchangeRateDateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid date format, expected YYYY-MM-DD")
+	}
+	return date, nil
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/pkg/response"
+)
+
+// GeoTypeHTTPHandler handles HTTP requests for geo type registry operations
+type GeoTypeHTTPHandler struct {
+	geoTypeService *services.GeoTypeService
+}
+
+// NewGeoTypeHTTPHandler creates a new GeoTypeHTTPHandler instance
+func NewGeoTypeHTTPHandler(geoTypeService *services.GeoTypeService) *GeoTypeHTTPHandler {
+	return &GeoTypeHTTPHandler{
+		geoTypeService: geoTypeService,
+	}
+}
+
+// GetAllGeoTypes handles GET /api/v1/geo-types
+// @Summary Get all geo types
+// @Description Get all registered geodirectory types with label, default depth and hierarchy rank
+// @Tags geo-types
+// @Produce json
+// @Success 200 {object} response.Response "Geo types retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/geo-types [get]
+func (h *GeoTypeHTTPHandler) GetAllGeoTypes(c *fiber.Ctx) error {
+	geoTypes, err := h.geoTypeService.GetAllGeoTypes(c.Context())
+	if err != nil {
+		return response.InternalServerError(c, "Failed to retrieve geo types: "+err.Error())
+	}
+
+	return response.Success(c, geoTypes, "Geo types retrieved successfully")
+}
+
+// GetGeoTypeByCode handles GET /api/v1/geo-types/:code
+// @Summary Get geo type by code
+// @Description Get a registered geodirectory type by its code
+// @Tags geo-types
+// @Produce json
+// @Param code path string true "Geo type code"
+// @Success 200 {object} response.Response "Geo type retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Geo type not found"
+// @Security ApiKeyAuth
+// @Router /api/v1/geo-types/{code} [get]
+func (h *GeoTypeHTTPHandler) GetGeoTypeByCode(c *fiber.Ctx) error {
+	code := c.Params("code")
+
+	geoType, err := h.geoTypeService.GetGeoTypeByCode(c.Context(), code)
+	if err != nil {
+		return response.NotFound(c, "Geo type not found: "+err.Error())
+	}
+
+	return response.Success(c, geoType, "Geo type retrieved successfully")
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -15,13 +16,15 @@ import (
 type GeodirectoryHTTPHandler struct {
 	geodirectoryService *services.GeodirectoryService
 	searchService       repositories.SearchRepository
+	localizer           *Localizer
 }
 
 // NewGeodirectoryHTTPHandler creates a new GeodirectoryHTTPHandler instance
-func NewGeodirectoryHTTPHandler(geodirectoryService *services.GeodirectoryService, searchService repositories.SearchRepository) *GeodirectoryHTTPHandler {
+func NewGeodirectoryHTTPHandler(geodirectoryService *services.GeodirectoryService, searchService repositories.SearchRepository, localizer *Localizer) *GeodirectoryHTTPHandler {
 	return &GeodirectoryHTTPHandler{
 		geodirectoryService: geodirectoryService,
 		searchService:       searchService,
+		localizer:           localizer,
 	}
 }
 
@@ -31,6 +34,7 @@ func NewGeodirectoryHTTPHandler(geodirectoryService *services.GeodirectoryServic
 // @Tags geodirectories
 // @Produce json
 // @Param id path string true "Geodirectory ID (UUID)"
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Geodirectory retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -50,7 +54,7 @@ func (h *GeodirectoryHTTPHandler) GetGeodirectoryByID(c *fiber.Ctx) error {
 		return response.NotFound(c, "Geodirectory not found: "+err.Error())
 	}
 
-	return response.Success(c, geodirectory, "Geodirectory retrieved successfully")
+	return h.localizer.Success(c, geodirectory, "Geodirectory retrieved successfully")
 }
 
 // GetGeodirectoryWithHierarchy handles GET /api/v1/geodirectories/:id/hierarchy
@@ -59,6 +63,7 @@ func (h *GeodirectoryHTTPHandler) GetGeodirectoryByID(c *fiber.Ctx) error {
 // @Tags geodirectories
 // @Produce json
 // @Param id path string true "Geodirectory ID (UUID)"
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Geodirectory with hierarchy retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -78,31 +83,45 @@ func (h *GeodirectoryHTTPHandler) GetGeodirectoryWithHierarchy(c *fiber.Ctx) err
 		return response.NotFound(c, "Geodirectory not found: "+err.Error())
 	}
 
-	return response.Success(c, geodirectory, "Geodirectory with hierarchy retrieved successfully")
+	return h.localizer.Success(c, geodirectory, "Geodirectory with hierarchy retrieved successfully")
 }
 
 // GetAllGeodirectories handles GET /api/v1/geodirectories
 // @Summary Get all geodirectories
-// @Description Get all geodirectories with pagination
+// @Description Get all geodirectories with pagination. Lists can be filtered with filter[field][op]=value (fields: name, type, code, postal_code, parent_id, record_depth, record_ordering, created_at, updated_at; operators: eq, ne, lt, lte, gt, gte, like, in, null) and ordered with sort=-field,field.
 // @Tags geodirectories
 // @Produce json
+// @Param filter[field][op] query string false "Filter, e.g. filter[type][eq]=PROVINCE or filter[parent_id][eq]=<uuid>"
+// @Param sort query string false "Comma separated sort fields, prefixed with - for descending order, e.g. type,-name"
 // @Param limit query int false "Limit" default(50)
 // @Param offset query int false "Offset" default(0)
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Geodirectories retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Security ApiKeyAuth
 // @Router /api/v1/geodirectories [get]
 func (h *GeodirectoryHTTPHandler) GetAllGeodirectories(c *fiber.Ctx) error {
-	limit, _ := strconv.Atoi(c.Query("limit", "50"))
-	offset, _ := strconv.Atoi(c.Query("offset", "0"))
+	listQuery, err := parseListQuery(c, entities.GeodirectoryListSchema)
+	if err != nil {
+		return response.BadRequest(c, err.Error())
+	}
 
-	geodirectories, err := h.geodirectoryService.GetAllGeodirectories(c.Context(), limit, offset)
+	if !listQuery.IsEmpty() {
+		geodirectories, err := h.geodirectoryService.ListGeodirectories(c.Context(), listQuery)
+		if err != nil {
+			return writeListError(c, err, "Failed to retrieve geodirectories: ")
+		}
+		return h.localizer.Success(c, geodirectories, "Geodirectories retrieved successfully")
+	}
+
+	geodirectories, err := h.geodirectoryService.GetAllGeodirectories(c.Context(), listQuery.Limit, listQuery.Offset)
 	if err != nil {
 		return response.InternalServerError(c, "Failed to retrieve geodirectories: "+err.Error())
 	}
 
-	return response.Success(c, geodirectories, "Geodirectories retrieved successfully")
+	return h.localizer.Success(c, geodirectories, "Geodirectories retrieved successfully")
 }
 
 // SearchGeodirectories handles GET /api/v1/geodirectories/search
@@ -113,6 +132,7 @@ func (h *GeodirectoryHTTPHandler) GetAllGeodirectories(c *fiber.Ctx) error {
 // @Param q query string true "Search query"
 // @Param limit query int false "Limit" default(50)
 // @Param offset query int false "Offset" default(0)
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Geodirectories found"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -133,7 +153,7 @@ func (h *GeodirectoryHTTPHandler) SearchGeodirectories(c *fiber.Ctx) error {
 		return response.InternalServerError(c, "Failed to search geodirectories: "+err.Error())
 	}
 
-	return response.Success(c, geodirectories, "Geodirectories found")
+	return h.localizer.Success(c, geodirectories, "Geodirectories found")
 }
 
 // GetGeodirectoriesByType handles GET /api/v1/geodirectories/type/:type
@@ -141,9 +161,10 @@ func (h *GeodirectoryHTTPHandler) SearchGeodirectories(c *fiber.Ctx) error {
 // @Description Get geodirectories filtered by type
 // @Tags geodirectories
 // @Produce json
-// @Param type path string true "Geodirectory Type" Enums(CONTINENT,SUBCONTINENT,COUNTRY,STATE,PROVINCE,REGENCY,CITY,DISTRICT,SUBDISTRICT,VILLAGE)
+// @Param type path string true "Geodirectory Type (see /api/v1/geo-types)"
 // @Param limit query int false "Limit" default(50)
 // @Param offset query int false "Offset" default(0)
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Geodirectories retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -160,7 +181,7 @@ func (h *GeodirectoryHTTPHandler) GetGeodirectoriesByType(c *fiber.Ctx) error {
 		return response.InternalServerError(c, "Failed to retrieve geodirectories: "+err.Error())
 	}
 
-	return response.Success(c, geodirectories, "Geodirectories retrieved successfully")
+	return h.localizer.Success(c, geodirectories, "Geodirectories retrieved successfully")
 }
 
 // GetChildren handles GET /api/v1/geodirectories/:id/children
@@ -172,6 +193,7 @@ func (h *GeodirectoryHTTPHandler) GetGeodirectoriesByType(c *fiber.Ctx) error {
 // @Param type query string false "Filter by child type"
 // @Param limit query int false "Limit" default(50)
 // @Param offset query int false "Offset" default(0)
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Children retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -202,7 +224,7 @@ func (h *GeodirectoryHTTPHandler) GetChildren(c *fiber.Ctx) error {
 		return response.InternalServerError(c, "Failed to retrieve children: "+err.Error())
 	}
 
-	return response.Success(c, children, "Children retrieved successfully")
+	return h.localizer.Success(c, children, "Children retrieved successfully")
 }
 
 // GetAncestors handles GET /api/v1/geodirectories/:id/ancestors
@@ -211,6 +233,7 @@ func (h *GeodirectoryHTTPHandler) GetChildren(c *fiber.Ctx) error {
 // @Tags geodirectories
 // @Produce json
 // @Param id path string true "Geodirectory ID (UUID)"
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Ancestors retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -230,7 +253,7 @@ func (h *GeodirectoryHTTPHandler) GetAncestors(c *fiber.Ctx) error {
 		return response.InternalServerError(c, "Failed to retrieve ancestors: "+err.Error())
 	}
 
-	return response.Success(c, ancestors, "Ancestors retrieved successfully")
+	return h.localizer.Success(c, ancestors, "Ancestors retrieved successfully")
 }
 
 // GetDescendants handles GET /api/v1/geodirectories/:id/descendants
@@ -241,6 +264,7 @@ func (h *GeodirectoryHTTPHandler) GetAncestors(c *fiber.Ctx) error {
 // @Param id path string true "Geodirectory ID (UUID)"
 // @Param limit query int false "Limit" default(100)
 // @Param offset query int false "Offset" default(0)
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Descendants retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -263,7 +287,29 @@ func (h *GeodirectoryHTTPHandler) GetDescendants(c *fiber.Ctx) error {
 		return response.InternalServerError(c, "Failed to retrieve descendants: "+err.Error())
 	}
 
-	return response.Success(c, descendants, "Descendants retrieved successfully")
+	return h.localizer.Success(c, descendants, "Descendants retrieved successfully")
+}
+
+// GetHierarchySchema handles GET /api/v1/geodirectories/hierarchy-schemas/:country_code
+// @Summary Get administrative hierarchy schema of a country
+// @Description Get the allowed geodirectory types and parent types used by a country's administrative hierarchy
+// @Tags geodirectories
+// @Produce json
+// @Param country_code path string true "Country code"
+// @Success 200 {object} response.Response "Hierarchy schema retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/geodirectories/hierarchy-schemas/{country_code} [get]
+func (h *GeodirectoryHTTPHandler) GetHierarchySchema(c *fiber.Ctx) error {
+	countryCode := strings.ToUpper(c.Params("country_code"))
+
+	schema, err := h.geodirectoryService.GetHierarchySchema(c.Context(), countryCode)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to retrieve hierarchy schema: "+err.Error())
+	}
+
+	return response.Success(c, schema, "Hierarchy schema retrieved successfully")
 }
 
 // Request/Response DTOs
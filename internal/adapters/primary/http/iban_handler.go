@@ -0,0 +1,103 @@
+package http
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/pkg/response"
+)
+
+// IBANHTTPHandler handles HTTP requests for IBAN validation and IBAN formats
+type IBANHTTPHandler struct {
+	ibanService *services.IBANService
+}
+
+// NewIBANHTTPHandler creates a new IBANHTTPHandler instance
+func NewIBANHTTPHandler(ibanService *services.IBANService) *IBANHTTPHandler {
+	return &IBANHTTPHandler{
+		ibanService: ibanService,
+	}
+}
+
+// ValidateIBAN handles POST /api/v1/iban/validate
+// @Summary Validate an IBAN
+// @Description Validate an IBAN against its country length and BBAN structure and the mod-97 checksum. Extracts the country code and bank identifier and resolves the country geodirectory and bank when known.
+// @Tags iban
+// @Accept json
+// @Produce json
+// @Param request body ValidateIBANRequest true "IBAN to validate"
+// @Success 200 {object} response.Response "IBAN validated, see the valid flag and errors"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/iban/validate [post]
+func (h *IBANHTTPHandler) ValidateIBAN(c *fiber.Ctx) error {
+	var req ValidateIBANRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
+
+	if strings.TrimSpace(req.IBAN) == "" {
+		return response.BadRequest(c, "IBAN is required")
+	}
+
+	result, err := h.ibanService.ValidateIBAN(c.Context(), req.IBAN)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to validate IBAN: "+err.Error())
+	}
+
+	if !result.Valid {
+		return response.Success(c, result, "IBAN is invalid")
+	}
+
+	return response.Success(c, result, "IBAN is valid")
+}
+
+// GetIBANFormats handles GET /api/v1/iban/formats
+// @Summary Get all IBAN formats
+// @Description Get the IBAN length, BBAN structure and bank identifier position of every country using IBAN
+// @Tags iban
+// @Produce json
+// @Success 200 {object} response.Response "IBAN formats retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/iban/formats [get]
+func (h *IBANHTTPHandler) GetIBANFormats(c *fiber.Ctx) error {
+	formats, err := h.ibanService.GetAllIBANFormats(c.Context())
+	if err != nil {
+		return response.InternalServerError(c, "Failed to retrieve IBAN formats: "+err.Error())
+	}
+
+	return response.Success(c, formats, "IBAN formats retrieved successfully")
+}
+
+// GetIBANFormatByCountryCode handles GET /api/v1/iban/formats/:country_code
+// @Summary Get IBAN format by country
+// @Description Get the IBAN format of a country by its ISO 3166-1 alpha-2 code
+// @Tags iban
+// @Produce json
+// @Param country_code path string true "Country code (ISO 3166-1 alpha-2)"
+// @Success 200 {object} response.Response "IBAN format retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "IBAN format not found"
+// @Security ApiKeyAuth
+// @Router /api/v1/iban/formats/{country_code} [get]
+func (h *IBANHTTPHandler) GetIBANFormatByCountryCode(c *fiber.Ctx) error {
+	countryCode := c.Params("country_code")
+
+	format, err := h.ibanService.GetIBANFormatByCountryCode(c.Context(), countryCode)
+	if err != nil {
+		return response.NotFound(c, "IBAN format not found: "+err.Error())
+	}
+
+	return response.Success(c, format, "IBAN format retrieved successfully")
+}
+
+// Request/Response DTOs
+
+type ValidateIBANRequest struct {
+	IBAN string `json:"iban" validate:"required"`
+}
@@ -1,9 +1,11 @@
 package http
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
 	"github.com/turahe/master-data-rest-api/internal/domain/services"
 	"github.com/turahe/master-data-rest-api/pkg/response"
@@ -13,38 +15,54 @@ import (
 type LanguageHTTPHandler struct {
 	languageService *services.LanguageService
 	searchService   repositories.SearchRepository
+	localizer       *Localizer
 }
 
 // NewLanguageHTTPHandler creates a new LanguageHTTPHandler instance
-func NewLanguageHTTPHandler(languageService *services.LanguageService, searchService repositories.SearchRepository) *LanguageHTTPHandler {
+func NewLanguageHTTPHandler(languageService *services.LanguageService, searchService repositories.SearchRepository, localizer *Localizer) *LanguageHTTPHandler {
 	return &LanguageHTTPHandler{
 		languageService: languageService,
 		searchService:   searchService,
+		localizer:       localizer,
 	}
 }
 
 // GetAllLanguages handles GET /api/v1/languages
 // @Summary Get all languages
-// @Description Get all languages with pagination
+// @Description Get all languages with pagination. Lists can be filtered with filter[field][op]=value (fields: name, code, is_active, created_at, updated_at; operators: eq, ne, lt, lte, gt, gte, like, in, null) and ordered with sort=-field,field.
 // @Tags languages
 // @Produce json
+// @Param filter[field][op] query string false "Filter, e.g. filter[is_active][eq]=false or filter[code][in]=en,id"
+// @Param sort query string false "Comma separated sort fields, prefixed with - for descending order, e.g. code"
 // @Param limit query int false "Limit" default(50)
 // @Param offset query int false "Offset" default(0)
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Languages retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Security ApiKeyAuth
 // @Router /api/v1/languages [get]
 func (h *LanguageHTTPHandler) GetAllLanguages(c *fiber.Ctx) error {
-	limit, _ := strconv.Atoi(c.Query("limit", "50"))
-	offset, _ := strconv.Atoi(c.Query("offset", "0"))
+	listQuery, err := parseListQuery(c, entities.LanguageListSchema)
+	if err != nil {
+		return response.BadRequest(c, err.Error())
+	}
 
-	languages, err := h.languageService.GetAllLanguages(c.Context(), limit, offset)
+	if !listQuery.IsEmpty() {
+		languages, err := h.languageService.ListLanguages(c.Context(), listQuery)
+		if err != nil {
+			return writeListError(c, err, "Failed to retrieve languages: ")
+		}
+		return h.localizer.Success(c, languages, "Languages retrieved successfully")
+	}
+
+	languages, err := h.languageService.GetAllLanguages(c.Context(), listQuery.Limit, listQuery.Offset)
 	if err != nil {
 		return response.InternalServerError(c, "Failed to retrieve languages: "+err.Error())
 	}
 
-	return response.Success(c, languages, "Languages retrieved successfully")
+	return h.localizer.Success(c, languages, "Languages retrieved successfully")
 }
 
 // SearchLanguages handles GET /api/v1/languages/search
@@ -55,6 +73,7 @@ func (h *LanguageHTTPHandler) GetAllLanguages(c *fiber.Ctx) error {
 // @Param q query string true "Search query"
 // @Param limit query int false "Limit" default(50)
 // @Param offset query int false "Offset" default(0)
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
 // @Success 200 {object} response.Response "Languages found"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Unauthorized"
@@ -75,7 +94,36 @@ func (h *LanguageHTTPHandler) SearchLanguages(c *fiber.Ctx) error {
 		return response.InternalServerError(c, "Failed to search languages: "+err.Error())
 	}
 
-	return response.Success(c, languages, "Languages found")
+	return h.localizer.Success(c, languages, "Languages found")
+}
+
+// GetLanguageByCode handles GET /api/v1/languages/:code
+// @Summary Get language by code
+// @Description Get a language by its code or any of its ISO 639-1, ISO 639-2/T, ISO 639-2/B or ISO 639-3 codes (e.g. de, deu or ger), with its native name, default ISO 15924 script and text direction
+// @Tags languages
+// @Produce json
+// @Param code path string true "Language code (ISO 639-1, ISO 639-2/T, ISO 639-2/B or ISO 639-3)"
+// @Param lang query string false "Language code to localize names to; the Accept-Language header is used when omitted"
+// @Success 200 {object} response.Response "Language retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Language not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/languages/{code} [get]
+func (h *LanguageHTTPHandler) GetLanguageByCode(c *fiber.Ctx) error {
+	language, err := h.languageService.GetLanguageByAnyCode(c.Context(), c.Params("code"))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidInput) {
+			return response.BadRequest(c, err.Error())
+		}
+		if errors.Is(err, services.ErrNotFound) {
+			return response.NotFound(c, err.Error())
+		}
+		return response.InternalServerError(c, "Failed to retrieve language: "+err.Error())
+	}
+
+	return h.localizer.Success(c, language, "Language retrieved successfully")
 }
 
 // Request/Response DTOs
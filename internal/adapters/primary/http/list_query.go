@@ -0,0 +1,67 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+	"github.com/turahe/master-data-rest-api/pkg/response"
+)
+
+const (
+	// defaultListLimit is the number of items a list request returns unless it gives a limit
+	defaultListLimit = 50
+	// maxListLimit caps the limit of list requests; larger limits return this many items
+	maxListLimit = 1000
+)
+
+// parseListQuery parses the filter[field][op], sort, limit and offset query parameters of a list request
+// against the whitelist of an entity
+func parseListQuery(c *fiber.Ctx, schema valueobjects.ListSchema) (valueobjects.ListQuery, error) {
+	params := make(map[string]string)
+	c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+		params[string(key)] = string(value)
+	})
+
+	query, err := valueobjects.ParseListQuery(schema, params)
+	if err != nil {
+		return valueobjects.ListQuery{}, err
+	}
+
+	if query.Limit, err = parsePageParam(c, "limit", defaultListLimit); err != nil {
+		return valueobjects.ListQuery{}, err
+	}
+	if query.Limit > maxListLimit {
+		query.Limit = maxListLimit
+	}
+	if query.Offset, err = parsePageParam(c, "offset", 0); err != nil {
+		return valueobjects.ListQuery{}, err
+	}
+
+	return query, nil
+}
+
+// parsePageParam parses the limit or offset query parameter, which must be a non-negative integer
+func parsePageParam(c *fiber.Ctx, name string, defaultValue int) (int, error) {
+	raw := c.Query(name)
+	if raw == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid %s '%s': must be a non-negative integer", name, raw)
+	}
+
+	return value, nil
+}
+
+// writeListError maps the errors of list queries to HTTP responses; filters the repository rejects are bad requests
+func writeListError(c *fiber.Ctx, err error, prefix string) error {
+	if errors.Is(err, valueobjects.ErrInvalidFilter) || errors.Is(err, valueobjects.ErrInvalidSort) {
+		return response.BadRequest(c, err.Error())
+	}
+	return response.InternalServerError(c, prefix+err.Error())
+}
@@ -0,0 +1,106 @@
+package http
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/pkg/response"
+)
+
+// LocaleHTTPHandler handles HTTP requests for locale operations
+type LocaleHTTPHandler struct {
+	localeService *services.LocaleService
+}
+
+// NewLocaleHTTPHandler creates a new LocaleHTTPHandler instance
+func NewLocaleHTTPHandler(localeService *services.LocaleService) *LocaleHTTPHandler {
+	return &LocaleHTTPHandler{
+		localeService: localeService,
+	}
+}
+
+// GetLocales handles GET /api/v1/locales
+// @Summary Get all locales
+// @Description Get locales combining a language and a country, with display names, first day of the week, date and time patterns, number separators and default currency
+// @Tags locales
+// @Produce json
+// @Param limit query int false "Limit" default(50)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} response.Response "Locales retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/locales [get]
+func (h *LocaleHTTPHandler) GetLocales(c *fiber.Ctx) error {
+	limit, _ := strconv.Atoi(c.Query("limit", "50"))
+	offset, _ := strconv.Atoi(c.Query("offset", "0"))
+
+	locales, err := h.localeService.GetLocales(c.Context(), limit, offset)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to retrieve locales: "+err.Error())
+	}
+
+	return response.Success(c, locales, "Locales retrieved successfully")
+}
+
+// NegotiateLocale handles GET /api/v1/locales/negotiate
+// @Summary Negotiate a locale
+// @Description Find the active locale that best matches an Accept-Language header. Language ranges are tried by quality; a range without an exact locale falls back to the default locale of its language (e.g. en-NZ to en-US), and the default locale en-US is returned when nothing matches.
+// @Tags locales
+// @Produce json
+// @Param Accept-Language header string false "Accept-Language header, e.g. id-ID,id;q=0.9,en;q=0.8"
+// @Param accept_language query string false "Accept-Language value overriding the header"
+// @Success 200 {object} response.Response "Locale negotiated successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Locale not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/locales/negotiate [get]
+func (h *LocaleHTTPHandler) NegotiateLocale(c *fiber.Ctx) error {
+	acceptLanguage := c.Query("accept_language")
+	if acceptLanguage == "" {
+		acceptLanguage = c.Get(fiber.HeaderAcceptLanguage)
+	}
+	c.Vary(fiber.HeaderAcceptLanguage)
+
+	match, err := h.localeService.NegotiateLocale(c.Context(), acceptLanguage)
+	if err != nil {
+		if errors.Is(err, services.ErrNotFound) {
+			return response.NotFound(c, err.Error())
+		}
+		return response.InternalServerError(c, "Failed to negotiate locale: "+err.Error())
+	}
+
+	c.Set(fiber.HeaderContentLanguage, match.Locale.Tag)
+	return response.Success(c, match, "Locale negotiated successfully")
+}
+
+// GetLocaleByTag handles GET /api/v1/locales/:tag
+// @Summary Get locale by tag
+// @Description Get a locale by its BCP 47 tag (e.g. id-ID; id_id is accepted) with its language, country and default currency
+// @Tags locales
+// @Produce json
+// @Param tag path string true "BCP 47 language tag"
+// @Success 200 {object} response.Response "Locale retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Locale not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/locales/{tag} [get]
+func (h *LocaleHTTPHandler) GetLocaleByTag(c *fiber.Ctx) error {
+	locale, err := h.localeService.GetLocaleByTag(c.Context(), c.Params("tag"))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidInput) {
+			return response.BadRequest(c, err.Error())
+		}
+		if errors.Is(err, services.ErrNotFound) {
+			return response.NotFound(c, err.Error())
+		}
+		return response.InternalServerError(c, "Failed to retrieve locale: "+err.Error())
+	}
+
+	return response.Success(c, locale, "Locale retrieved successfully")
+}
@@ -0,0 +1,100 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/pkg/response"
+)
+
+// Localizer localizes the translatable fields of responses to the language requested with the lang query
+// parameter or the Accept-Language header
+type Localizer struct {
+	translationService *services.TranslationService
+}
+
+// NewLocalizer creates a new Localizer instance
+func NewLocalizer(translationService *services.TranslationService) *Localizer {
+	return &Localizer{
+		translationService: translationService,
+	}
+}
+
+// Success localizes the banks, currencies, languages or geodirectories of the data, including related banks and
+// geodirectories, and writes a success response. Content-Language is set to the language of the response.
+// An unknown lang parameter is a bad request; when translations cannot be loaded the data is returned as is.
+func (l *Localizer) Success(c *fiber.Ctx, data interface{}, message string) error {
+	c.Vary(fiber.HeaderAcceptLanguage)
+
+	language, err := l.translationService.ResolveLanguage(c.Context(), c.Query("lang"), c.Get(fiber.HeaderAcceptLanguage))
+	if err != nil && errors.Is(err, services.ErrInvalidInput) {
+		return response.BadRequest(c, err.Error())
+	}
+
+	if err == nil && language != nil {
+		if err := l.translationService.Localize(c.Context(), language.Code, translatables(data)...); err == nil {
+			c.Set(fiber.HeaderContentLanguage, language.Code)
+		}
+	}
+
+	return response.Success(c, data, message)
+}
+
+// translatables collects the translatable entities of response data
+func translatables(data interface{}) []entities.Translatable {
+	var items []entities.Translatable
+
+	var addBank func(bank *entities.Bank)
+	addBank = func(bank *entities.Bank) {
+		if bank == nil {
+			return
+		}
+		items = append(items, bank)
+		addBank(bank.Successor)
+	}
+
+	var addGeodirectory func(geodirectory *entities.Geodirectory, parents, children bool)
+	addGeodirectory = func(geodirectory *entities.Geodirectory, parents, children bool) {
+		if geodirectory == nil {
+			return
+		}
+		items = append(items, geodirectory)
+		if parents {
+			addGeodirectory(geodirectory.Parent, true, false)
+		}
+		if children {
+			for _, child := range geodirectory.Children {
+				addGeodirectory(child, false, true)
+			}
+		}
+	}
+
+	switch value := data.(type) {
+	case *entities.Bank:
+		addBank(value)
+	case []*entities.Bank:
+		for _, bank := range value {
+			addBank(bank)
+		}
+	case *entities.Geodirectory:
+		addGeodirectory(value, true, true)
+	case []*entities.Geodirectory:
+		for _, geodirectory := range value {
+			addGeodirectory(geodirectory, true, true)
+		}
+	case []*entities.Currency:
+		for _, currency := range value {
+			items = append(items, currency)
+		}
+	case []*entities.Language:
+		for _, language := range value {
+			items = append(items, language)
+		}
+	case entities.Translatable:
+		items = append(items, value)
+	}
+
+	return items
+}
@@ -2,9 +2,11 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/pkg/response"
 )
@@ -44,9 +46,14 @@ func APIKeyAuth(apiKeyService APIKeyValidator) fiber.Handler {
 			return response.Unauthorized(c, "Invalid API key")
 		}
 
+		if !apiKeyIPAllowed(c, apiKey) {
+			return response.Forbidden(c, "API key is not allowed from this IP address")
+		}
+
 		// Store API key info in context for use in handlers
 		c.Locals("api_key", apiKey)
 		c.Locals("api_key_id", apiKey.ID.String())
+		c.Locals("api_key_identity_id", apiKey.IdentityID.String())
 		c.Locals("api_key_name", apiKey.Name)
 
 		// API key is valid, continue to the next handler
@@ -77,9 +84,14 @@ func APIKeyAuthWithHeader(apiKeyService APIKeyValidator, headerName string) fibe
 			return response.Unauthorized(c, "Invalid API key")
 		}
 
+		if !apiKeyIPAllowed(c, apiKey) {
+			return response.Forbidden(c, "API key is not allowed from this IP address")
+		}
+
 		// Store API key info in context for use in handlers
 		c.Locals("api_key", apiKey)
 		c.Locals("api_key_id", apiKey.ID.String())
+		c.Locals("api_key_identity_id", apiKey.IdentityID.String())
 		c.Locals("api_key_name", apiKey.Name)
 
 		// API key is valid, continue to the next handler
@@ -146,12 +158,73 @@ func OptionalAPIKeyAuth(apiKeyService APIKeyValidator) fiber.Handler {
 			return c.Next()
 		}
 
+		// A valid key used from outside its allowed ranges is rejected rather than treated as anonymous
+		if !apiKeyIPAllowed(c, apiKey) {
+			return response.Forbidden(c, "API key is not allowed from this IP address")
+		}
+
 		// Store API key info in context for use in handlers
 		c.Locals("api_key", apiKey)
 		c.Locals("api_key_id", apiKey.ID.String())
+		c.Locals("api_key_identity_id", apiKey.IdentityID.String())
 		c.Locals("api_key_name", apiKey.Name)
 
 		// API key is valid, continue to the next handler
 		return c.Next()
 	}
 }
+
+// apiKeyIPAllowed checks the client IP of a request against the allowed CIDR ranges of its API key and logs
+// denials. The client IP is taken from the proxy header only when the request comes from a trusted proxy.
+func apiKeyIPAllowed(c *fiber.Ctx, apiKey *entities.APIKey) bool {
+	if apiKey.IsIPAllowed(c.IP()) {
+		return true
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"api_key_id":          apiKey.ID.String(),
+		"api_key_identity_id": apiKey.IdentityID.String(),
+		"ip":                  c.IP(),
+		"method":              c.Method(),
+		"path":                c.Path(),
+	}).Warn("API key denied outside its allowed CIDR ranges")
+	return false
+}
+
+// RequireScopes creates a middleware that only allows requests authenticated with an API key holding all of
+// the scopes; requests missing a scope are forbidden with the scope named. It must run after APIKeyAuth or
+// OptionalAPIKeyAuth.
+func RequireScopes(scopes ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		apiKey, ok := c.Locals("api_key").(*entities.APIKey)
+		if !ok || apiKey == nil {
+			return response.Unauthorized(c, "API key is required")
+		}
+
+		return requireAPIKeyScopes(c, apiKey, scopes)
+	}
+}
+
+// RequireScopesIfAuthenticated creates a middleware like RequireScopes that lets anonymous requests through,
+// for routes open to anonymous callers when authentication is optional
+func RequireScopesIfAuthenticated(scopes ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		apiKey, ok := c.Locals("api_key").(*entities.APIKey)
+		if !ok || apiKey == nil {
+			return c.Next()
+		}
+
+		return requireAPIKeyScopes(c, apiKey, scopes)
+	}
+}
+
+// requireAPIKeyScopes continues with the next handler when the API key holds all of the scopes
+func requireAPIKeyScopes(c *fiber.Ctx, apiKey *entities.APIKey, scopes []string) error {
+	for _, scope := range scopes {
+		if !apiKey.HasScope(scope) {
+			return response.Forbidden(c, fmt.Sprintf("API key is missing the required scope '%s'", scope))
+		}
+	}
+
+	return c.Next()
+}
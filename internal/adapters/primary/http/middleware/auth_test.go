@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"io"
 	"net/http/httptest"
 	"testing"
 
@@ -160,3 +161,150 @@ func TestOptionalAPIKeyAuth_XAPIKeyHeader(t *testing.T) {
 	assert.Equal(t, 200, resp.StatusCode)
 	mockService.AssertExpectations(t)
 }
+
+func TestAPIKeyAuth_AllowedCIDRs(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		forwardedFor   string
+		expectedStatus int
+	}{
+		{"client IP in an allowed range", []string{"0.0.0.0"}, "203.0.113.7", 200},
+		{"IPv6 client IP in an allowed range", []string{"0.0.0.0"}, "2001:db8::7", 200},
+		{"client IP outside the allowed ranges", []string{"0.0.0.0"}, "198.51.100.7", 403},
+		{"proxy header of an untrusted proxy is ignored", []string{"192.0.2.1"}, "203.0.113.7", 403},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup: test requests come from 0.0.0.0
+			app := fiber.New(fiber.Config{
+				ProxyHeader:             fiber.HeaderXForwardedFor,
+				EnableTrustedProxyCheck: true,
+				TrustedProxies:          tt.trustedProxies,
+				EnableIPValidation:      true,
+			})
+			mockService := new(MockAPIKeyService)
+			apiKey := entities.NewAPIKey("Partner", "partner-key")
+			apiKey.SetAllowedCIDRs([]string{"203.0.113.0/24", "2001:db8::/32"})
+			mockService.On("ValidateAPIKey", mock.Anything, "partner-key").Return(apiKey, nil)
+
+			app.Use(APIKeyAuth(mockService))
+			app.Get("/test", func(c *fiber.Ctx) error {
+				return c.SendString("success")
+			})
+
+			// Test
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header.Set("Authorization", "Bearer partner-key")
+			req.Header.Set(fiber.HeaderXForwardedFor, tt.forwardedFor)
+			resp, _ := app.Test(req)
+
+			// Assertions
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestOptionalAPIKeyAuth_AllowedCIDRs(t *testing.T) {
+	// Setup: a valid key used from outside its allowed ranges is rejected, not treated as anonymous
+	app := fiber.New()
+	mockService := new(MockAPIKeyService)
+	apiKey := entities.NewAPIKey("Partner", "partner-key")
+	apiKey.SetAllowedCIDRs([]string{"203.0.113.0/24"})
+	mockService.On("ValidateAPIKey", mock.Anything, "partner-key").Return(apiKey, nil)
+
+	app.Use(OptionalAPIKeyAuth(mockService))
+	app.Get("/test", func(c *fiber.Ctx) error {
+		return c.SendString("success")
+	})
+
+	// Test
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-API-Key", "partner-key")
+	resp, _ := app.Test(req)
+
+	// Assertions
+	assert.Equal(t, 403, resp.StatusCode)
+}
+
+func TestRequireScopes(t *testing.T) {
+	tests := []struct {
+		name           string
+		authHeader     string
+		expectedStatus int
+		expectedScope  string
+	}{
+		{"anonymous request", "", 401, ""},
+		{"API key without the scope", "Bearer read-key", 403, "banks:write"},
+		{"API key with only one of the scopes", "Bearer banks-key", 403, "admin:api-keys"},
+		{"API key with the scopes", "Bearer admin-key", 200, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			app := fiber.New()
+			mockService := new(MockAPIKeyService)
+			mockService.On("ValidateAPIKey", mock.Anything, "read-key").Return(&entities.APIKey{ID: uuid.New(), Name: "Read", Scopes: []string{entities.ScopeBanksRead}}, nil)
+			mockService.On("ValidateAPIKey", mock.Anything, "banks-key").Return(&entities.APIKey{ID: uuid.New(), Name: "Banks", Scopes: []string{entities.ScopeBanksWrite}}, nil)
+			mockService.On("ValidateAPIKey", mock.Anything, "admin-key").Return(&entities.APIKey{ID: uuid.New(), Name: "Admin", Scopes: []string{entities.ScopeBanksWrite, entities.ScopeAdminAPIKeys}}, nil)
+
+			app.Use(OptionalAPIKeyAuth(mockService))
+			app.Post("/test", RequireScopes(entities.ScopeBanksWrite, entities.ScopeAdminAPIKeys), func(c *fiber.Ctx) error {
+				return c.SendString("success")
+			})
+
+			// Test
+			req := httptest.NewRequest("POST", "/test", nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			resp, _ := app.Test(req)
+
+			// Assertions
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			if tt.expectedScope != "" {
+				body, _ := io.ReadAll(resp.Body)
+				assert.Contains(t, string(body), tt.expectedScope)
+			}
+		})
+	}
+}
+
+func TestRequireScopesIfAuthenticated(t *testing.T) {
+	tests := []struct {
+		name           string
+		authHeader     string
+		expectedStatus int
+	}{
+		{"anonymous request", "", 200},
+		{"API key without the scope", "Bearer geo-key", 403},
+		{"API key with the write scope", "Bearer banks-key", 200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			app := fiber.New()
+			mockService := new(MockAPIKeyService)
+			mockService.On("ValidateAPIKey", mock.Anything, "geo-key").Return(&entities.APIKey{ID: uuid.New(), Name: "Geo", Scopes: []string{entities.ScopeGeoRead}}, nil)
+			mockService.On("ValidateAPIKey", mock.Anything, "banks-key").Return(&entities.APIKey{ID: uuid.New(), Name: "Banks", Scopes: []string{entities.ScopeBanksWrite}}, nil)
+
+			app.Use(OptionalAPIKeyAuth(mockService))
+			app.Get("/test", RequireScopesIfAuthenticated(entities.ScopeBanksRead), func(c *fiber.Ctx) error {
+				return c.SendString("success")
+			})
+
+			// Test
+			req := httptest.NewRequest("GET", "/test", nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			resp, _ := app.Test(req)
+
+			// Assertions
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+		})
+	}
+}
@@ -38,6 +38,10 @@ func RequestLoggerMiddleware(log *logger.Logger) fiber.Handler {
 		if apiKeyID := c.Locals("api_key_id"); apiKeyID != nil {
 			entry = entry.WithField("api_key_id", apiKeyID)
 		}
+		// The identity is shared by rotated keys, so requests can be followed across rotations
+		if identityID, ok := c.Locals("api_key_identity_id").(string); ok && identityID != "" {
+			entry = entry.WithField("api_key_identity_id", identityID)
+		}
 		if apiKeyName := c.Locals("api_key_name"); apiKeyName != nil {
 			entry = entry.WithField("api_key_name", apiKeyName)
 		}
@@ -2,12 +2,14 @@ package middleware
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/redis"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/pkg/response"
 )
 
@@ -172,9 +174,15 @@ func APIKeyBasedRateLimiter(rateLimiter *redis.RateLimiter, requests int, window
 	return RateLimiter(rateLimiter, config)
 }
 
-// TieredRateLimiter creates different rate limits based on request path or other criteria
+// TieredRateLimiter limits requests authenticated with an API key by the plan of the key, counted per key
+// identity so that rotating a key keeps its limits and quota, and anonymous requests by client IP with limits
+// based on the request path. It must run after APIKeyAuth or OptionalAPIKeyAuth.
 func TieredRateLimiter(rateLimiter *redis.RateLimiter) fiber.Handler {
 	return func(c *fiber.Ctx) error {
+		if apiKey, ok := c.Locals("api_key").(*entities.APIKey); ok && apiKey != nil {
+			return planRateLimit(c, rateLimiter, apiKey)
+		}
+
 		var requests int
 		var window time.Duration
 		var key string
@@ -218,3 +226,64 @@ func TieredRateLimiter(rateLimiter *redis.RateLimiter) fiber.Handler {
 		return RateLimiter(rateLimiter, config)(c)
 	}
 }
+
+// PlanLimitConfig returns the rate limit configuration of an API key plan at a time
+func PlanLimitConfig(plan entities.APIKeyPlan, at time.Time) redis.PlanLimitConfig {
+	config := redis.PlanLimitConfig{
+		RequestsPerMinute: plan.RequestsPerMinute,
+		Burst:             plan.Burst,
+		Quota:             plan.Quota,
+	}
+	if plan.Quota > 0 {
+		config.QuotaWindowStart, config.QuotaWindowEnd = plan.QuotaWindow(at)
+	}
+	return config
+}
+
+// planRateLimit limits a request by the plan of its API key
+func planRateLimit(c *fiber.Ctx, rateLimiter *redis.RateLimiter, apiKey *entities.APIKey) error {
+	identifier := apiKey.IdentityID.String()
+	result, err := rateLimiter.CheckPlanLimit(c.Context(), identifier, PlanLimitConfig(apiKey.Plan, time.Now()))
+	if err != nil {
+		// Log error but allow request to proceed
+		logrus.WithError(err).WithField("api_key_identity_id", identifier).Error("Plan limit check failed")
+		return c.Next()
+	}
+
+	c.Set("X-RateLimit-Limit", strconv.Itoa(apiKey.Plan.RequestsPerMinute))
+	c.Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	c.Set("X-RateLimit-Reset", strconv.FormatInt(result.ResetTime.Unix(), 10))
+	if apiKey.Plan.Quota > 0 {
+		_, quotaReset := apiKey.Plan.QuotaWindow(time.Now())
+		c.Set("X-Quota-Limit", strconv.FormatInt(apiKey.Plan.Quota, 10))
+		c.Set("X-Quota-Remaining", strconv.FormatInt(result.QuotaRemaining, 10))
+		c.Set("X-Quota-Reset", strconv.FormatInt(quotaReset.Unix(), 10))
+	}
+
+	if result.Allowed {
+		return c.Next()
+	}
+
+	retryAfter := int(math.Ceil(result.RetryAfter.Seconds()))
+	c.Set("X-RateLimit-RetryAfter", strconv.Itoa(retryAfter))
+	c.Set(fiber.HeaderRetryAfter, strconv.Itoa(retryAfter))
+
+	message := fmt.Sprintf("Rate limit of plan %s exceeded. Try again in %d seconds", apiKey.Plan.Name, retryAfter)
+	if result.QuotaExceeded {
+		message = fmt.Sprintf("%s quota of plan %s exhausted. Try again in %d seconds", quotaPeriodTitle(apiKey.Plan.QuotaPeriod), apiKey.Plan.Name, retryAfter)
+	}
+
+	return response.TooManyRequests(c, map[string]interface{}{
+		"retry_after":    retryAfter,
+		"reset_time":     result.ResetTime,
+		"quota_exceeded": result.QuotaExceeded,
+	}, message)
+}
+
+// quotaPeriodTitle returns the adjective of a quota period for messages
+func quotaPeriodTitle(period string) string {
+	if period == entities.QuotaPeriodMonth {
+		return "Monthly"
+	}
+	return "Daily"
+}
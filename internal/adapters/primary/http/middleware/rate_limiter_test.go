@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/redis"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+func TestPlanLimitConfig(t *testing.T) {
+	at := time.Date(2024, time.March, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("without quota", func(t *testing.T) {
+		config := PlanLimitConfig(entities.DefaultAPIKeyPlan(), at)
+
+		assert.Equal(t, 1000, config.RequestsPerMinute)
+		assert.Equal(t, 100, config.Burst)
+		assert.Equal(t, int64(0), config.Quota)
+		assert.True(t, config.QuotaWindowStart.IsZero())
+	})
+
+	t.Run("monthly quota", func(t *testing.T) {
+		plan := entities.APIKeyPlan{Name: "partner", RequestsPerMinute: 600, Burst: 50, Quota: 100000, QuotaPeriod: entities.QuotaPeriodMonth}
+
+		config := PlanLimitConfig(plan, at)
+
+		assert.Equal(t, int64(100000), config.Quota)
+		assert.Equal(t, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), config.QuotaWindowStart)
+		assert.Equal(t, time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), config.QuotaWindowEnd)
+	})
+}
+
+func TestTieredRateLimiter_APIKeyPlan(t *testing.T) {
+	// Setup: without a Redis client every request is allowed, which exercises the plan headers
+	app := fiber.New()
+	apiKey := entities.NewAPIKey("Partner", "key")
+	apiKey.Plan = entities.APIKeyPlan{Name: "partner", RequestsPerMinute: 600, Burst: 50, Quota: 100000, QuotaPeriod: entities.QuotaPeriodDay}
+
+	app.Use(func(c *fiber.Ctx) error {
+		if c.Get("Authorization") != "" {
+			c.Locals("api_key", apiKey)
+			c.Locals("api_key_id", apiKey.ID.String())
+		}
+		return c.Next()
+	})
+	app.Use(TieredRateLimiter(redis.NewRateLimiter(nil, logrus.New())))
+	app.Get("/api/v1/banks", func(c *fiber.Ctx) error {
+		return c.SendString("success")
+	})
+
+	t.Run("API key limited by its plan", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/banks", nil)
+		req.Header.Set("Authorization", "Bearer "+uuid.NewString())
+
+		resp, err := app.Test(req)
+
+		require.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "600", resp.Header.Get("X-RateLimit-Limit"))
+		assert.Equal(t, "50", resp.Header.Get("X-RateLimit-Remaining"))
+		assert.Equal(t, "100000", resp.Header.Get("X-Quota-Limit"))
+		assert.Equal(t, "100000", resp.Header.Get("X-Quota-Remaining"))
+	})
+
+	t.Run("anonymous request limited by IP", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/banks", nil)
+
+		resp, err := app.Test(req)
+
+		require.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "500", resp.Header.Get("X-RateLimit-Limit"))
+		assert.Empty(t, resp.Header.Get("X-Quota-Limit"))
+	})
+}
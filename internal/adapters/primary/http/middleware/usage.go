@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// UsageRecorder interface for counting the requests made with API keys
+type UsageRecorder interface {
+	RecordRequest(apiKeyID uuid.UUID, route string, status int, at time.Time)
+}
+
+// APIKeyUsage creates a middleware that counts the requests made with an API key per route and response
+// status. Routes are recorded as registered, e.g. /api/v1/banks/:id, so that counts do not depend on IDs in
+// the path. It must run after APIKeyAuth or OptionalAPIKeyAuth.
+func APIKeyUsage(recorder UsageRecorder) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		err := c.Next()
+
+		apiKey, ok := c.Locals("api_key").(*entities.APIKey)
+		if !ok || apiKey == nil {
+			return err
+		}
+
+		// Errors are turned into responses by the error handler after this middleware returns
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				status = fiberErr.Code
+			}
+		}
+
+		recorder.RecordRequest(apiKey.ID, c.Route().Path, status, time.Now())
+		return err
+	}
+}
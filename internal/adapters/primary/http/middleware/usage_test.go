@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// MockUsageRecorder is a mock implementation of UsageRecorder
+type MockUsageRecorder struct {
+	mock.Mock
+}
+
+func (m *MockUsageRecorder) RecordRequest(apiKeyID uuid.UUID, route string, status int, at time.Time) {
+	m.Called(apiKeyID, route, status, at)
+}
+
+func TestAPIKeyUsage(t *testing.T) {
+	// Setup
+	app := fiber.New()
+	recorder := new(MockUsageRecorder)
+	apiKey := entities.NewAPIKey("Partner", "partner-key")
+
+	app.Use(func(c *fiber.Ctx) error {
+		if c.Get("Authorization") != "" {
+			c.Locals("api_key", apiKey)
+		}
+		return c.Next()
+	})
+	app.Use(APIKeyUsage(recorder))
+	app.Get("/api/v1/banks/:id", func(c *fiber.Ctx) error {
+		if c.Params("id") == "missing" {
+			return fiber.NewError(fiber.StatusNotFound, "bank not found")
+		}
+		return c.SendString("success")
+	})
+
+	recorder.On("RecordRequest", apiKey.ID, "/api/v1/banks/:id", 200, mock.AnythingOfType("time.Time")).Return()
+	recorder.On("RecordRequest", apiKey.ID, "/api/v1/banks/:id", 404, mock.AnythingOfType("time.Time")).Return()
+
+	t.Run("requests with an API key are counted by route and status", func(t *testing.T) {
+		for _, id := range []string{uuid.NewString(), "missing"} {
+			req := httptest.NewRequest("GET", "/api/v1/banks/"+id, nil)
+			req.Header.Set("Authorization", "Bearer partner-key")
+
+			_, err := app.Test(req)
+
+			require.NoError(t, err)
+		}
+
+		recorder.AssertExpectations(t)
+	})
+
+	t.Run("anonymous requests are not counted", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/banks/"+uuid.NewString(), nil)
+
+		resp, err := app.Test(req)
+
+		require.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		recorder.AssertNumberOfCalls(t, "RecordRequest", 2)
+	})
+}
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/pkg/response"
+)
+
+// PaymentNetworkHTTPHandler handles HTTP requests for payment networks and bank network memberships
+type PaymentNetworkHTTPHandler struct {
+	networkService *services.PaymentNetworkService
+}
+
+// NewPaymentNetworkHTTPHandler creates a new PaymentNetworkHTTPHandler instance
+func NewPaymentNetworkHTTPHandler(networkService *services.PaymentNetworkService) *PaymentNetworkHTTPHandler {
+	return &PaymentNetworkHTTPHandler{
+		networkService: networkService,
+	}
+}
+
+// GetPaymentNetworks handles GET /api/v1/payment-networks
+// @Summary Get all payment networks
+// @Description Get the clearing and payment networks banks participate in (RTGS, SKN, BI-FAST, card switches)
+// @Tags payment-networks
+// @Produce json
+// @Success 200 {object} response.Response "Payment networks retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/payment-networks [get]
+func (h *PaymentNetworkHTTPHandler) GetPaymentNetworks(c *fiber.Ctx) error {
+	networks, err := h.networkService.GetAllNetworks(c.Context())
+	if err != nil {
+		return response.InternalServerError(c, "Failed to retrieve payment networks: "+err.Error())
+	}
+
+	return response.Success(c, networks, "Payment networks retrieved successfully")
+}
+
+// GetPaymentNetworkByCode handles GET /api/v1/payment-networks/:code
+// @Summary Get payment network by code
+// @Description Get a payment network by its code
+// @Tags payment-networks
+// @Produce json
+// @Param code path string true "Payment network code (e.g. BI-FAST)"
+// @Success 200 {object} response.Response "Payment network retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Payment network not found"
+// @Security ApiKeyAuth
+// @Router /api/v1/payment-networks/{code} [get]
+func (h *PaymentNetworkHTTPHandler) GetPaymentNetworkByCode(c *fiber.Ctx) error {
+	network, err := h.networkService.GetNetworkByCode(c.Context(), c.Params("code"))
+	if err != nil {
+		return response.NotFound(c, "Payment network not found: "+err.Error())
+	}
+
+	return response.Success(c, network, "Payment network retrieved successfully")
+}
+
+// GetBankNetworks handles GET /api/v1/banks/:code/networks
+// @Summary Get network memberships of a bank
+// @Description Get the payment networks a bank participates in with the network specific member code, status and effective dates
+// @Tags payment-networks
+// @Produce json
+// @Param code path string true "Bank Code"
+// @Success 200 {object} response.Response "Bank network memberships retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Bank not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/banks/{code}/networks [get]
+func (h *PaymentNetworkHTTPHandler) GetBankNetworks(c *fiber.Ctx) error {
+	memberships, err := h.networkService.GetBankMemberships(c.Context(), c.Params("code"))
+	if err != nil {
+		if errors.Is(err, services.ErrNotFound) {
+			return response.NotFound(c, "Bank not found: "+err.Error())
+		}
+		return response.InternalServerError(c, "Failed to retrieve bank network memberships: "+err.Error())
+	}
+
+	return response.Success(c, memberships, "Bank network memberships retrieved successfully")
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/sirupsen/logrus"
+	"github.com/turahe/master-data-rest-api/internal/adapters/primary/http/middleware"
 	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/redis"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/pkg/response"
 )
 
@@ -25,15 +27,19 @@ func NewRateLimitHTTPHandler(rateLimiter *redis.RateLimiter, log *logrus.Logger)
 
 // GetRateLimitInfo handles GET /api/v1/rate-limit/info
 // @Summary Get rate limit information
-// @Description Get current rate limit information for the requesting client
+// @Description Get current rate limit information for the requesting client. Requests authenticated with an API key get the plan of the key with the requests that can be made at once and the remaining quota; anonymous requests get the limits of the client IP.
 // @Tags rate-limit
 // @Produce json
-// @Param identifier query string false "Client identifier (IP, user ID, or API key)"
+// @Param identifier query string false "Client identifier of anonymous requests (IP or user ID)"
 // @Success 200 {object} response.Response "Rate limit information retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Router /api/v1/rate-limit/info [get]
 func (h *RateLimitHTTPHandler) GetRateLimitInfo(c *fiber.Ctx) error {
+	if apiKey, ok := c.Locals("api_key").(*entities.APIKey); ok && apiKey != nil {
+		return h.getPlanLimitInfo(c, apiKey)
+	}
+
 	identifier := c.Query("identifier")
 	if identifier == "" {
 		identifier = c.IP()
@@ -81,14 +87,53 @@ func (h *RateLimitHTTPHandler) GetRateLimitInfo(c *fiber.Ctx) error {
 	return response.Success(c, results, "Rate limit information retrieved successfully")
 }
 
+// getPlanLimitInfo writes the plan of an API key with its remaining requests and quota
+func (h *RateLimitHTTPHandler) getPlanLimitInfo(c *fiber.Ctx, apiKey *entities.APIKey) error {
+	now := time.Now()
+	result, err := h.rateLimiter.GetPlanLimitInfo(c.Context(), apiKey.IdentityID.String(), middleware.PlanLimitConfig(apiKey.Plan, now))
+	if err != nil {
+		h.log.WithError(err).WithField("api_key_id", apiKey.ID.String()).Error("Failed to get plan limit info")
+		return response.InternalServerError(c, "Failed to get rate limit information")
+	}
+
+	info := map[string]interface{}{
+		"api_key_id":          apiKey.ID,
+		"api_key_identity_id": apiKey.IdentityID,
+		"plan":                apiKey.Plan,
+		"rate": map[string]interface{}{
+			"allowed":             result.Allowed && !result.QuotaExceeded,
+			"remaining":           result.Remaining,
+			"reset_time":          result.ResetTime,
+			"requests_per_minute": apiKey.Plan.RequestsPerMinute,
+			"burst":               apiKey.Plan.Burst,
+		},
+	}
+
+	if apiKey.Plan.Quota > 0 {
+		periodStart, periodEnd := apiKey.Plan.QuotaWindow(now)
+		info["quota"] = map[string]interface{}{
+			"limit":        apiKey.Plan.Quota,
+			"used":         result.QuotaUsed,
+			"remaining":    result.QuotaRemaining,
+			"period":       apiKey.Plan.QuotaPeriod,
+			"period_start": periodStart,
+			"reset_time":   periodEnd,
+		}
+	}
+
+	return response.Success(c, info, "Rate limit information retrieved successfully")
+}
+
 // GetRateLimitStats handles GET /api/v1/rate-limit/stats
 // @Summary Get rate limit statistics
-// @Description Get comprehensive rate limit statistics across all clients
+// @Description Get comprehensive rate limit statistics across all clients. Requires the admin:rate-limit scope.
 // @Tags rate-limit
 // @Produce json
 // @Param key query string false "Rate limit key to get stats for (api, ip, user, apikey)"
 // @Success 200 {object} response.Response "Rate limit statistics retrieved successfully"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Router /api/v1/rate-limit/stats [get]
 func (h *RateLimitHTTPHandler) GetRateLimitStats(c *fiber.Ctx) error {
@@ -111,7 +156,7 @@ func (h *RateLimitHTTPHandler) GetRateLimitStats(c *fiber.Ctx) error {
 
 // ResetRateLimit handles POST /api/v1/rate-limit/reset
 // @Summary Reset rate limit for a client
-// @Description Reset rate limit for a specific client identifier
+// @Description Reset rate limit for a specific client identifier. Requires the admin:rate-limit scope.
 // @Tags rate-limit
 // @Accept json
 // @Produce json
@@ -119,6 +164,8 @@ func (h *RateLimitHTTPHandler) GetRateLimitStats(c *fiber.Ctx) error {
 // @Param key body string false "Rate limit key to reset (default: api)"
 // @Success 200 {object} response.Response "Rate limit reset successfully"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Router /api/v1/rate-limit/reset [post]
 func (h *RateLimitHTTPHandler) ResetRateLimit(c *fiber.Ctx) error {
@@ -161,10 +208,12 @@ func (h *RateLimitHTTPHandler) ResetRateLimit(c *fiber.Ctx) error {
 
 // GetRateLimitConfig handles GET /api/v1/rate-limit/config
 // @Summary Get rate limit configuration
-// @Description Get current rate limit configuration for different endpoints
+// @Description Get current rate limit configuration for different endpoints. Requires the admin:rate-limit scope.
 // @Tags rate-limit
 // @Produce json
 // @Success 200 {object} response.Response "Rate limit configuration retrieved successfully"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
 // @Router /api/v1/rate-limit/config [get]
 func (h *RateLimitHTTPHandler) GetRateLimitConfig(c *fiber.Ctx) error {
 	config := map[string]interface{}{
@@ -205,9 +254,9 @@ func (h *RateLimitHTTPHandler) GetRateLimitConfig(c *fiber.Ctx) error {
 				"key":      "user",
 			},
 			"apikey": map[string]interface{}{
-				"requests": 2000,
-				"window":   "1m",
-				"key":      "apikey",
+				"description":  "Requests with an API key are limited by the plan of the key instead of the tiered limits",
+				"default_plan": entities.DefaultAPIKeyPlan(),
+				"key":          "plan",
 			},
 		},
 	}
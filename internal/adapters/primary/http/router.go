@@ -8,6 +8,7 @@ import (
 	"github.com/turahe/master-data-rest-api/configs"
 	"github.com/turahe/master-data-rest-api/internal/adapters/primary/http/middleware"
 	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/redis"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/internal/domain/services"
 	"github.com/turahe/master-data-rest-api/pkg/logger"
 	"github.com/turahe/master-data-rest-api/pkg/response"
@@ -22,7 +23,17 @@ func SetupRouter(
 	bankHandler *BankHTTPHandler,
 	currencyHandler *CurrencyHTTPHandler,
 	languageHandler *LanguageHTTPHandler,
+	geoTypeHandler *GeoTypeHTTPHandler,
+	ibanHandler *IBANHTTPHandler,
+	bankBranchHandler *BankBranchHTTPHandler,
+	bankAccountRuleHandler *BankAccountRuleHTTPHandler,
+	paymentNetworkHandler *PaymentNetworkHTTPHandler,
+	exchangeRateHandler *ExchangeRateHTTPHandler,
+	countryCurrencyHandler *CountryCurrencyHTTPHandler,
+	localeHandler *LocaleHTTPHandler,
+	translationHandler *TranslationHTTPHandler,
 	apiKeyService *services.APIKeyService,
+	apiKeyUsageService *services.APIKeyUsageService,
 	geodirectoryService *services.GeodirectoryService,
 ) *fiber.App {
 	// Create Fiber app
@@ -31,6 +42,12 @@ func SetupRouter(
 			log.WithError(err).Error("Request failed")
 			return response.InternalServerError(c, "Internal server error")
 		},
+		// c.IP() reads the proxy header only for requests from trusted proxies, so clients cannot spoof
+		// the IP checked against the allowed CIDR ranges of API keys
+		ProxyHeader:             config.Server.ProxyHeader,
+		EnableTrustedProxyCheck: true,
+		TrustedProxies:          config.Server.TrustedProxies,
+		EnableIPValidation:      true,
 	})
 
 	// Initialize Redis manager and rate limiter
@@ -48,12 +65,6 @@ func SetupRouter(
 	app.Use(middleware.RequestLoggerMiddleware(log))
 	app.Use(middleware.ErrorLoggerMiddleware(log))
 
-	// Add rate limiting middleware if Redis is enabled
-	if redisManager.IsEnabled() {
-		app.Use(middleware.TieredRateLimiter(rateLimiter))
-		log.Info("Rate limiting middleware enabled")
-	}
-
 	// Health check endpoint
 	app.Get("/health", func(c *fiber.Ctx) error {
 		return c.JSON(fiber.Map{
@@ -78,30 +89,63 @@ func SetupRouter(
 		api.Use(middleware.OptionalAPIKeyAuth(apiKeyService))
 	}
 
+	// Count the requests of API keys, including those rejected by the rate limiter
+	api.Use(middleware.APIKeyUsage(apiKeyUsageService))
+
+	// Add rate limiting middleware if Redis is enabled; it runs after authentication so that API keys are
+	// limited by their plan rather than by client IP
+	if redisManager.IsEnabled() {
+		api.Use(middleware.TieredRateLimiter(rateLimiter))
+		log.Info("Rate limiting middleware enabled")
+	}
+
+	// Scopes required per route. Read scopes are checked for API keys only when authentication is optional,
+	// so that anonymous callers can still read; write and admin scopes always require an API key.
+	requireScopes := middleware.RequireScopes
+	requireReadScope := middleware.RequireScopesIfAuthenticated
+	if config.Auth.Required {
+		requireReadScope = middleware.RequireScopes
+	}
+	geoRead := requireReadScope(entities.ScopeGeoRead)
+	banksRead := requireReadScope(entities.ScopeBanksRead)
+	banksWrite := requireScopes(entities.ScopeBanksWrite)
+	currenciesRead := requireReadScope(entities.ScopeCurrenciesRead)
+	languagesRead := requireReadScope(entities.ScopeLanguagesRead)
+	translationsRead := requireReadScope(entities.ScopeTranslationsRead)
+	translationsWrite := requireScopes(entities.ScopeTranslationsWrite)
+	adminAPIKeys := requireScopes(entities.ScopeAdminAPIKeys)
+	adminRateLimit := requireScopes(entities.ScopeAdminRateLimit)
+
 	// Rate limit management routes (only if Redis is enabled)
 	if redisManager.IsEnabled() {
 		rateLimitHandler := NewRateLimitHTTPHandler(rateLimiter, log.Logger)
 		rateLimits := api.Group("/rate-limit")
 		rateLimits.Get("/info", rateLimitHandler.GetRateLimitInfo)
-		rateLimits.Get("/stats", rateLimitHandler.GetRateLimitStats)
-		rateLimits.Get("/config", rateLimitHandler.GetRateLimitConfig)
-		rateLimits.Post("/reset", rateLimitHandler.ResetRateLimit)
+		rateLimits.Get("/stats", adminRateLimit, rateLimitHandler.GetRateLimitStats)
+		rateLimits.Get("/config", adminRateLimit, rateLimitHandler.GetRateLimitConfig)
+		rateLimits.Post("/reset", adminRateLimit, rateLimitHandler.ResetRateLimit)
 	}
 
 	// Geodirectory routes
 	geodirectories := api.Group("/geodirectories")
-	geodirectories.Get("/", geodirectoryHandler.GetAllGeodirectories)
-	geodirectories.Get("/search", geodirectoryHandler.SearchGeodirectories)
-	geodirectories.Get("/type/:type", geodirectoryHandler.GetGeodirectoriesByType)
-	geodirectories.Get("/:id", geodirectoryHandler.GetGeodirectoryByID)
-	geodirectories.Get("/:id/hierarchy", geodirectoryHandler.GetGeodirectoryWithHierarchy)
-	geodirectories.Get("/:id/children", geodirectoryHandler.GetChildren)
-	geodirectories.Get("/:id/ancestors", geodirectoryHandler.GetAncestors)
-	geodirectories.Get("/:id/descendants", geodirectoryHandler.GetDescendants)
+	geodirectories.Get("/", geoRead, geodirectoryHandler.GetAllGeodirectories)
+	geodirectories.Get("/search", geoRead, geodirectoryHandler.SearchGeodirectories)
+	geodirectories.Get("/type/:type", geoRead, geodirectoryHandler.GetGeodirectoriesByType)
+	geodirectories.Get("/hierarchy-schemas/:country_code", geoRead, geodirectoryHandler.GetHierarchySchema)
+	geodirectories.Get("/:id", geoRead, geodirectoryHandler.GetGeodirectoryByID)
+	geodirectories.Get("/:id/hierarchy", geoRead, geodirectoryHandler.GetGeodirectoryWithHierarchy)
+	geodirectories.Get("/:id/children", geoRead, geodirectoryHandler.GetChildren)
+	geodirectories.Get("/:id/ancestors", geoRead, geodirectoryHandler.GetAncestors)
+	geodirectories.Get("/:id/descendants", geoRead, geodirectoryHandler.GetDescendants)
+
+	// Geo type registry routes
+	geoTypes := api.Group("/geo-types")
+	geoTypes.Get("/", geoRead, geoTypeHandler.GetAllGeoTypes)
+	geoTypes.Get("/:code", geoRead, geoTypeHandler.GetGeoTypeByCode)
 
 	// Backward compatibility routes for countries, provinces, cities, etc.
 	countries := api.Group("/countries")
-	countries.Get("/", func(c *fiber.Ctx) error {
+	countries.Get("/", geoRead, func(c *fiber.Ctx) error {
 		limit := 50
 		offset := 0
 		geodirectories, err := geodirectoryService.GetCountries(c.Context(), limit, offset)
@@ -110,9 +154,10 @@ func SetupRouter(
 		}
 		return response.Success(c, geodirectories, "Countries retrieved successfully")
 	})
+	countries.Get("/:code/currencies", currenciesRead, countryCurrencyHandler.GetCountryCurrencies)
 
 	provinces := api.Group("/provinces")
-	provinces.Get("/", func(c *fiber.Ctx) error {
+	provinces.Get("/", geoRead, func(c *fiber.Ctx) error {
 		limit := 50
 		offset := 0
 		geodirectories, err := geodirectoryService.GetProvinces(c.Context(), limit, offset)
@@ -123,7 +168,7 @@ func SetupRouter(
 	})
 
 	cities := api.Group("/cities")
-	cities.Get("/", func(c *fiber.Ctx) error {
+	cities.Get("/", geoRead, func(c *fiber.Ctx) error {
 		limit := 50
 		offset := 0
 		geodirectories, err := geodirectoryService.GetCities(c.Context(), limit, offset)
@@ -134,7 +179,7 @@ func SetupRouter(
 	})
 
 	districts := api.Group("/districts")
-	districts.Get("/", func(c *fiber.Ctx) error {
+	districts.Get("/", geoRead, func(c *fiber.Ctx) error {
 		limit := 50
 		offset := 0
 		geodirectories, err := geodirectoryService.GetDistricts(c.Context(), limit, offset)
@@ -145,7 +190,7 @@ func SetupRouter(
 	})
 
 	villages := api.Group("/villages")
-	villages.Get("/", func(c *fiber.Ctx) error {
+	villages.Get("/", geoRead, func(c *fiber.Ctx) error {
 		limit := 50
 		offset := 0
 		geodirectories, err := geodirectoryService.GetVillages(c.Context(), limit, offset)
@@ -157,28 +202,85 @@ func SetupRouter(
 
 	// API key management routes
 	apiKeys := api.Group("/api-keys")
-	apiKeys.Post("/", apiKeyHandler.CreateAPIKey)
-	apiKeys.Get("/", apiKeyHandler.GetAllAPIKeys)
-	apiKeys.Get("/:id", apiKeyHandler.GetAPIKeyByID)
-	apiKeys.Put("/:id", apiKeyHandler.UpdateAPIKey)
-	apiKeys.Post("/:id/activate", apiKeyHandler.ActivateAPIKey)
-	apiKeys.Post("/:id/deactivate", apiKeyHandler.DeactivateAPIKey)
-	apiKeys.Delete("/:id", apiKeyHandler.DeleteAPIKey)
+	apiKeys.Post("/", adminAPIKeys, apiKeyHandler.CreateAPIKey)
+	apiKeys.Get("/", adminAPIKeys, apiKeyHandler.GetAllAPIKeys)
+	apiKeys.Get("/:id", adminAPIKeys, apiKeyHandler.GetAPIKeyByID)
+	apiKeys.Put("/:id", adminAPIKeys, apiKeyHandler.UpdateAPIKey)
+	apiKeys.Post("/:id/activate", adminAPIKeys, apiKeyHandler.ActivateAPIKey)
+	apiKeys.Post("/:id/deactivate", adminAPIKeys, apiKeyHandler.DeactivateAPIKey)
+	apiKeys.Post("/:id/rotate", adminAPIKeys, apiKeyHandler.RotateAPIKey)
+	apiKeys.Get("/:id/history", adminAPIKeys, apiKeyHandler.GetAPIKeyHistory)
+	apiKeys.Get("/:id/usage", adminAPIKeys, apiKeyHandler.GetAPIKeyUsage)
+	apiKeys.Delete("/:id", adminAPIKeys, apiKeyHandler.DeleteAPIKey)
 
 	// Bank routes
 	banks := api.Group("/banks")
-	banks.Get("/", bankHandler.GetBanks)
-	banks.Get("/:code", bankHandler.GetBankByCode)
+	banks.Get("/", banksRead, bankHandler.GetBanks)
+	banks.Post("/", banksWrite, bankHandler.CreateBank)
+	banks.Get("/bic/:bic", banksRead, bankHandler.GetBanksByBIC)
+	banks.Get("/:code", banksRead, bankHandler.GetBankByCode)
+	banks.Put("/:code", banksWrite, bankHandler.UpdateBank)
+	banks.Delete("/:code", banksWrite, bankHandler.DeleteBank)
+	banks.Get("/:code/history", banksRead, bankHandler.GetBankHistory)
+	banks.Put("/:code/status", banksWrite, bankHandler.ChangeBankStatus)
+
+	// Bank branch routes
+	banks.Get("/:code/branches", banksRead, bankBranchHandler.GetBranches)
+	banks.Post("/:code/branches", banksWrite, bankBranchHandler.CreateBranch)
+	banks.Get("/:code/branches/:branch_code", banksRead, bankBranchHandler.GetBranchByCode)
+	banks.Put("/:code/branches/:branch_code", banksWrite, bankBranchHandler.UpdateBranch)
+	banks.Delete("/:code/branches/:branch_code", banksWrite, bankBranchHandler.DeleteBranch)
+	api.Get("/bank-branches/search", banksRead, bankBranchHandler.SearchBranches)
+
+	// Bank account number rule routes
+	banks.Post("/:code/validate-account", banksRead, bankAccountRuleHandler.ValidateAccount)
+	banks.Get("/:code/account-rules", banksRead, bankAccountRuleHandler.GetAccountRule)
+	banks.Put("/:code/account-rules", banksWrite, bankAccountRuleHandler.SaveAccountRule)
+	banks.Delete("/:code/account-rules", banksWrite, bankAccountRuleHandler.DeleteAccountRule)
+
+	// Payment network routes
+	banks.Get("/:code/networks", banksRead, paymentNetworkHandler.GetBankNetworks)
+	paymentNetworks := api.Group("/payment-networks")
+	paymentNetworks.Get("/", banksRead, paymentNetworkHandler.GetPaymentNetworks)
+	paymentNetworks.Get("/:code", banksRead, paymentNetworkHandler.GetPaymentNetworkByCode)
+
+	// IBAN routes
+	iban := api.Group("/iban")
+	iban.Post("/validate", banksRead, ibanHandler.ValidateIBAN)
+	iban.Get("/formats", banksRead, ibanHandler.GetIBANFormats)
+	iban.Get("/formats/:country_code", banksRead, ibanHandler.GetIBANFormatByCountryCode)
 
 	// Currency routes
 	currencies := api.Group("/currencies")
-	currencies.Get("/", currencyHandler.GetCurrencies)
-	currencies.Get("/:code", currencyHandler.GetCurrencyByCode)
+	currencies.Get("/", currenciesRead, currencyHandler.GetCurrencies)
+	currencies.Post("/format", currenciesRead, currencyHandler.FormatAmounts)
+	currencies.Get("/numeric/:num", currenciesRead, currencyHandler.GetCurrencyByNumericCode)
+	currencies.Get("/:code", currenciesRead, currencyHandler.GetCurrencyByCode)
+	currencies.Get("/:code/format", currenciesRead, currencyHandler.FormatAmount)
+	currencies.Post("/:code/round", currenciesRead, currencyHandler.RoundAmounts)
+	currencies.Get("/:code/countries", currenciesRead, countryCurrencyHandler.GetCurrencyCountries)
+
+	// Exchange rate routes
+	api.Get("/exchange-rates", currenciesRead, exchangeRateHandler.GetExchangeRates)
+	api.Get("/convert", currenciesRead, exchangeRateHandler.Convert)
 
 	// Language routes
 	languages := api.Group("/languages")
-	languages.Get("/", languageHandler.GetAllLanguages)
-	languages.Get("/search", languageHandler.SearchLanguages)
+	languages.Get("/", languagesRead, languageHandler.GetAllLanguages)
+	languages.Get("/search", languagesRead, languageHandler.SearchLanguages)
+	languages.Get("/:code", languagesRead, languageHandler.GetLanguageByCode)
+
+	// Locale routes
+	locales := api.Group("/locales")
+	locales.Get("/", languagesRead, localeHandler.GetLocales)
+	locales.Get("/negotiate", languagesRead, localeHandler.NegotiateLocale)
+	locales.Get("/:tag", languagesRead, localeHandler.GetLocaleByTag)
+
+	// Translation routes
+	translations := api.Group("/translations")
+	translations.Get("/", translationsRead, translationHandler.GetTranslations)
+	translations.Put("/", translationsWrite, translationHandler.SaveTranslations)
+	translations.Delete("/", translationsWrite, translationHandler.DeleteTranslations)
 
 	return app
 }
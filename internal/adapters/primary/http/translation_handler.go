@@ -0,0 +1,156 @@
+package http
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/services"
+	"github.com/turahe/master-data-rest-api/pkg/response"
+)
+
+// TranslationHTTPHandler handles HTTP requests for managing translations of master data
+type TranslationHTTPHandler struct {
+	translationService *services.TranslationService
+}
+
+// NewTranslationHTTPHandler creates a new TranslationHTTPHandler instance
+func NewTranslationHTTPHandler(translationService *services.TranslationService) *TranslationHTTPHandler {
+	return &TranslationHTTPHandler{
+		translationService: translationService,
+	}
+}
+
+// GetTranslations handles GET /api/v1/translations
+// @Summary Get translations
+// @Description Get translations of master data fields. Translations can be filtered with filter[field][op]=value (fields: entity_type, entity_id, field, language_code, value, updated_at) and ordered with sort=-field,field. Translatable fields: bank name, alias and company; currency, language and geodirectory name. API keys need the translations:read scope, which translations:write includes.
+// @Tags translations
+// @Produce json
+// @Param filter[field][op] query string false "Filter, e.g. filter[entity_type][eq]=bank or filter[language_code][in]=id,ms"
+// @Param sort query string false "Comma separated sort fields, prefixed with - for descending order, e.g. -updated_at"
+// @Param limit query int false "Limit" default(50)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} response.Response "Translations retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/translations [get]
+func (h *TranslationHTTPHandler) GetTranslations(c *fiber.Ctx) error {
+	listQuery, err := parseListQuery(c, entities.TranslationListSchema)
+	if err != nil {
+		return response.BadRequest(c, err.Error())
+	}
+
+	translations, err := h.translationService.ListTranslations(c.Context(), listQuery)
+	if err != nil {
+		return writeListError(c, err, "Failed to retrieve translations: ")
+	}
+
+	return response.Success(c, translations, "Translations retrieved successfully")
+}
+
+// SaveTranslations handles PUT /api/v1/translations
+// @Summary Create or update translations in bulk
+// @Description Create or update up to 1000 translations. Language codes must be a language of tm_languages (any of its ISO 639 codes is accepted and stored as its code). Nothing is saved when any translation is invalid. Requires the translations:write scope.
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param request body SaveTranslationsRequest true "Translations"
+// @Success 200 {object} response.Response "Translations saved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/translations [put]
+func (h *TranslationHTTPHandler) SaveTranslations(c *fiber.Ctx) error {
+	var req SaveTranslationsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
+
+	translations := make([]*entities.Translation, 0, len(req.Translations))
+	for _, item := range req.Translations {
+		translations = append(translations, entities.NewTranslation(
+			strings.TrimSpace(item.EntityType), item.EntityID, strings.TrimSpace(item.Field),
+			item.LanguageCode, strings.TrimSpace(item.Value),
+		))
+	}
+
+	if err := h.translationService.SaveTranslations(c.Context(), translations); err != nil {
+		return h.writeError(c, err, "Failed to save translations: ")
+	}
+
+	return response.Success(c, translations, "Translations saved successfully")
+}
+
+// DeleteTranslations handles DELETE /api/v1/translations
+// @Summary Delete translations in bulk
+// @Description Delete up to 1000 translations by entity type, entity ID, field and language code. Keys without a translation are ignored; nothing is deleted when any key is invalid. Requires the translations:write scope.
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param request body DeleteTranslationsRequest true "Translation keys"
+// @Success 200 {object} response.Response "Translations deleted successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/translations [delete]
+func (h *TranslationHTTPHandler) DeleteTranslations(c *fiber.Ctx) error {
+	var req DeleteTranslationsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body: "+err.Error())
+	}
+
+	keys := make([]entities.TranslationKey, 0, len(req.Translations))
+	for _, item := range req.Translations {
+		keys = append(keys, entities.TranslationKey{
+			EntityType:   strings.TrimSpace(item.EntityType),
+			EntityID:     item.EntityID,
+			Field:        strings.TrimSpace(item.Field),
+			LanguageCode: item.LanguageCode,
+		})
+	}
+
+	deleted, err := h.translationService.DeleteTranslations(c.Context(), keys)
+	if err != nil {
+		return h.writeError(c, err, "Failed to delete translations: ")
+	}
+
+	return response.Success(c, DeleteTranslationsResponse{Deleted: deleted}, "Translations deleted successfully")
+}
+
+// writeError maps translation service errors to HTTP responses
+func (h *TranslationHTTPHandler) writeError(c *fiber.Ctx, err error, prefix string) error {
+	if errors.Is(err, services.ErrInvalidInput) {
+		return response.BadRequest(c, err.Error())
+	}
+	return response.InternalServerError(c, prefix+err.Error())
+}
+
+// Request/Response DTOs
+
+type TranslationRequest struct {
+	EntityType   string    `json:"entity_type" validate:"required"`
+	EntityID     uuid.UUID `json:"entity_id" validate:"required"`
+	Field        string    `json:"field" validate:"required"`
+	LanguageCode string    `json:"language_code" validate:"required"`
+	Value        string    `json:"value" validate:"required"`
+}
+
+type SaveTranslationsRequest struct {
+	Translations []TranslationRequest `json:"translations" validate:"required"`
+}
+
+type DeleteTranslationsRequest struct {
+	Translations []entities.TranslationKey `json:"translations" validate:"required"`
+}
+
+type DeleteTranslationsResponse struct {
+	Deleted int64 `json:"deleted"`
+}
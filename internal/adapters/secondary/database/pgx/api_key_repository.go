@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
 )
 
 // APIKeyRepository implements the APIKeyRepository interface using pgx
@@ -25,22 +26,33 @@ func NewAPIKeyRepository(pool *pgxpool.Pool) *APIKeyRepository {
 
 // Create creates a new API key in the database
 func (r *APIKeyRepository) Create(ctx context.Context, apiKey *entities.APIKey) error {
+	return r.insert(ctx, r.pool, apiKey)
+}
+
+// insert inserts an API key with the pool or a transaction
+func (r *APIKeyRepository) insert(ctx context.Context, db executor, apiKey *entities.APIKey) error {
 	if apiKey.ID == uuid.Nil {
 		apiKey.ID = uuid.New()
 	}
+	if apiKey.IdentityID == uuid.Nil {
+		apiKey.IdentityID = apiKey.ID
+	}
 	apiKey.CreatedAt = time.Now()
 	apiKey.UpdatedAt = time.Now()
 
 	query := `
 		INSERT INTO tm_api_keys (
-			id, name, key, description, is_active, expires_at, last_used_at, created_at, updated_at
+			id, identity_id, name, key_hash, key_prefix, description, scopes, allowed_cidrs,
+			plan_name, requests_per_minute, burst, quota, quota_period,
+			is_active, expires_at, last_used_at, rotated_from_id, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
 		)`
 
-	_, err := r.pool.Exec(ctx, query,
-		apiKey.ID, apiKey.Name, apiKey.Key, apiKey.Description, apiKey.IsActive,
-		apiKey.ExpiresAt, apiKey.LastUsedAt, apiKey.CreatedAt, apiKey.UpdatedAt,
+	_, err := db.Exec(ctx, query,
+		apiKey.ID, apiKey.IdentityID, apiKey.Name, apiKey.KeyHash, apiKey.KeyPrefix, apiKey.Description, apiKey.Scopes, apiKey.AllowedCIDRs,
+		apiKey.Plan.Name, apiKey.Plan.RequestsPerMinute, apiKey.Plan.Burst, apiKey.Plan.Quota, apiKey.Plan.QuotaPeriod, apiKey.IsActive,
+		apiKey.ExpiresAt, apiKey.LastUsedAt, apiKey.RotatedFromID, apiKey.CreatedAt, apiKey.UpdatedAt,
 	)
 
 	return err
@@ -49,7 +61,10 @@ func (r *APIKeyRepository) Create(ctx context.Context, apiKey *entities.APIKey)
 // GetByID retrieves an API key by its ID
 func (r *APIKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.APIKey, error) {
 	query := `
-		SELECT id, name, key, description, is_active, expires_at, last_used_at, created_at, updated_at, deleted_at
+		SELECT id, identity_id, name, key_hash, key_prefix, description, scopes, allowed_cidrs,
+			plan_name, requests_per_minute, burst, quota, quota_period,
+			is_active, expires_at, last_used_at, rotated_from_id, replaced_by_id, rotation_deadline,
+			created_at, updated_at, deleted_at
 		FROM tm_api_keys
 		WHERE id = $1 AND deleted_at IS NULL`
 
@@ -57,13 +72,15 @@ func (r *APIKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities
 	row := r.pool.QueryRow(ctx, query, id)
 
 	err := row.Scan(
-		&apiKey.ID, &apiKey.Name, &apiKey.Key, &apiKey.Description, &apiKey.IsActive,
-		&apiKey.ExpiresAt, &apiKey.LastUsedAt, &apiKey.CreatedAt, &apiKey.UpdatedAt, &apiKey.DeletedAt,
+		&apiKey.ID, &apiKey.IdentityID, &apiKey.Name, &apiKey.KeyHash, &apiKey.KeyPrefix, &apiKey.Description, &apiKey.Scopes, &apiKey.AllowedCIDRs,
+		&apiKey.Plan.Name, &apiKey.Plan.RequestsPerMinute, &apiKey.Plan.Burst, &apiKey.Plan.Quota, &apiKey.Plan.QuotaPeriod,
+		&apiKey.IsActive, &apiKey.ExpiresAt, &apiKey.LastUsedAt, &apiKey.RotatedFromID, &apiKey.ReplacedByID, &apiKey.RotationDeadline,
+		&apiKey.CreatedAt, &apiKey.UpdatedAt, &apiKey.DeletedAt,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("API key not found")
+			return nil, fmt.Errorf("API key %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -71,19 +88,24 @@ func (r *APIKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities
 	return &apiKey, nil
 }
 
-// GetByKey retrieves an API key by its key value
-func (r *APIKeyRepository) GetByKey(ctx context.Context, key string) (*entities.APIKey, error) {
+// GetByKeyHash retrieves an API key by the hash of its key
+func (r *APIKeyRepository) GetByKeyHash(ctx context.Context, keyHash string) (*entities.APIKey, error) {
 	query := `
-		SELECT id, name, key, description, is_active, expires_at, last_used_at, created_at, updated_at, deleted_at
+		SELECT id, identity_id, name, key_hash, key_prefix, description, scopes, allowed_cidrs,
+			plan_name, requests_per_minute, burst, quota, quota_period,
+			is_active, expires_at, last_used_at, rotated_from_id, replaced_by_id, rotation_deadline,
+			created_at, updated_at, deleted_at
 		FROM tm_api_keys
-		WHERE key = $1 AND deleted_at IS NULL`
+		WHERE key_hash = $1 AND deleted_at IS NULL`
 
 	var apiKey entities.APIKey
-	row := r.pool.QueryRow(ctx, query, key)
+	row := r.pool.QueryRow(ctx, query, keyHash)
 
 	err := row.Scan(
-		&apiKey.ID, &apiKey.Name, &apiKey.Key, &apiKey.Description, &apiKey.IsActive,
-		&apiKey.ExpiresAt, &apiKey.LastUsedAt, &apiKey.CreatedAt, &apiKey.UpdatedAt, &apiKey.DeletedAt,
+		&apiKey.ID, &apiKey.IdentityID, &apiKey.Name, &apiKey.KeyHash, &apiKey.KeyPrefix, &apiKey.Description, &apiKey.Scopes, &apiKey.AllowedCIDRs,
+		&apiKey.Plan.Name, &apiKey.Plan.RequestsPerMinute, &apiKey.Plan.Burst, &apiKey.Plan.Quota, &apiKey.Plan.QuotaPeriod,
+		&apiKey.IsActive, &apiKey.ExpiresAt, &apiKey.LastUsedAt, &apiKey.RotatedFromID, &apiKey.ReplacedByID, &apiKey.RotationDeadline,
+		&apiKey.CreatedAt, &apiKey.UpdatedAt, &apiKey.DeletedAt,
 	)
 
 	if err != nil {
@@ -99,7 +121,10 @@ func (r *APIKeyRepository) GetByKey(ctx context.Context, key string) (*entities.
 // GetAll retrieves all API keys with optional pagination
 func (r *APIKeyRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.APIKey, error) {
 	query := `
-		SELECT id, name, key, description, is_active, expires_at, last_used_at, created_at, updated_at, deleted_at
+		SELECT id, identity_id, name, key_hash, key_prefix, description, scopes, allowed_cidrs,
+			plan_name, requests_per_minute, burst, quota, quota_period,
+			is_active, expires_at, last_used_at, rotated_from_id, replaced_by_id, rotation_deadline,
+			created_at, updated_at, deleted_at
 		FROM tm_api_keys
 		WHERE deleted_at IS NULL
 		ORDER BY created_at DESC
@@ -115,8 +140,10 @@ func (r *APIKeyRepository) GetAll(ctx context.Context, limit, offset int) ([]*en
 	for rows.Next() {
 		var apiKey entities.APIKey
 		err := rows.Scan(
-			&apiKey.ID, &apiKey.Name, &apiKey.Key, &apiKey.Description, &apiKey.IsActive,
-			&apiKey.ExpiresAt, &apiKey.LastUsedAt, &apiKey.CreatedAt, &apiKey.UpdatedAt, &apiKey.DeletedAt,
+			&apiKey.ID, &apiKey.IdentityID, &apiKey.Name, &apiKey.KeyHash, &apiKey.KeyPrefix, &apiKey.Description, &apiKey.Scopes, &apiKey.AllowedCIDRs,
+			&apiKey.Plan.Name, &apiKey.Plan.RequestsPerMinute, &apiKey.Plan.Burst, &apiKey.Plan.Quota, &apiKey.Plan.QuotaPeriod,
+			&apiKey.IsActive, &apiKey.ExpiresAt, &apiKey.LastUsedAt, &apiKey.RotatedFromID, &apiKey.ReplacedByID, &apiKey.RotationDeadline,
+			&apiKey.CreatedAt, &apiKey.UpdatedAt, &apiKey.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -137,13 +164,15 @@ func (r *APIKeyRepository) Update(ctx context.Context, apiKey *entities.APIKey)
 
 	query := `
 		UPDATE tm_api_keys SET
-			name = $2, description = $3, is_active = $4, expires_at = $5,
-			last_used_at = $6, updated_at = $7
+			name = $2, description = $3, scopes = $4, is_active = $5, expires_at = $6,
+			last_used_at = $7, updated_at = $8, plan_name = $9, requests_per_minute = $10,
+			burst = $11, quota = $12, quota_period = $13, allowed_cidrs = $14
 		WHERE id = $1 AND deleted_at IS NULL`
 
 	result, err := r.pool.Exec(ctx, query,
-		apiKey.ID, apiKey.Name, apiKey.Description, apiKey.IsActive,
-		apiKey.ExpiresAt, apiKey.LastUsedAt, apiKey.UpdatedAt,
+		apiKey.ID, apiKey.Name, apiKey.Description, apiKey.Scopes, apiKey.IsActive,
+		apiKey.ExpiresAt, apiKey.LastUsedAt, apiKey.UpdatedAt, apiKey.Plan.Name, apiKey.Plan.RequestsPerMinute,
+		apiKey.Plan.Burst, apiKey.Plan.Quota, apiKey.Plan.QuotaPeriod, apiKey.AllowedCIDRs,
 	)
 
 	if err != nil {
@@ -151,7 +180,26 @@ func (r *APIKeyRepository) Update(ctx context.Context, apiKey *entities.APIKey)
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("API key not found")
+		return fmt.Errorf("API key %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// UpdateKeyHash replaces the stored hash of an API key
+func (r *APIKeyRepository) UpdateKeyHash(ctx context.Context, id uuid.UUID, keyHash string) error {
+	query := `
+		UPDATE tm_api_keys SET
+			key_hash = $2, updated_at = $3
+		WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := r.pool.Exec(ctx, query, id, keyHash, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("API key %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -171,7 +219,7 @@ func (r *APIKeyRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID) err
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("API key not found")
+		return fmt.Errorf("API key %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -191,7 +239,7 @@ func (r *APIKeyRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("API key not found")
+		return fmt.Errorf("API key %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -210,7 +258,7 @@ func (r *APIKeyRepository) Activate(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("API key not found")
+		return fmt.Errorf("API key %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -229,12 +277,98 @@ func (r *APIKeyRepository) Deactivate(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("API key not found")
+		return fmt.Errorf("API key %w", repositories.ErrNotFound)
 	}
 
 	return nil
 }
 
+// GetByIdentityID retrieves the API keys sharing an identity, oldest first
+func (r *APIKeyRepository) GetByIdentityID(ctx context.Context, identityID uuid.UUID) ([]*entities.APIKey, error) {
+	query := `
+		SELECT id, identity_id, name, key_hash, key_prefix, description, scopes, allowed_cidrs,
+			plan_name, requests_per_minute, burst, quota, quota_period,
+			is_active, expires_at, last_used_at, rotated_from_id, replaced_by_id, rotation_deadline,
+			created_at, updated_at, deleted_at
+		FROM tm_api_keys
+		WHERE identity_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at ASC`
+
+	rows, err := r.pool.Query(ctx, query, identityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var apiKeys []*entities.APIKey
+	for rows.Next() {
+		var apiKey entities.APIKey
+		err := rows.Scan(
+			&apiKey.ID, &apiKey.IdentityID, &apiKey.Name, &apiKey.KeyHash, &apiKey.KeyPrefix, &apiKey.Description, &apiKey.Scopes, &apiKey.AllowedCIDRs,
+			&apiKey.Plan.Name, &apiKey.Plan.RequestsPerMinute, &apiKey.Plan.Burst, &apiKey.Plan.Quota, &apiKey.Plan.QuotaPeriod,
+			&apiKey.IsActive, &apiKey.ExpiresAt, &apiKey.LastUsedAt, &apiKey.RotatedFromID, &apiKey.ReplacedByID, &apiKey.RotationDeadline,
+			&apiKey.CreatedAt, &apiKey.UpdatedAt, &apiKey.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, &apiKey)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return apiKeys, nil
+}
+
+// Rotate creates the successor of an API key and links the key to it in a single transaction. It fails when
+// the key has already been rotated.
+func (r *APIKeyRepository) Rotate(ctx context.Context, apiKey *entities.APIKey, successor *entities.APIKey) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := r.insert(ctx, tx, successor); err != nil {
+		return err
+	}
+
+	apiKey.UpdatedAt = time.Now()
+	query := `
+		UPDATE tm_api_keys SET
+			replaced_by_id = $2, rotation_deadline = $3, updated_at = $4
+		WHERE id = $1 AND deleted_at IS NULL AND replaced_by_id IS NULL`
+
+	result, err := tx.Exec(ctx, query, apiKey.ID, apiKey.ReplacedByID, apiKey.RotationDeadline, apiKey.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("API key %w or already rotated", repositories.ErrNotFound)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// DeactivateRotated deactivates the rotated API keys whose rotation deadline has passed and returns how many
+// were deactivated
+func (r *APIKeyRepository) DeactivateRotated(ctx context.Context, now time.Time) (int64, error) {
+	query := `
+		UPDATE tm_api_keys SET
+			is_active = false, updated_at = $1
+		WHERE is_active = true AND rotation_deadline <= $1 AND deleted_at IS NULL`
+
+	result, err := r.pool.Exec(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
 // Count returns the total number of active API keys
 func (r *APIKeyRepository) Count(ctx context.Context) (int64, error) {
 	query := "SELECT COUNT(*) FROM tm_api_keys WHERE deleted_at IS NULL"
@@ -247,7 +381,10 @@ func (r *APIKeyRepository) Count(ctx context.Context) (int64, error) {
 // Search searches API keys by name
 func (r *APIKeyRepository) Search(ctx context.Context, query string, limit, offset int) ([]*entities.APIKey, error) {
 	searchQuery := `
-		SELECT id, name, key, description, is_active, expires_at, last_used_at, created_at, updated_at, deleted_at
+		SELECT id, identity_id, name, key_hash, key_prefix, description, scopes, allowed_cidrs,
+			plan_name, requests_per_minute, burst, quota, quota_period,
+			is_active, expires_at, last_used_at, rotated_from_id, replaced_by_id, rotation_deadline,
+			created_at, updated_at, deleted_at
 		FROM tm_api_keys
 		WHERE deleted_at IS NULL AND (name ILIKE $1 OR description ILIKE $1)
 		ORDER BY created_at DESC
@@ -264,8 +401,10 @@ func (r *APIKeyRepository) Search(ctx context.Context, query string, limit, offs
 	for rows.Next() {
 		var apiKey entities.APIKey
 		err := rows.Scan(
-			&apiKey.ID, &apiKey.Name, &apiKey.Key, &apiKey.Description, &apiKey.IsActive,
-			&apiKey.ExpiresAt, &apiKey.LastUsedAt, &apiKey.CreatedAt, &apiKey.UpdatedAt, &apiKey.DeletedAt,
+			&apiKey.ID, &apiKey.IdentityID, &apiKey.Name, &apiKey.KeyHash, &apiKey.KeyPrefix, &apiKey.Description, &apiKey.Scopes, &apiKey.AllowedCIDRs,
+			&apiKey.Plan.Name, &apiKey.Plan.RequestsPerMinute, &apiKey.Plan.Burst, &apiKey.Plan.Quota, &apiKey.Plan.QuotaPeriod,
+			&apiKey.IsActive, &apiKey.ExpiresAt, &apiKey.LastUsedAt, &apiKey.RotatedFromID, &apiKey.ReplacedByID, &apiKey.RotationDeadline,
+			&apiKey.CreatedAt, &apiKey.UpdatedAt, &apiKey.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -279,29 +418,3 @@ func (r *APIKeyRepository) Search(ctx context.Context, query string, limit, offs
 
 	return apiKeys, nil
 }
-
-// ValidateKey validates an API key and returns it if valid
-func (r *APIKeyRepository) ValidateKey(ctx context.Context, key string) (*entities.APIKey, error) {
-	apiKey, err := r.GetByKey(ctx, key)
-	if err != nil {
-		return nil, err
-	}
-
-	if apiKey == nil {
-		return nil, nil // Key not found
-	}
-
-	// Check if the key is valid (active and not expired)
-	if !apiKey.IsValid() {
-		return nil, nil // Key is invalid
-	}
-
-	// Update last used timestamp
-	apiKey.UpdateLastUsed()
-	if err := r.UpdateLastUsed(ctx, apiKey.ID); err != nil {
-		// Log the error but don't fail the validation
-		// The key is still valid even if we can't update the timestamp
-	}
-
-	return apiKey, nil
-}
@@ -0,0 +1,83 @@
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// APIKeyUsageRepository implements the APIKeyUsageRepository interface using pgx
+type APIKeyUsageRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAPIKeyUsageRepository creates a new APIKeyUsageRepository instance
+func NewAPIKeyUsageRepository(pool *pgxpool.Pool) *APIKeyUsageRepository {
+	return &APIKeyUsageRepository{
+		pool: pool,
+	}
+}
+
+// Increment adds usage counts to the stored counts in a single transaction
+func (r *APIKeyUsageRepository) Increment(ctx context.Context, usage []*entities.APIKeyUsage) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO tm_api_key_usage (api_key_id, granularity, period_start, route, status_class, requests, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (api_key_id, granularity, period_start, route, status_class)
+		DO UPDATE SET requests = tm_api_key_usage.requests + EXCLUDED.requests, updated_at = EXCLUDED.updated_at`
+
+	now := time.Now()
+	for _, count := range usage {
+		_, err := tx.Exec(ctx, query,
+			count.APIKeyID, count.Granularity, count.PeriodStart, count.Route, count.StatusClass, count.Requests, now,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetByAPIKey retrieves the usage counts of an API key at a granularity for the periods starting in [from, to)
+func (r *APIKeyUsageRepository) GetByAPIKey(ctx context.Context, apiKeyID uuid.UUID, granularity string, from, to time.Time) ([]*entities.APIKeyUsage, error) {
+	query := `
+		SELECT api_key_id, granularity, period_start, route, status_class, requests
+		FROM tm_api_key_usage
+		WHERE api_key_id = $1 AND granularity = $2 AND period_start >= $3 AND period_start < $4
+		ORDER BY period_start, route, status_class`
+
+	rows, err := r.pool.Query(ctx, query, apiKeyID, granularity, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	usage := []*entities.APIKeyUsage{}
+	for rows.Next() {
+		var count entities.APIKeyUsage
+		err := rows.Scan(
+			&count.APIKeyID, &count.Granularity, &count.PeriodStart, &count.Route, &count.StatusClass, &count.Requests,
+		)
+		if err != nil {
+			return nil, err
+		}
+		count.PeriodStart = count.PeriodStart.UTC()
+		usage = append(usage, &count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
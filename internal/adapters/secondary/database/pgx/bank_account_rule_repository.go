@@ -0,0 +1,166 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// BankAccountRuleRepository implements the BankAccountRuleRepository interface using pgx
+type BankAccountRuleRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBankAccountRuleRepository creates a new BankAccountRuleRepository instance
+func NewBankAccountRuleRepository(pool *pgxpool.Pool) *BankAccountRuleRepository {
+	return &BankAccountRuleRepository{
+		pool: pool,
+	}
+}
+
+// Create creates new account number rules in the database
+func (r *BankAccountRuleRepository) Create(ctx context.Context, rule *entities.BankAccountRule) error {
+	rule.GenerateID()
+	rule.CreatedAt = time.Now()
+	rule.UpdatedAt = time.Now()
+
+	query := `
+		INSERT INTO tm_bank_account_rules (id, bank_id, lengths, digits_only, check_digit_algorithm, prefix_patterns,
+			created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := r.pool.Exec(ctx, query,
+		rule.ID, rule.BankID, nonNilInts(rule.Lengths), rule.DigitsOnly, rule.CheckDigitAlgorithm,
+		nonNilStrings(rule.PrefixPatterns), rule.CreatedAt, rule.UpdatedAt,
+	)
+
+	return err
+}
+
+// GetByBankID retrieves the account number rules of a bank
+func (r *BankAccountRuleRepository) GetByBankID(ctx context.Context, bankID uuid.UUID) (*entities.BankAccountRule, error) {
+	query := `
+		SELECT id, bank_id, lengths, digits_only, check_digit_algorithm, prefix_patterns, created_at, updated_at
+		FROM tm_bank_account_rules
+		WHERE bank_id = $1`
+
+	var rule entities.BankAccountRule
+	err := r.pool.QueryRow(ctx, query, bankID).Scan(
+		&rule.ID, &rule.BankID, &rule.Lengths, &rule.DigitsOnly, &rule.CheckDigitAlgorithm, &rule.PrefixPatterns,
+		&rule.CreatedAt, &rule.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("bank account rule %w", repositories.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// GetAll retrieves the account number rules of all banks with pagination
+func (r *BankAccountRuleRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.BankAccountRule, error) {
+	query := `
+		SELECT id, bank_id, lengths, digits_only, check_digit_algorithm, prefix_patterns, created_at, updated_at
+		FROM tm_bank_account_rules
+		ORDER BY bank_id
+		LIMIT $1 OFFSET $2`
+
+	rows, err := r.pool.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*entities.BankAccountRule
+	for rows.Next() {
+		var rule entities.BankAccountRule
+		err := rows.Scan(
+			&rule.ID, &rule.BankID, &rule.Lengths, &rule.DigitsOnly, &rule.CheckDigitAlgorithm, &rule.PrefixPatterns,
+			&rule.CreatedAt, &rule.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// Update updates existing account number rules
+func (r *BankAccountRuleRepository) Update(ctx context.Context, rule *entities.BankAccountRule) error {
+	rule.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE tm_bank_account_rules SET
+			lengths = $2, digits_only = $3, check_digit_algorithm = $4, prefix_patterns = $5, updated_at = $6
+		WHERE bank_id = $1`
+
+	result, err := r.pool.Exec(ctx, query,
+		rule.BankID, nonNilInts(rule.Lengths), rule.DigitsOnly, rule.CheckDigitAlgorithm,
+		nonNilStrings(rule.PrefixPatterns), rule.UpdatedAt,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("bank account rule %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// DeleteByBankID deletes the account number rules of a bank
+func (r *BankAccountRuleRepository) DeleteByBankID(ctx context.Context, bankID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, "DELETE FROM tm_bank_account_rules WHERE bank_id = $1", bankID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("bank account rule %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Truncate removes all bank account rule records efficiently using TRUNCATE
+func (r *BankAccountRuleRepository) Truncate(ctx context.Context) error {
+	query := `TRUNCATE TABLE tm_bank_account_rules RESTART IDENTITY CASCADE`
+	_, err := r.pool.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to truncate bank account rules table: %w", err)
+	}
+	return nil
+}
+
+// nonNilInts returns an empty slice instead of nil so that NOT NULL array columns store '{}'
+func nonNilInts(values []int) []int {
+	if values == nil {
+		return []int{}
+	}
+	return values
+}
+
+// nonNilStrings returns an empty slice instead of nil so that NOT NULL array columns store '{}'
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,226 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// BankBranchRepository implements the BankBranchRepository interface using pgx
+type BankBranchRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBankBranchRepository creates a new BankBranchRepository instance
+func NewBankBranchRepository(pool *pgxpool.Pool) *BankBranchRepository {
+	return &BankBranchRepository{
+		pool: pool,
+	}
+}
+
+// Create creates a new bank branch in the database
+func (r *BankBranchRepository) Create(ctx context.Context, branch *entities.BankBranch) error {
+	branch.GenerateID()
+	branch.CreatedAt = time.Now()
+	branch.UpdatedAt = time.Now()
+
+	query := `
+		INSERT INTO tm_bank_branches (id, bank_id, geodirectory_id, name, code, address, bic, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.pool.Exec(ctx, query,
+		branch.ID, branch.BankID, branch.GeodirectoryID, branch.Name, branch.Code, branch.Address, branch.BIC,
+		branch.CreatedAt, branch.UpdatedAt,
+	)
+
+	return err
+}
+
+// GetByID retrieves a bank branch by its ID
+func (r *BankBranchRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.BankBranch, error) {
+	query := `
+		SELECT id, bank_id, geodirectory_id, name, code, address, bic, created_at, updated_at
+		FROM tm_bank_branches
+		WHERE id = $1`
+
+	return r.scanBranch(r.pool.QueryRow(ctx, query, id))
+}
+
+// GetAll retrieves all bank branches with pagination
+func (r *BankBranchRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.BankBranch, error) {
+	query := `
+		SELECT id, bank_id, geodirectory_id, name, code, address, bic, created_at, updated_at
+		FROM tm_bank_branches
+		ORDER BY bank_id, name
+		LIMIT $1 OFFSET $2`
+
+	rows, err := r.pool.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanBranches(rows)
+}
+
+// Update updates an existing bank branch
+func (r *BankBranchRepository) Update(ctx context.Context, branch *entities.BankBranch) error {
+	branch.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE tm_bank_branches SET
+			geodirectory_id = $2, name = $3, code = $4, address = $5, bic = $6, updated_at = $7
+		WHERE id = $1`
+
+	result, err := r.pool.Exec(ctx, query,
+		branch.ID, branch.GeodirectoryID, branch.Name, branch.Code, branch.Address, branch.BIC, branch.UpdatedAt,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("bank branch %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Delete deletes a bank branch by ID
+func (r *BankBranchRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, "DELETE FROM tm_bank_branches WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("bank branch %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// CountByBank returns the number of branches of a bank
+func (r *BankBranchRepository) CountByBank(ctx context.Context, bankID uuid.UUID) (int64, error) {
+	query := "SELECT COUNT(*) FROM tm_bank_branches WHERE bank_id = $1"
+
+	var count int64
+	err := r.pool.QueryRow(ctx, query, bankID).Scan(&count)
+	return count, err
+}
+
+// GetByBankAndCode retrieves a branch of a bank by its branch code
+func (r *BankBranchRepository) GetByBankAndCode(ctx context.Context, bankID uuid.UUID, code string) (*entities.BankBranch, error) {
+	query := `
+		SELECT id, bank_id, geodirectory_id, name, code, address, bic, created_at, updated_at
+		FROM tm_bank_branches
+		WHERE bank_id = $1 AND code = $2`
+
+	return r.scanBranch(r.pool.QueryRow(ctx, query, bankID, code))
+}
+
+// GetByBank retrieves the branches of a bank with pagination
+func (r *BankBranchRepository) GetByBank(ctx context.Context, bankID uuid.UUID, limit, offset int) ([]*entities.BankBranch, error) {
+	query := `
+		SELECT id, bank_id, geodirectory_id, name, code, address, bic, created_at, updated_at
+		FROM tm_bank_branches
+		WHERE bank_id = $1
+		ORDER BY name
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.pool.Query(ctx, query, bankID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanBranches(rows)
+}
+
+// GetByBankWithinGeodirectory retrieves the branches of a bank located in a geodirectory node or
+// any of its descendants using the nested set model
+func (r *BankBranchRepository) GetByBankWithinGeodirectory(ctx context.Context, bankID, geodirectoryID uuid.UUID, limit, offset int) ([]*entities.BankBranch, error) {
+	query := `
+		SELECT b.id, b.bank_id, b.geodirectory_id, b.name, b.code, b.address, b.bic, b.created_at, b.updated_at
+		FROM tm_geodirectories p
+		JOIN tm_geodirectories g ON g.record_left BETWEEN p.record_left AND p.record_right
+		JOIN tm_bank_branches b ON b.geodirectory_id = g.id
+		WHERE p.id = $2 AND b.bank_id = $1
+		ORDER BY b.name
+		LIMIT $3 OFFSET $4`
+
+	rows, err := r.pool.Query(ctx, query, bankID, geodirectoryID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanBranches(rows)
+}
+
+// ExistsByBankAndCode checks if a bank already has a branch with the given code
+func (r *BankBranchRepository) ExistsByBankAndCode(ctx context.Context, bankID uuid.UUID, code string) (bool, error) {
+	query := "SELECT EXISTS(SELECT 1 FROM tm_bank_branches WHERE bank_id = $1 AND code = $2)"
+
+	var exists bool
+	err := r.pool.QueryRow(ctx, query, bankID, code).Scan(&exists)
+	return exists, err
+}
+
+// scanBranch is a helper method to scan a single row into a bank branch entity
+func (r *BankBranchRepository) scanBranch(row pgx.Row) (*entities.BankBranch, error) {
+	var branch entities.BankBranch
+	err := row.Scan(
+		&branch.ID, &branch.BankID, &branch.GeodirectoryID, &branch.Name, &branch.Code, &branch.Address, &branch.BIC,
+		&branch.CreatedAt, &branch.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("bank branch %w", repositories.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return &branch, nil
+}
+
+// scanBranches is a helper method to scan rows into bank branch entities
+func (r *BankBranchRepository) scanBranches(rows pgx.Rows) ([]*entities.BankBranch, error) {
+	var branches []*entities.BankBranch
+
+	for rows.Next() {
+		var branch entities.BankBranch
+		err := rows.Scan(
+			&branch.ID, &branch.BankID, &branch.GeodirectoryID, &branch.Name, &branch.Code, &branch.Address, &branch.BIC,
+			&branch.CreatedAt, &branch.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, &branch)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return branches, nil
+}
+
+// Truncate removes all bank branch records efficiently using TRUNCATE
+func (r *BankBranchRepository) Truncate(ctx context.Context) error {
+	query := `TRUNCATE TABLE tm_bank_branches RESTART IDENTITY CASCADE`
+	_, err := r.pool.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to truncate bank branches table: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// BankHistoryRepository implements the BankHistoryRepository interface using pgx
+type BankHistoryRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBankHistoryRepository creates a new BankHistoryRepository instance
+func NewBankHistoryRepository(pool *pgxpool.Pool) *BankHistoryRepository {
+	return &BankHistoryRepository{
+		pool: pool,
+	}
+}
+
+// Create creates a new bank history record in the database
+func (r *BankHistoryRepository) Create(ctx context.Context, record *entities.BankHistoryRecord) error {
+	return insertBankHistoryRecord(ctx, r.pool, record)
+}
+
+// insertBankHistoryRecord inserts a bank history record with the pool or a transaction
+func insertBankHistoryRecord(ctx context.Context, db executor, record *entities.BankHistoryRecord) error {
+	record.GenerateID()
+	record.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO tm_bank_history (id, bank_id, name, alias, company, code, bic, status, valid_from, valid_until, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	_, err := db.Exec(ctx, query,
+		record.ID, record.BankID, record.Name, record.Alias, record.Company, record.Code, record.BIC, record.Status,
+		record.ValidFrom, record.ValidUntil, record.CreatedAt,
+	)
+
+	return err
+}
+
+// GetByBank retrieves the history of a bank, most recent first
+func (r *BankHistoryRepository) GetByBank(ctx context.Context, bankID uuid.UUID) ([]*entities.BankHistoryRecord, error) {
+	query := `
+		SELECT id, bank_id, name, alias, company, code, bic, status, valid_from, valid_until, created_at
+		FROM tm_bank_history
+		WHERE bank_id = $1
+		ORDER BY valid_until DESC`
+
+	rows, err := r.pool.Query(ctx, query, bankID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []*entities.BankHistoryRecord
+	for rows.Next() {
+		var record entities.BankHistoryRecord
+		err := rows.Scan(
+			&record.ID, &record.BankID, &record.Name, &record.Alias, &record.Company, &record.Code, &record.BIC,
+			&record.Status, &record.ValidFrom, &record.ValidUntil, &record.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, &record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// GetLatestByCode retrieves the most recent history record of a former bank code
+func (r *BankHistoryRepository) GetLatestByCode(ctx context.Context, code string) (*entities.BankHistoryRecord, error) {
+	query := `
+		SELECT id, bank_id, name, alias, company, code, bic, status, valid_from, valid_until, created_at
+		FROM tm_bank_history
+		WHERE code = $1
+		ORDER BY valid_until DESC
+		LIMIT 1`
+
+	var record entities.BankHistoryRecord
+	err := r.pool.QueryRow(ctx, query, code).Scan(
+		&record.ID, &record.BankID, &record.Name, &record.Alias, &record.Company, &record.Code, &record.BIC,
+		&record.Status, &record.ValidFrom, &record.ValidUntil, &record.CreatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("bank history %w", repositories.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return &record, nil
+}
@@ -7,10 +7,28 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
+// bankListColumns maps the fields of entities.BankListSchema to their columns
+var bankListColumns = map[string]string{
+	"name":            "name",
+	"alias":           "alias",
+	"company":         "company",
+	"code":            "code",
+	"bic":             "bic",
+	"status":          "status",
+	"effective_from":  "effective_from",
+	"effective_until": "effective_until",
+	"successor_id":    "successor_id",
+	"created_at":      "created_at",
+	"updated_at":      "updated_at",
+}
+
 // BankRepository implements the BankRepository interface using pgx
 type BankRepository struct {
 	pool *pgxpool.Pool
@@ -28,13 +46,18 @@ func (r *BankRepository) Create(ctx context.Context, bank *entities.Bank) error
 	bank.GenerateID()
 	bank.CreatedAt = time.Now()
 	bank.UpdatedAt = time.Now()
+	if bank.Status == "" {
+		bank.Status = entities.BankStatusActive
+	}
 
 	query := `
-		INSERT INTO tm_banks (id, name, alias, company, code, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+		INSERT INTO tm_banks (id, name, alias, company, code, bic, status, effective_from, effective_until, successor_id,
+			created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
 
 	_, err := r.pool.Exec(ctx, query,
-		bank.ID, bank.Name, bank.Alias, bank.Company, bank.Code,
+		bank.ID, bank.Name, bank.Alias, bank.Company, bank.Code, bank.BIC,
+		bank.Status, bank.EffectiveFrom, bank.EffectiveUntil, bank.SuccessorID,
 		bank.CreatedAt, bank.UpdatedAt,
 	)
 
@@ -44,7 +67,8 @@ func (r *BankRepository) Create(ctx context.Context, bank *entities.Bank) error
 // GetByID retrieves a bank by its ID
 func (r *BankRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Bank, error) {
 	query := `
-		SELECT id, name, alias, company, code, created_at, updated_at
+		SELECT id, name, alias, company, code, bic, status, effective_from, effective_until, successor_id,
+			created_at, updated_at
 		FROM tm_banks
 		WHERE id = $1`
 
@@ -52,13 +76,14 @@ func (r *BankRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.B
 	row := r.pool.QueryRow(ctx, query, id)
 
 	err := row.Scan(
-		&bank.ID, &bank.Name, &bank.Alias, &bank.Company, &bank.Code,
+		&bank.ID, &bank.Name, &bank.Alias, &bank.Company, &bank.Code, &bank.BIC,
+		&bank.Status, &bank.EffectiveFrom, &bank.EffectiveUntil, &bank.SuccessorID,
 		&bank.CreatedAt, &bank.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("bank not found")
+			return nil, fmt.Errorf("bank %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -69,7 +94,8 @@ func (r *BankRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.B
 // GetAll retrieves all banks with pagination
 func (r *BankRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.Bank, error) {
 	query := `
-		SELECT id, name, alias, company, code, created_at, updated_at
+		SELECT id, name, alias, company, code, bic, status, effective_from, effective_until, successor_id,
+			created_at, updated_at
 		FROM tm_banks
 		ORDER BY name
 		LIMIT $1 OFFSET $2`
@@ -83,17 +109,70 @@ func (r *BankRepository) GetAll(ctx context.Context, limit, offset int) ([]*enti
 	return r.scanBanks(rows)
 }
 
+// List retrieves the banks matching the filters of a list query in the requested order
+func (r *BankRepository) List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Bank, error) {
+	sql, args, err := buildListQuery(`
+		SELECT id, name, alias, company, code, bic, status, effective_from, effective_until, successor_id,
+			created_at, updated_at
+		FROM tm_banks`, query, bankListColumns, "name, id")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanBanks(rows)
+}
+
 // Update updates an existing bank
 func (r *BankRepository) Update(ctx context.Context, bank *entities.Bank) error {
+	return r.update(ctx, r.pool, bank)
+}
+
+// UpdateWithHistory updates a bank and stores the history record of its previous state, if any, in one
+// transaction, so a bank never changes without its former name and code staying resolvable
+func (r *BankRepository) UpdateWithHistory(ctx context.Context, bank *entities.Bank, record *entities.BankHistoryRecord) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := r.update(ctx, tx, bank); err != nil {
+		return err
+	}
+
+	if record != nil {
+		if err := insertBankHistoryRecord(ctx, tx, record); err != nil {
+			return fmt.Errorf("failed to record bank history: %w", err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// executor is implemented by both the pool and transactions
+type executor interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+// update updates a bank with the pool or a transaction
+func (r *BankRepository) update(ctx context.Context, db executor, bank *entities.Bank) error {
 	bank.UpdatedAt = time.Now()
 
 	query := `
 		UPDATE tm_banks SET
-			name = $2, alias = $3, company = $4, code = $5, updated_at = $6
+			name = $2, alias = $3, company = $4, code = $5, bic = $6,
+			status = $7, effective_from = $8, effective_until = $9, successor_id = $10, updated_at = $11
 		WHERE id = $1`
 
-	result, err := r.pool.Exec(ctx, query,
-		bank.ID, bank.Name, bank.Alias, bank.Company, bank.Code, bank.UpdatedAt,
+	result, err := db.Exec(ctx, query,
+		bank.ID, bank.Name, bank.Alias, bank.Company, bank.Code, bank.BIC,
+		bank.Status, bank.EffectiveFrom, bank.EffectiveUntil, bank.SuccessorID, bank.UpdatedAt,
 	)
 
 	if err != nil {
@@ -101,7 +180,7 @@ func (r *BankRepository) Update(ctx context.Context, bank *entities.Bank) error
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("bank not found")
+		return fmt.Errorf("bank %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -117,7 +196,7 @@ func (r *BankRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("bank not found")
+		return fmt.Errorf("bank %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -135,9 +214,10 @@ func (r *BankRepository) Count(ctx context.Context) (int64, error) {
 // Search searches banks by name, alias, company, or code
 func (r *BankRepository) Search(ctx context.Context, query string, limit, offset int) ([]*entities.Bank, error) {
 	searchQuery := `
-		SELECT id, name, alias, company, code, created_at, updated_at
+		SELECT id, name, alias, company, code, bic, status, effective_from, effective_until, successor_id,
+			created_at, updated_at
 		FROM tm_banks
-		WHERE name ILIKE $1 OR alias ILIKE $1 OR company ILIKE $1 OR code ILIKE $1
+		WHERE name ILIKE $1 OR alias ILIKE $1 OR company ILIKE $1 OR code ILIKE $1 OR bic ILIKE $1
 		ORDER BY name
 		LIMIT $2 OFFSET $3`
 
@@ -154,7 +234,8 @@ func (r *BankRepository) Search(ctx context.Context, query string, limit, offset
 // GetByName retrieves a bank by name
 func (r *BankRepository) GetByName(ctx context.Context, name string) (*entities.Bank, error) {
 	query := `
-		SELECT id, name, alias, company, code, created_at, updated_at
+		SELECT id, name, alias, company, code, bic, status, effective_from, effective_until, successor_id,
+			created_at, updated_at
 		FROM tm_banks
 		WHERE name = $1`
 
@@ -162,13 +243,14 @@ func (r *BankRepository) GetByName(ctx context.Context, name string) (*entities.
 	row := r.pool.QueryRow(ctx, query, name)
 
 	err := row.Scan(
-		&bank.ID, &bank.Name, &bank.Alias, &bank.Company, &bank.Code,
+		&bank.ID, &bank.Name, &bank.Alias, &bank.Company, &bank.Code, &bank.BIC,
+		&bank.Status, &bank.EffectiveFrom, &bank.EffectiveUntil, &bank.SuccessorID,
 		&bank.CreatedAt, &bank.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("bank not found")
+			return nil, fmt.Errorf("bank %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -179,7 +261,8 @@ func (r *BankRepository) GetByName(ctx context.Context, name string) (*entities.
 // GetByCode retrieves a bank by code
 func (r *BankRepository) GetByCode(ctx context.Context, code string) (*entities.Bank, error) {
 	query := `
-		SELECT id, name, alias, company, code, created_at, updated_at
+		SELECT id, name, alias, company, code, bic, status, effective_from, effective_until, successor_id,
+			created_at, updated_at
 		FROM tm_banks
 		WHERE code = $1`
 
@@ -187,13 +270,14 @@ func (r *BankRepository) GetByCode(ctx context.Context, code string) (*entities.
 	row := r.pool.QueryRow(ctx, query, code)
 
 	err := row.Scan(
-		&bank.ID, &bank.Name, &bank.Alias, &bank.Company, &bank.Code,
+		&bank.ID, &bank.Name, &bank.Alias, &bank.Company, &bank.Code, &bank.BIC,
+		&bank.Status, &bank.EffectiveFrom, &bank.EffectiveUntil, &bank.SuccessorID,
 		&bank.CreatedAt, &bank.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("bank not found")
+			return nil, fmt.Errorf("bank %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -204,7 +288,8 @@ func (r *BankRepository) GetByCode(ctx context.Context, code string) (*entities.
 // GetByAlias retrieves a bank by alias
 func (r *BankRepository) GetByAlias(ctx context.Context, alias string) (*entities.Bank, error) {
 	query := `
-		SELECT id, name, alias, company, code, created_at, updated_at
+		SELECT id, name, alias, company, code, bic, status, effective_from, effective_until, successor_id,
+			created_at, updated_at
 		FROM tm_banks
 		WHERE alias = $1`
 
@@ -212,13 +297,14 @@ func (r *BankRepository) GetByAlias(ctx context.Context, alias string) (*entitie
 	row := r.pool.QueryRow(ctx, query, alias)
 
 	err := row.Scan(
-		&bank.ID, &bank.Name, &bank.Alias, &bank.Company, &bank.Code,
+		&bank.ID, &bank.Name, &bank.Alias, &bank.Company, &bank.Code, &bank.BIC,
+		&bank.Status, &bank.EffectiveFrom, &bank.EffectiveUntil, &bank.SuccessorID,
 		&bank.CreatedAt, &bank.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("bank not found")
+			return nil, fmt.Errorf("bank %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -229,7 +315,8 @@ func (r *BankRepository) GetByAlias(ctx context.Context, alias string) (*entitie
 // GetByCompany retrieves banks by company
 func (r *BankRepository) GetByCompany(ctx context.Context, company string, limit, offset int) ([]*entities.Bank, error) {
 	query := `
-		SELECT id, name, alias, company, code, created_at, updated_at
+		SELECT id, name, alias, company, code, bic, status, effective_from, effective_until, successor_id,
+			created_at, updated_at
 		FROM tm_banks
 		WHERE company = $1
 		ORDER BY name
@@ -244,6 +331,67 @@ func (r *BankRepository) GetByCompany(ctx context.Context, company string, limit
 	return r.scanBanks(rows)
 }
 
+// GetByBIC retrieves banks by BIC. An 8-character BIC matches every office of the institution,
+// while an 11-character BIC matches the exact office or, for the "XXX" branch, the 8-character form.
+func (r *BankRepository) GetByBIC(ctx context.Context, bic string) ([]*entities.Bank, error) {
+	query := `
+		SELECT id, name, alias, company, code, bic, status, effective_from, effective_until, successor_id,
+			created_at, updated_at
+		FROM tm_banks
+		WHERE LEFT(bic, 8) = LEFT($1, 8)
+			AND (LENGTH($1) = 8 OR bic = $1 OR (RIGHT($1, 3) = 'XXX' AND LENGTH(bic) = 8))
+		ORDER BY name`
+
+	rows, err := r.pool.Query(ctx, query, bic)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanBanks(rows)
+}
+
+// GetByNetwork retrieves the banks with an active membership in a payment network that is effective today
+func (r *BankRepository) GetByNetwork(ctx context.Context, networkCode string, limit, offset int) ([]*entities.Bank, error) {
+	query := `
+		SELECT b.id, b.name, b.alias, b.company, b.code, b.bic, b.status, b.effective_from, b.effective_until,
+			b.successor_id, b.created_at, b.updated_at
+		FROM tm_banks b
+		JOIN tm_bank_network_memberships m ON m.bank_id = b.id
+		WHERE m.network_code = $1
+			AND m.status = 'active'
+			AND (m.effective_from IS NULL OR m.effective_from <= CURRENT_DATE)
+			AND (m.effective_until IS NULL OR m.effective_until > CURRENT_DATE)
+		ORDER BY b.name
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.pool.Query(ctx, query, networkCode, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanBanks(rows)
+}
+
+// GetPredecessors retrieves the banks that merged into or were succeeded by the given bank
+func (r *BankRepository) GetPredecessors(ctx context.Context, bankID uuid.UUID) ([]*entities.Bank, error) {
+	query := `
+		SELECT id, name, alias, company, code, bic, status, effective_from, effective_until, successor_id,
+			created_at, updated_at
+		FROM tm_banks
+		WHERE successor_id = $1
+		ORDER BY effective_until, name`
+
+	rows, err := r.pool.Query(ctx, query, bankID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanBanks(rows)
+}
+
 // ExistsByCode checks if a bank exists by code
 func (r *BankRepository) ExistsByCode(ctx context.Context, code string) (bool, error) {
 	query := "SELECT EXISTS(SELECT 1 FROM tm_banks WHERE code = $1)"
@@ -269,7 +417,8 @@ func (r *BankRepository) scanBanks(rows pgx.Rows) ([]*entities.Bank, error) {
 	for rows.Next() {
 		var bank entities.Bank
 		err := rows.Scan(
-			&bank.ID, &bank.Name, &bank.Alias, &bank.Company, &bank.Code,
+			&bank.ID, &bank.Name, &bank.Alias, &bank.Company, &bank.Code, &bank.BIC,
+			&bank.Status, &bank.EffectiveFrom, &bank.EffectiveUntil, &bank.SuccessorID,
 			&bank.CreatedAt, &bank.UpdatedAt,
 		)
 		if err != nil {
@@ -0,0 +1,210 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// CountryCurrencyRepository implements the CountryCurrencyRepository interface using pgx
+type CountryCurrencyRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCountryCurrencyRepository creates a new CountryCurrencyRepository instance
+func NewCountryCurrencyRepository(pool *pgxpool.Pool) *CountryCurrencyRepository {
+	return &CountryCurrencyRepository{
+		pool: pool,
+	}
+}
+
+// Create creates a new country currency in the database
+func (r *CountryCurrencyRepository) Create(ctx context.Context, countryCurrency *entities.CountryCurrency) error {
+	countryCurrency.GenerateID()
+	countryCurrency.CreatedAt = time.Now()
+	countryCurrency.UpdatedAt = time.Now()
+
+	query := `
+		INSERT INTO tm_country_currencies (id, country_id, currency_id, is_legal_tender, is_primary,
+			effective_from, effective_until, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.pool.Exec(ctx, query,
+		countryCurrency.ID, countryCurrency.CountryID, countryCurrency.CurrencyID, countryCurrency.IsLegalTender,
+		countryCurrency.IsPrimary, countryCurrency.EffectiveFrom, countryCurrency.EffectiveUntil,
+		countryCurrency.CreatedAt, countryCurrency.UpdatedAt,
+	)
+
+	return err
+}
+
+// GetByCountryAndCurrency retrieves the link between a country and a currency
+func (r *CountryCurrencyRepository) GetByCountryAndCurrency(ctx context.Context, countryID, currencyID uuid.UUID) (*entities.CountryCurrency, error) {
+	query := `
+		SELECT id, country_id, currency_id, is_legal_tender, is_primary, effective_from, effective_until,
+			   created_at, updated_at
+		FROM tm_country_currencies
+		WHERE country_id = $1 AND currency_id = $2`
+
+	var countryCurrency entities.CountryCurrency
+	err := r.pool.QueryRow(ctx, query, countryID, currencyID).Scan(
+		&countryCurrency.ID, &countryCurrency.CountryID, &countryCurrency.CurrencyID, &countryCurrency.IsLegalTender,
+		&countryCurrency.IsPrimary, &countryCurrency.EffectiveFrom, &countryCurrency.EffectiveUntil,
+		&countryCurrency.CreatedAt, &countryCurrency.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("country currency %w", repositories.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return &countryCurrency, nil
+}
+
+// GetByCountry retrieves the currencies used in a country, current currencies first and the primary
+// currency before the others
+func (r *CountryCurrencyRepository) GetByCountry(ctx context.Context, countryID uuid.UUID) ([]*entities.CountryCurrency, error) {
+	query := `
+		SELECT cc.id, cc.country_id, cc.currency_id, cc.is_legal_tender, cc.is_primary, cc.effective_from,
+			   cc.effective_until, cc.created_at, cc.updated_at,
+			   c.id, c.name, c.code, c.numeric_code, c.symbol, c.decimal_places, c.is_active, c.withdrawn_at,
+			   c.replaced_by, c.cash_rounding_increment::text, c.cash_rounding_mode, c.created_at, c.updated_at
+		FROM tm_country_currencies cc
+		JOIN tm_currencies c ON c.id = cc.currency_id
+		WHERE cc.country_id = $1
+		ORDER BY cc.effective_until DESC NULLS FIRST, cc.is_primary DESC, cc.is_legal_tender DESC, c.code`
+
+	rows, err := r.pool.Query(ctx, query, countryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var countryCurrencies []*entities.CountryCurrency
+	for rows.Next() {
+		var countryCurrency entities.CountryCurrency
+		var currency entities.Currency
+		err := rows.Scan(
+			&countryCurrency.ID, &countryCurrency.CountryID, &countryCurrency.CurrencyID, &countryCurrency.IsLegalTender,
+			&countryCurrency.IsPrimary, &countryCurrency.EffectiveFrom, &countryCurrency.EffectiveUntil,
+			&countryCurrency.CreatedAt, &countryCurrency.UpdatedAt,
+			&currency.ID, &currency.Name, &currency.Code, &currency.NumericCode, &currency.Symbol,
+			&currency.DecimalPlaces, &currency.IsActive, &currency.WithdrawnAt, &currency.ReplacedBy,
+			&currency.CashRoundingIncrement, &currency.CashRoundingMode, &currency.CreatedAt, &currency.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		countryCurrency.Currency = &currency
+		countryCurrencies = append(countryCurrencies, &countryCurrency)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return countryCurrencies, nil
+}
+
+// GetByCurrency retrieves the countries a currency is used in, current countries first and countries
+// where it is the primary currency before the others
+func (r *CountryCurrencyRepository) GetByCurrency(ctx context.Context, currencyID uuid.UUID) ([]*entities.CountryCurrency, error) {
+	query := `
+		SELECT cc.id, cc.country_id, cc.currency_id, cc.is_legal_tender, cc.is_primary, cc.effective_from,
+			   cc.effective_until, cc.created_at, cc.updated_at,
+			   g.id, g.name, g.type, g.code, g.postal_code, g.longitude, g.latitude,
+			   g.record_left, g.record_right, g.record_ordering, g.record_depth, g.parent_id, g.created_at, g.updated_at
+		FROM tm_country_currencies cc
+		JOIN tm_geodirectories g ON g.id = cc.country_id
+		WHERE cc.currency_id = $1
+		ORDER BY cc.effective_until DESC NULLS FIRST, cc.is_primary DESC, cc.is_legal_tender DESC, g.code`
+
+	rows, err := r.pool.Query(ctx, query, currencyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var countryCurrencies []*entities.CountryCurrency
+	for rows.Next() {
+		var countryCurrency entities.CountryCurrency
+		var country entities.Geodirectory
+		err := rows.Scan(
+			&countryCurrency.ID, &countryCurrency.CountryID, &countryCurrency.CurrencyID, &countryCurrency.IsLegalTender,
+			&countryCurrency.IsPrimary, &countryCurrency.EffectiveFrom, &countryCurrency.EffectiveUntil,
+			&countryCurrency.CreatedAt, &countryCurrency.UpdatedAt,
+			&country.ID, &country.Name, &country.Type, &country.Code,
+			&country.PostalCode, &country.Longitude, &country.Latitude,
+			&country.RecordLeft, &country.RecordRight, &country.RecordOrdering, &country.RecordDepth,
+			&country.ParentID, &country.CreatedAt, &country.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		countryCurrency.Country = &country
+		countryCurrencies = append(countryCurrencies, &countryCurrency)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return countryCurrencies, nil
+}
+
+// Update updates an existing country currency
+func (r *CountryCurrencyRepository) Update(ctx context.Context, countryCurrency *entities.CountryCurrency) error {
+	countryCurrency.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE tm_country_currencies SET
+			is_legal_tender = $2, is_primary = $3, effective_from = $4, effective_until = $5, updated_at = $6
+		WHERE id = $1`
+
+	result, err := r.pool.Exec(ctx, query,
+		countryCurrency.ID, countryCurrency.IsLegalTender, countryCurrency.IsPrimary,
+		countryCurrency.EffectiveFrom, countryCurrency.EffectiveUntil, countryCurrency.UpdatedAt,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("country currency %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Delete deletes a country currency by ID
+func (r *CountryCurrencyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, "DELETE FROM tm_country_currencies WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("country currency %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Truncate removes all country currency records efficiently using TRUNCATE
+func (r *CountryCurrencyRepository) Truncate(ctx context.Context) error {
+	query := `TRUNCATE TABLE tm_country_currencies RESTART IDENTITY CASCADE`
+	_, err := r.pool.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to truncate country currencies table: %w", err)
+	}
+	return nil
+}
@@ -9,8 +9,25 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
+// currencyListColumns maps the fields of entities.CurrencyListSchema to their columns
+var currencyListColumns = map[string]string{
+	"name":               "name",
+	"code":               "code",
+	"numeric_code":       "numeric_code",
+	"symbol":             "symbol",
+	"decimal_places":     "decimal_places",
+	"is_active":          "is_active",
+	"withdrawn_at":       "withdrawn_at",
+	"replaced_by":        "replaced_by",
+	"cash_rounding_mode": "cash_rounding_mode",
+	"created_at":         "created_at",
+	"updated_at":         "updated_at",
+}
+
 // CurrencyRepository implements the CurrencyRepository interface using pgx
 type CurrencyRepository struct {
 	pool *pgxpool.Pool
@@ -28,14 +45,19 @@ func (r *CurrencyRepository) Create(ctx context.Context, currency *entities.Curr
 	currency.GenerateID()
 	currency.CreatedAt = time.Now()
 	currency.UpdatedAt = time.Now()
+	if currency.CashRoundingMode == "" {
+		currency.CashRoundingMode = valueobjects.RoundHalfUp
+	}
 
 	query := `
-		INSERT INTO tm_currencies (id, name, code, symbol, decimal_places, is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+		INSERT INTO tm_currencies (id, name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by,
+			cash_rounding_increment, cash_rounding_mode, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10::text::numeric, $11, $12, $13)`
 
 	_, err := r.pool.Exec(ctx, query,
-		currency.ID, currency.Name, currency.Code, currency.Symbol,
-		currency.DecimalPlaces, currency.IsActive, currency.CreatedAt, currency.UpdatedAt,
+		currency.ID, currency.Name, currency.Code, currency.NumericCode, currency.Symbol,
+		currency.DecimalPlaces, currency.IsActive, currency.WithdrawnAt, currency.ReplacedBy,
+		currency.CashRoundingIncrement, currency.CashRoundingMode, currency.CreatedAt, currency.UpdatedAt,
 	)
 
 	return err
@@ -44,7 +66,8 @@ func (r *CurrencyRepository) Create(ctx context.Context, currency *entities.Curr
 // GetByID retrieves a currency by its ID
 func (r *CurrencyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Currency, error) {
 	query := `
-		SELECT id, name, code, symbol, decimal_places, is_active, created_at, updated_at
+		SELECT id, name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by,
+			   cash_rounding_increment::text, cash_rounding_mode, created_at, updated_at
 		FROM tm_currencies
 		WHERE id = $1`
 
@@ -52,13 +75,14 @@ func (r *CurrencyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entiti
 	row := r.pool.QueryRow(ctx, query, id)
 
 	err := row.Scan(
-		&currency.ID, &currency.Name, &currency.Code, &currency.Symbol,
-		&currency.DecimalPlaces, &currency.IsActive, &currency.CreatedAt, &currency.UpdatedAt,
+		&currency.ID, &currency.Name, &currency.Code, &currency.NumericCode, &currency.Symbol,
+		&currency.DecimalPlaces, &currency.IsActive, &currency.WithdrawnAt, &currency.ReplacedBy,
+		&currency.CashRoundingIncrement, &currency.CashRoundingMode, &currency.CreatedAt, &currency.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("currency not found")
+			return nil, fmt.Errorf("currency %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -69,7 +93,8 @@ func (r *CurrencyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entiti
 // GetAll retrieves all currencies with pagination
 func (r *CurrencyRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.Currency, error) {
 	query := `
-		SELECT id, name, code, symbol, decimal_places, is_active, created_at, updated_at
+		SELECT id, name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by,
+			   cash_rounding_increment::text, cash_rounding_mode, created_at, updated_at
 		FROM tm_currencies
 		ORDER BY name
 		LIMIT $1 OFFSET $2`
@@ -83,18 +108,43 @@ func (r *CurrencyRepository) GetAll(ctx context.Context, limit, offset int) ([]*
 	return r.scanCurrencies(rows)
 }
 
+// List retrieves the currencies matching the filters of a list query in the requested order
+func (r *CurrencyRepository) List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Currency, error) {
+	sql, args, err := buildListQuery(`
+		SELECT id, name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by,
+			   cash_rounding_increment::text, cash_rounding_mode, created_at, updated_at
+		FROM tm_currencies`, query, currencyListColumns, "name, id")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanCurrencies(rows)
+}
+
 // Update updates an existing currency
 func (r *CurrencyRepository) Update(ctx context.Context, currency *entities.Currency) error {
 	currency.UpdatedAt = time.Now()
+	if currency.CashRoundingMode == "" {
+		currency.CashRoundingMode = valueobjects.RoundHalfUp
+	}
 
 	query := `
 		UPDATE tm_currencies SET
-			name = $2, code = $3, symbol = $4, decimal_places = $5, is_active = $6, updated_at = $7
+			name = $2, code = $3, numeric_code = $4, symbol = $5, decimal_places = $6, is_active = $7,
+			withdrawn_at = $8, replaced_by = $9, cash_rounding_increment = $10::text::numeric,
+			cash_rounding_mode = $11, updated_at = $12
 		WHERE id = $1`
 
 	result, err := r.pool.Exec(ctx, query,
-		currency.ID, currency.Name, currency.Code, currency.Symbol,
-		currency.DecimalPlaces, currency.IsActive, currency.UpdatedAt,
+		currency.ID, currency.Name, currency.Code, currency.NumericCode, currency.Symbol,
+		currency.DecimalPlaces, currency.IsActive, currency.WithdrawnAt, currency.ReplacedBy,
+		currency.CashRoundingIncrement, currency.CashRoundingMode, currency.UpdatedAt,
 	)
 
 	if err != nil {
@@ -102,7 +152,7 @@ func (r *CurrencyRepository) Update(ctx context.Context, currency *entities.Curr
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("currency not found")
+		return fmt.Errorf("currency %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -118,7 +168,7 @@ func (r *CurrencyRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("currency not found")
+		return fmt.Errorf("currency %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -136,7 +186,8 @@ func (r *CurrencyRepository) Count(ctx context.Context) (int64, error) {
 // Search searches currencies by name, code, or symbol
 func (r *CurrencyRepository) Search(ctx context.Context, query string, limit, offset int) ([]*entities.Currency, error) {
 	searchQuery := `
-		SELECT id, name, code, symbol, decimal_places, is_active, created_at, updated_at
+		SELECT id, name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by,
+			   cash_rounding_increment::text, cash_rounding_mode, created_at, updated_at
 		FROM tm_currencies
 		WHERE name ILIKE $1 OR code ILIKE $1 OR symbol ILIKE $1
 		ORDER BY name
@@ -155,7 +206,8 @@ func (r *CurrencyRepository) Search(ctx context.Context, query string, limit, of
 // GetByName retrieves a currency by name
 func (r *CurrencyRepository) GetByName(ctx context.Context, name string) (*entities.Currency, error) {
 	query := `
-		SELECT id, name, code, symbol, decimal_places, is_active, created_at, updated_at
+		SELECT id, name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by,
+			   cash_rounding_increment::text, cash_rounding_mode, created_at, updated_at
 		FROM tm_currencies
 		WHERE name = $1`
 
@@ -163,13 +215,14 @@ func (r *CurrencyRepository) GetByName(ctx context.Context, name string) (*entit
 	row := r.pool.QueryRow(ctx, query, name)
 
 	err := row.Scan(
-		&currency.ID, &currency.Name, &currency.Code, &currency.Symbol,
-		&currency.DecimalPlaces, &currency.IsActive, &currency.CreatedAt, &currency.UpdatedAt,
+		&currency.ID, &currency.Name, &currency.Code, &currency.NumericCode, &currency.Symbol,
+		&currency.DecimalPlaces, &currency.IsActive, &currency.WithdrawnAt, &currency.ReplacedBy,
+		&currency.CashRoundingIncrement, &currency.CashRoundingMode, &currency.CreatedAt, &currency.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("currency not found")
+			return nil, fmt.Errorf("currency %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -180,7 +233,8 @@ func (r *CurrencyRepository) GetByName(ctx context.Context, name string) (*entit
 // GetByCode retrieves a currency by code
 func (r *CurrencyRepository) GetByCode(ctx context.Context, code string) (*entities.Currency, error) {
 	query := `
-		SELECT id, name, code, symbol, decimal_places, is_active, created_at, updated_at
+		SELECT id, name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by,
+			   cash_rounding_increment::text, cash_rounding_mode, created_at, updated_at
 		FROM tm_currencies
 		WHERE code = $1`
 
@@ -188,13 +242,44 @@ func (r *CurrencyRepository) GetByCode(ctx context.Context, code string) (*entit
 	row := r.pool.QueryRow(ctx, query, code)
 
 	err := row.Scan(
-		&currency.ID, &currency.Name, &currency.Code, &currency.Symbol,
-		&currency.DecimalPlaces, &currency.IsActive, &currency.CreatedAt, &currency.UpdatedAt,
+		&currency.ID, &currency.Name, &currency.Code, &currency.NumericCode, &currency.Symbol,
+		&currency.DecimalPlaces, &currency.IsActive, &currency.WithdrawnAt, &currency.ReplacedBy,
+		&currency.CashRoundingIncrement, &currency.CashRoundingMode, &currency.CreatedAt, &currency.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("currency %w", repositories.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return &currency, nil
+}
+
+// GetByNumericCode retrieves a currency by its ISO 4217 numeric code. Numeric codes are occasionally
+// reused, so the current currency is preferred over withdrawn ones, then the most recently withdrawn.
+func (r *CurrencyRepository) GetByNumericCode(ctx context.Context, numericCode string) (*entities.Currency, error) {
+	query := `
+		SELECT id, name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by,
+			   cash_rounding_increment::text, cash_rounding_mode, created_at, updated_at
+		FROM tm_currencies
+		WHERE numeric_code = $1
+		ORDER BY withdrawn_at DESC NULLS FIRST
+		LIMIT 1`
+
+	var currency entities.Currency
+	row := r.pool.QueryRow(ctx, query, numericCode)
+
+	err := row.Scan(
+		&currency.ID, &currency.Name, &currency.Code, &currency.NumericCode, &currency.Symbol,
+		&currency.DecimalPlaces, &currency.IsActive, &currency.WithdrawnAt, &currency.ReplacedBy,
+		&currency.CashRoundingIncrement, &currency.CashRoundingMode, &currency.CreatedAt, &currency.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("currency not found")
+			return nil, fmt.Errorf("currency %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -205,7 +290,8 @@ func (r *CurrencyRepository) GetByCode(ctx context.Context, code string) (*entit
 // GetBySymbol retrieves currencies by symbol
 func (r *CurrencyRepository) GetBySymbol(ctx context.Context, symbol string) ([]*entities.Currency, error) {
 	query := `
-		SELECT id, name, code, symbol, decimal_places, is_active, created_at, updated_at
+		SELECT id, name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by,
+			   cash_rounding_increment::text, cash_rounding_mode, created_at, updated_at
 		FROM tm_currencies
 		WHERE symbol = $1
 		ORDER BY name`
@@ -222,7 +308,8 @@ func (r *CurrencyRepository) GetBySymbol(ctx context.Context, symbol string) ([]
 // GetActive retrieves all active currencies
 func (r *CurrencyRepository) GetActive(ctx context.Context, limit, offset int) ([]*entities.Currency, error) {
 	query := `
-		SELECT id, name, code, symbol, decimal_places, is_active, created_at, updated_at
+		SELECT id, name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by,
+			   cash_rounding_increment::text, cash_rounding_mode, created_at, updated_at
 		FROM tm_currencies
 		WHERE is_active = true
 		ORDER BY name
@@ -240,7 +327,8 @@ func (r *CurrencyRepository) GetActive(ctx context.Context, limit, offset int) (
 // GetInactive retrieves all inactive currencies
 func (r *CurrencyRepository) GetInactive(ctx context.Context, limit, offset int) ([]*entities.Currency, error) {
 	query := `
-		SELECT id, name, code, symbol, decimal_places, is_active, created_at, updated_at
+		SELECT id, name, code, numeric_code, symbol, decimal_places, is_active, withdrawn_at, replaced_by,
+			   cash_rounding_increment::text, cash_rounding_mode, created_at, updated_at
 		FROM tm_currencies
 		WHERE is_active = false
 		ORDER BY name
@@ -268,7 +356,7 @@ func (r *CurrencyRepository) Activate(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("currency not found")
+		return fmt.Errorf("currency %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -287,7 +375,7 @@ func (r *CurrencyRepository) Deactivate(ctx context.Context, id uuid.UUID) error
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("currency not found")
+		return fmt.Errorf("currency %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -318,8 +406,9 @@ func (r *CurrencyRepository) scanCurrencies(rows pgx.Rows) ([]*entities.Currency
 	for rows.Next() {
 		var currency entities.Currency
 		err := rows.Scan(
-			&currency.ID, &currency.Name, &currency.Code, &currency.Symbol,
-			&currency.DecimalPlaces, &currency.IsActive, &currency.CreatedAt, &currency.UpdatedAt,
+			&currency.ID, &currency.Name, &currency.Code, &currency.NumericCode, &currency.Symbol,
+			&currency.DecimalPlaces, &currency.IsActive, &currency.WithdrawnAt, &currency.ReplacedBy,
+			&currency.CashRoundingIncrement, &currency.CashRoundingMode, &currency.CreatedAt, &currency.UpdatedAt,
 		)
 		if err != nil {
 			return nil, err
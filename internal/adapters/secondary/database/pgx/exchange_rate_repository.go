@@ -0,0 +1,196 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// ExchangeRateRepository implements the ExchangeRateRepository interface using pgx
+type ExchangeRateRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewExchangeRateRepository creates a new ExchangeRateRepository instance
+func NewExchangeRateRepository(pool *pgxpool.Pool) *ExchangeRateRepository {
+	return &ExchangeRateRepository{
+		pool: pool,
+	}
+}
+
+// exchangeRateStagingColumns are the columns of the temporary table rates are copied into before the upsert
+var exchangeRateStagingColumns = []string{
+	"position", "id", "base_currency", "quote_currency", "rate", "rate_date", "source", "created_at", "updated_at",
+}
+
+// Upsert creates exchange rates or replaces the rates of the same pair, date and source in a single
+// transaction, so either all rates are stored or none. The rates are copied into a temporary table and
+// upserted with one statement; when a pair, date and source occurs more than once, the last rate wins.
+func (r *ExchangeRateRepository) Upsert(ctx context.Context, rates []*entities.ExchangeRate) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// The rate is staged as text so that NUMERIC receives it without going through a float
+	_, err = tx.Exec(ctx, `
+		CREATE TEMPORARY TABLE tmp_exchange_rates (
+			position BIGINT NOT NULL,
+			id UUID NOT NULL,
+			base_currency TEXT NOT NULL,
+			quote_currency TEXT NOT NULL,
+			rate TEXT NOT NULL,
+			rate_date DATE NOT NULL,
+			source TEXT NOT NULL,
+			created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+			updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL
+		) ON COMMIT DROP`)
+	if err != nil {
+		return fmt.Errorf("failed to create exchange rates staging table: %w", err)
+	}
+
+	now := time.Now()
+	rows := make([][]interface{}, 0, len(rates))
+	for i, rate := range rates {
+		rate.GenerateID()
+		rate.CreatedAt = now
+		rate.UpdatedAt = now
+
+		rows = append(rows, []interface{}{
+			int64(i), rate.ID, rate.BaseCurrency, rate.QuoteCurrency, rate.Rate.String(), rate.RateDate, rate.Source,
+			rate.CreatedAt, rate.UpdatedAt,
+		})
+	}
+
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"tmp_exchange_rates"}, exchangeRateStagingColumns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("failed to copy exchange rates: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO tm_exchange_rates (id, base_currency, quote_currency, rate, rate_date, source, created_at, updated_at)
+		SELECT DISTINCT ON (base_currency, quote_currency, rate_date, source)
+			id, base_currency, quote_currency, rate::numeric, rate_date, source, created_at, updated_at
+		FROM tmp_exchange_rates
+		ORDER BY base_currency, quote_currency, rate_date, source, position DESC
+		ON CONFLICT (base_currency, quote_currency, rate_date, source)
+		DO UPDATE SET rate = EXCLUDED.rate, updated_at = EXCLUDED.updated_at`)
+	if err != nil {
+		return fmt.Errorf("failed to upsert exchange rates: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetRate retrieves the rate of a currency pair on the latest date on or before the given date
+func (r *ExchangeRateRepository) GetRate(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (*entities.ExchangeRate, error) {
+	query := `
+		SELECT id, base_currency, quote_currency, rate::text, rate_date, source, created_at, updated_at
+		FROM tm_exchange_rates
+		WHERE base_currency = $1 AND quote_currency = $2 AND rate_date <= $3
+		ORDER BY rate_date DESC, source
+		LIMIT 1`
+
+	rate, err := r.scanRate(r.pool.QueryRow(ctx, query, baseCurrency, quoteCurrency, date))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("exchange rate %w", repositories.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+// GetByBase retrieves the latest rate of every quote currency of a base currency on or before the given date
+func (r *ExchangeRateRepository) GetByBase(ctx context.Context, baseCurrency string, date time.Time) ([]*entities.ExchangeRate, error) {
+	query := `
+		SELECT DISTINCT ON (quote_currency)
+			id, base_currency, quote_currency, rate::text, rate_date, source, created_at, updated_at
+		FROM tm_exchange_rates
+		WHERE base_currency = $1 AND rate_date <= $2
+		ORDER BY quote_currency, rate_date DESC, source`
+
+	rows, err := r.pool.Query(ctx, query, baseCurrency, date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rates []*entities.ExchangeRate
+	for rows.Next() {
+		rate, err := r.scanRate(rows)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// GetBaseCurrencies retrieves the distinct base currencies rates are stored for
+func (r *ExchangeRateRepository) GetBaseCurrencies(ctx context.Context) ([]string, error) {
+	query := `SELECT DISTINCT base_currency FROM tm_exchange_rates ORDER BY base_currency`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var currencies []string
+	for rows.Next() {
+		var currency string
+		if err := rows.Scan(&currency); err != nil {
+			return nil, err
+		}
+		currencies = append(currencies, currency)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return currencies, nil
+}
+
+// Truncate removes all exchange rate records efficiently using TRUNCATE
+func (r *ExchangeRateRepository) Truncate(ctx context.Context) error {
+	query := `TRUNCATE TABLE tm_exchange_rates RESTART IDENTITY CASCADE`
+	_, err := r.pool.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to truncate exchange rates table: %w", err)
+	}
+	return nil
+}
+
+// scanRate scans an exchange rate row whose rate column is selected as text
+func (r *ExchangeRateRepository) scanRate(row pgx.Row) (*entities.ExchangeRate, error) {
+	var rate entities.ExchangeRate
+	var value string
+	err := row.Scan(
+		&rate.ID, &rate.BaseCurrency, &rate.QuoteCurrency, &value, &rate.RateDate, &rate.Source,
+		&rate.CreatedAt, &rate.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rate.Rate, err = valueobjects.NewDecimal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse exchange rate: %w", err)
+	}
+
+	return &rate, nil
+}
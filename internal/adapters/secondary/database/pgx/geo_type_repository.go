@@ -0,0 +1,86 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// GeoTypeRepository implements the GeoTypeRepository interface using pgx
+type GeoTypeRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewGeoTypeRepository creates a new GeoTypeRepository instance
+func NewGeoTypeRepository(pool *pgxpool.Pool) *GeoTypeRepository {
+	return &GeoTypeRepository{
+		pool: pool,
+	}
+}
+
+// GetByCode retrieves a geo type by its code
+func (r *GeoTypeRepository) GetByCode(ctx context.Context, code entities.GeoType) (*entities.GeoTypeDefinition, error) {
+	query := `
+		SELECT id, code, label, default_depth, hierarchy_rank, created_at, updated_at
+		FROM tm_geo_types
+		WHERE code = $1`
+
+	var geoType entities.GeoTypeDefinition
+	row := r.pool.QueryRow(ctx, query, code)
+
+	err := row.Scan(
+		&geoType.ID, &geoType.Code, &geoType.Label, &geoType.DefaultDepth, &geoType.HierarchyRank,
+		&geoType.CreatedAt, &geoType.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("geo type not found")
+		}
+		return nil, err
+	}
+
+	return &geoType, nil
+}
+
+// GetAll retrieves all registered geo types ordered by hierarchy rank
+func (r *GeoTypeRepository) GetAll(ctx context.Context) ([]*entities.GeoTypeDefinition, error) {
+	query := `
+		SELECT id, code, label, default_depth, hierarchy_rank, created_at, updated_at
+		FROM tm_geo_types
+		ORDER BY hierarchy_rank, code`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanGeoTypes(rows)
+}
+
+// scanGeoTypes is a helper method to scan rows into geo type entities
+func (r *GeoTypeRepository) scanGeoTypes(rows pgx.Rows) ([]*entities.GeoTypeDefinition, error) {
+	var geoTypes []*entities.GeoTypeDefinition
+
+	for rows.Next() {
+		var geoType entities.GeoTypeDefinition
+		err := rows.Scan(
+			&geoType.ID, &geoType.Code, &geoType.Label, &geoType.DefaultDepth, &geoType.HierarchyRank,
+			&geoType.CreatedAt, &geoType.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		geoTypes = append(geoTypes, &geoType)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return geoTypes, nil
+}
@@ -3,14 +3,30 @@ package pgx
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
+// geodirectoryListColumns maps the fields of entities.GeodirectoryListSchema to their columns
+var geodirectoryListColumns = map[string]string{
+	"name":            "name",
+	"type":            "type::text",
+	"code":            "code",
+	"postal_code":     "postal_code",
+	"parent_id":       "parent_id",
+	"record_depth":    "record_depth",
+	"record_ordering": "record_ordering",
+	"created_at":      "created_at",
+	"updated_at":      "updated_at",
+}
+
 // GeodirectoryRepository implements the GeodirectoryRepository interface using pgx
 type GeodirectoryRepository struct {
 	pool *pgxpool.Pool
@@ -48,7 +64,7 @@ func (r *GeodirectoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*en
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("geodirectory not found")
+			return nil, fmt.Errorf("geodirectory %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -74,6 +90,25 @@ func (r *GeodirectoryRepository) GetAll(ctx context.Context, limit, offset int)
 	return r.scanGeodirectories(rows)
 }
 
+// List retrieves the geodirectories matching the filters of a list query in the requested order
+func (r *GeodirectoryRepository) List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Geodirectory, error) {
+	sql, args, err := buildListQuery(`
+		SELECT id, name, type, code, postal_code, longitude, latitude,
+			   record_left, record_right, record_ordering, record_depth, parent_id, created_at, updated_at
+		FROM tm_geodirectories`, query, geodirectoryListColumns, "record_ordering, name, id")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanGeodirectories(rows)
+}
+
 // Update updates an existing geodirectory
 func (r *GeodirectoryRepository) Update(ctx context.Context, geodirectory *entities.Geodirectory) error {
 	geodirectory.UpdatedAt = time.Now()
@@ -96,7 +131,7 @@ func (r *GeodirectoryRepository) Update(ctx context.Context, geodirectory *entit
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("geodirectory not found")
+		return fmt.Errorf("geodirectory %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -156,7 +191,7 @@ func (r *GeodirectoryRepository) GetByName(ctx context.Context, name string) (*e
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("geodirectory not found")
+			return nil, fmt.Errorf("geodirectory %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -184,7 +219,7 @@ func (r *GeodirectoryRepository) GetByCode(ctx context.Context, code string) (*e
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("geodirectory not found")
+			return nil, fmt.Errorf("geodirectory %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -312,7 +347,7 @@ func (r *GeodirectoryRepository) GetCountryByCode(ctx context.Context, code stri
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("country not found")
+			return nil, fmt.Errorf("country %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -388,7 +423,7 @@ func (r *GeodirectoryRepository) GetParent(ctx context.Context, id uuid.UUID) (*
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("parent not found")
+			return nil, fmt.Errorf("parent %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -489,7 +524,7 @@ func (r *GeodirectoryRepository) UpdateNestedSetValues(ctx context.Context, id u
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("geodirectory not found")
+		return fmt.Errorf("geodirectory %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -765,18 +800,28 @@ func (r *GeodirectoryRepository) rebuildNestedSetForNode(ctx context.Context, tx
 	return counter, nil
 }
 
-// GetByCoordinates retrieves geodirectories by geographic coordinates (placeholder implementation)
-func (r *GeodirectoryRepository) GetByCoordinates(ctx context.Context, latitude, longitude string, radius float64) ([]*entities.Geodirectory, error) {
+// GetByCoordinates retrieves geodirectories within a bounding box of radius kilometers around the coordinates
+func (r *GeodirectoryRepository) GetByCoordinates(ctx context.Context, coordinates *valueobjects.Coordinates, radius float64) ([]*entities.Geodirectory, error) {
 	// This would require PostGIS extension for proper geographic queries
-	// For now, return a simple string-based match
+	// For now, match on a latitude/longitude bounding box using the numeric columns
+	const kilometersPerDegree = 111.32
+	latitudeDelta := radius / kilometersPerDegree
+	longitudeDelta := 180.0
+	if cosLatitude := math.Cos(coordinates.Latitude() * math.Pi / 180); cosLatitude > 0.000001 {
+		longitudeDelta = math.Min(radius/(kilometersPerDegree*cosLatitude), 180)
+	}
+
 	query := `
 		SELECT id, name, type, code, postal_code, longitude, latitude,
 			   record_left, record_right, record_ordering, record_depth, parent_id, created_at, updated_at
 		FROM tm_geodirectories
-		WHERE latitude = $1 AND longitude = $2
+		WHERE latitude BETWEEN $1 AND $2 AND longitude BETWEEN $3 AND $4
 		ORDER BY name`
 
-	rows, err := r.pool.Query(ctx, query, latitude, longitude)
+	rows, err := r.pool.Query(ctx, query,
+		coordinates.Latitude()-latitudeDelta, coordinates.Latitude()+latitudeDelta,
+		coordinates.Longitude()-longitudeDelta, coordinates.Longitude()+longitudeDelta,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,140 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// HierarchySchemaRepository implements the HierarchySchemaRepository interface using pgx
+type HierarchySchemaRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewHierarchySchemaRepository creates a new HierarchySchemaRepository instance
+func NewHierarchySchemaRepository(pool *pgxpool.Pool) *HierarchySchemaRepository {
+	return &HierarchySchemaRepository{
+		pool: pool,
+	}
+}
+
+// GetRulesByCountry retrieves all hierarchy rules defined for a country
+func (r *HierarchySchemaRepository) GetRulesByCountry(ctx context.Context, countryCode string) ([]*entities.HierarchyRule, error) {
+	query := `
+		SELECT id, country_code, parent_type, child_type, created_at, updated_at
+		FROM tm_geo_hierarchy_rules
+		WHERE country_code = $1
+		ORDER BY child_type, parent_type`
+
+	rows, err := r.pool.Query(ctx, query, countryCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanRules(rows)
+}
+
+// GetCountryCodes retrieves the codes of all countries that define their own rules
+func (r *HierarchySchemaRepository) GetCountryCodes(ctx context.Context) ([]string, error) {
+	query := "SELECT DISTINCT country_code FROM tm_geo_hierarchy_rules ORDER BY country_code"
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var codes []string
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// ReplaceRules replaces all hierarchy rules of a country in a single transaction
+func (r *HierarchySchemaRepository) ReplaceRules(ctx context.Context, countryCode string, rules []*entities.HierarchyRule) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, "DELETE FROM tm_geo_hierarchy_rules WHERE country_code = $1", countryCode)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO tm_geo_hierarchy_rules (id, country_code, parent_type, child_type, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	for _, rule := range rules {
+		rule.GenerateID()
+		rule.CountryCode = countryCode
+		rule.CreatedAt = time.Now()
+		rule.UpdatedAt = time.Now()
+
+		_, err = tx.Exec(ctx, query,
+			rule.ID, rule.CountryCode, rule.ParentType, rule.ChildType,
+			rule.CreatedAt, rule.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// DeleteByCountry removes all hierarchy rules of a country
+func (r *HierarchySchemaRepository) DeleteByCountry(ctx context.Context, countryCode string) error {
+	_, err := r.pool.Exec(ctx, "DELETE FROM tm_geo_hierarchy_rules WHERE country_code = $1", countryCode)
+	return err
+}
+
+// scanRules is a helper method to scan rows into hierarchy rule entities
+func (r *HierarchySchemaRepository) scanRules(rows pgx.Rows) ([]*entities.HierarchyRule, error) {
+	var rules []*entities.HierarchyRule
+
+	for rows.Next() {
+		var rule entities.HierarchyRule
+		err := rows.Scan(
+			&rule.ID, &rule.CountryCode, &rule.ParentType, &rule.ChildType,
+			&rule.CreatedAt, &rule.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// Truncate removes all hierarchy rule records efficiently using TRUNCATE
+func (r *HierarchySchemaRepository) Truncate(ctx context.Context) error {
+	query := `TRUNCATE TABLE tm_geo_hierarchy_rules RESTART IDENTITY CASCADE`
+	_, err := r.pool.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to truncate geo hierarchy rules table: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// IBANFormatRepository implements the IBANFormatRepository interface using pgx
+type IBANFormatRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewIBANFormatRepository creates a new IBANFormatRepository instance
+func NewIBANFormatRepository(pool *pgxpool.Pool) *IBANFormatRepository {
+	return &IBANFormatRepository{
+		pool: pool,
+	}
+}
+
+// Create creates a new IBAN format in the database
+func (r *IBANFormatRepository) Create(ctx context.Context, format *entities.IBANFormat) error {
+	format.GenerateID()
+	format.CreatedAt = time.Now()
+	format.UpdatedAt = time.Now()
+
+	query := `
+		INSERT INTO tm_iban_formats (id, country_code, length, bban_structure, bank_id_offset, bank_id_length,
+			branch_id_offset, branch_id_length, is_sepa, example, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+	_, err := r.pool.Exec(ctx, query,
+		format.ID, format.CountryCode, format.Length, format.BBANStructure, format.BankIDOffset, format.BankIDLength,
+		format.BranchIDOffset, format.BranchIDLength, format.IsSEPA, format.Example,
+		format.CreatedAt, format.UpdatedAt,
+	)
+
+	return err
+}
+
+// GetByCountryCode retrieves the IBAN format of a country
+func (r *IBANFormatRepository) GetByCountryCode(ctx context.Context, countryCode string) (*entities.IBANFormat, error) {
+	query := `
+		SELECT id, country_code, length, bban_structure, bank_id_offset, bank_id_length,
+			branch_id_offset, branch_id_length, is_sepa, example, created_at, updated_at
+		FROM tm_iban_formats
+		WHERE country_code = $1`
+
+	var format entities.IBANFormat
+	row := r.pool.QueryRow(ctx, query, countryCode)
+
+	err := row.Scan(
+		&format.ID, &format.CountryCode, &format.Length, &format.BBANStructure, &format.BankIDOffset, &format.BankIDLength,
+		&format.BranchIDOffset, &format.BranchIDLength, &format.IsSEPA, &format.Example,
+		&format.CreatedAt, &format.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("IBAN format %w", repositories.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return &format, nil
+}
+
+// GetAll retrieves all IBAN formats ordered by country code
+func (r *IBANFormatRepository) GetAll(ctx context.Context) ([]*entities.IBANFormat, error) {
+	query := `
+		SELECT id, country_code, length, bban_structure, bank_id_offset, bank_id_length,
+			branch_id_offset, branch_id_length, is_sepa, example, created_at, updated_at
+		FROM tm_iban_formats
+		ORDER BY country_code`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanFormats(rows)
+}
+
+// Update updates an existing IBAN format
+func (r *IBANFormatRepository) Update(ctx context.Context, format *entities.IBANFormat) error {
+	format.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE tm_iban_formats SET
+			length = $2, bban_structure = $3, bank_id_offset = $4, bank_id_length = $5,
+			branch_id_offset = $6, branch_id_length = $7, is_sepa = $8, example = $9, updated_at = $10
+		WHERE country_code = $1`
+
+	result, err := r.pool.Exec(ctx, query,
+		format.CountryCode, format.Length, format.BBANStructure, format.BankIDOffset, format.BankIDLength,
+		format.BranchIDOffset, format.BranchIDLength, format.IsSEPA, format.Example, format.UpdatedAt,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("IBAN format %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Delete deletes the IBAN format of a country
+func (r *IBANFormatRepository) Delete(ctx context.Context, countryCode string) error {
+	result, err := r.pool.Exec(ctx, "DELETE FROM tm_iban_formats WHERE country_code = $1", countryCode)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("IBAN format %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// scanFormats is a helper method to scan rows into IBAN format entities
+func (r *IBANFormatRepository) scanFormats(rows pgx.Rows) ([]*entities.IBANFormat, error) {
+	var formats []*entities.IBANFormat
+
+	for rows.Next() {
+		var format entities.IBANFormat
+		err := rows.Scan(
+			&format.ID, &format.CountryCode, &format.Length, &format.BBANStructure, &format.BankIDOffset, &format.BankIDLength,
+			&format.BranchIDOffset, &format.BranchIDLength, &format.IsSEPA, &format.Example,
+			&format.CreatedAt, &format.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		formats = append(formats, &format)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return formats, nil
+}
+
+// Truncate removes all IBAN format records efficiently using TRUNCATE
+func (r *IBANFormatRepository) Truncate(ctx context.Context) error {
+	query := `TRUNCATE TABLE tm_iban_formats RESTART IDENTITY CASCADE`
+	_, err := r.pool.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to truncate IBAN formats table: %w", err)
+	}
+	return nil
+}
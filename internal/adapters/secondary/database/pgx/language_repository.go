@@ -3,14 +3,33 @@ package pgx
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
+// languageListColumns maps the fields of entities.LanguageListSchema to their columns
+var languageListColumns = map[string]string{
+	"name":        "name",
+	"native_name": "native_name",
+	"code":        "code",
+	"iso639_1":    "iso639_1",
+	"iso639_2t":   "iso639_2t",
+	"iso639_2b":   "iso639_2b",
+	"iso639_3":    "iso639_3",
+	"script":      "script",
+	"direction":   "direction",
+	"is_active":   "is_active",
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+}
+
 // LanguageRepository implements the LanguageRepository interface using pgx
 type LanguageRepository struct {
 	pool *pgxpool.Pool
@@ -28,13 +47,18 @@ func (r *LanguageRepository) Create(ctx context.Context, language *entities.Lang
 	language.GenerateID()
 	language.CreatedAt = time.Now()
 	language.UpdatedAt = time.Now()
+	if language.Direction == "" {
+		language.Direction = entities.LanguageDirectionLTR
+	}
 
 	query := `
-		INSERT INTO tm_languages (id, name, code, is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)`
+		INSERT INTO tm_languages (id, name, native_name, code, iso639_1, iso639_2t, iso639_2b, iso639_3, script,
+			direction, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
 
 	_, err := r.pool.Exec(ctx, query,
-		language.ID, language.Name, language.Code, language.IsActive,
+		language.ID, language.Name, language.NativeName, language.Code, language.ISO6391, language.ISO6392T,
+		language.ISO6392B, language.ISO6393, language.Script, language.Direction, language.IsActive,
 		language.CreatedAt, language.UpdatedAt,
 	)
 
@@ -44,7 +68,8 @@ func (r *LanguageRepository) Create(ctx context.Context, language *entities.Lang
 // GetByID retrieves a language by its ID
 func (r *LanguageRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Language, error) {
 	query := `
-		SELECT id, name, code, is_active, created_at, updated_at
+		SELECT id, name, native_name, code, iso639_1, iso639_2t, iso639_2b, iso639_3, script, direction,
+			   is_active, created_at, updated_at
 		FROM tm_languages
 		WHERE id = $1`
 
@@ -52,13 +77,14 @@ func (r *LanguageRepository) GetByID(ctx context.Context, id uuid.UUID) (*entiti
 	row := r.pool.QueryRow(ctx, query, id)
 
 	err := row.Scan(
-		&language.ID, &language.Name, &language.Code, &language.IsActive,
-		&language.CreatedAt, &language.UpdatedAt,
+		&language.ID, &language.Name, &language.NativeName, &language.Code, &language.ISO6391,
+		&language.ISO6392T, &language.ISO6392B, &language.ISO6393, &language.Script, &language.Direction,
+		&language.IsActive, &language.CreatedAt, &language.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("language not found")
+			return nil, fmt.Errorf("language %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -69,7 +95,8 @@ func (r *LanguageRepository) GetByID(ctx context.Context, id uuid.UUID) (*entiti
 // GetAll retrieves all languages with pagination
 func (r *LanguageRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.Language, error) {
 	query := `
-		SELECT id, name, code, is_active, created_at, updated_at
+		SELECT id, name, native_name, code, iso639_1, iso639_2t, iso639_2b, iso639_3, script, direction,
+			   is_active, created_at, updated_at
 		FROM tm_languages
 		ORDER BY name
 		LIMIT $1 OFFSET $2`
@@ -83,17 +110,42 @@ func (r *LanguageRepository) GetAll(ctx context.Context, limit, offset int) ([]*
 	return r.scanLanguages(rows)
 }
 
+// List retrieves the languages matching the filters of a list query in the requested order
+func (r *LanguageRepository) List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Language, error) {
+	sql, args, err := buildListQuery(`
+		SELECT id, name, native_name, code, iso639_1, iso639_2t, iso639_2b, iso639_3, script, direction,
+			   is_active, created_at, updated_at
+		FROM tm_languages`, query, languageListColumns, "name, id")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanLanguages(rows)
+}
+
 // Update updates an existing language
 func (r *LanguageRepository) Update(ctx context.Context, language *entities.Language) error {
 	language.UpdatedAt = time.Now()
+	if language.Direction == "" {
+		language.Direction = entities.LanguageDirectionLTR
+	}
 
 	query := `
 		UPDATE tm_languages SET
-			name = $2, code = $3, is_active = $4, updated_at = $5
+			name = $2, native_name = $3, code = $4, iso639_1 = $5, iso639_2t = $6, iso639_2b = $7,
+			iso639_3 = $8, script = $9, direction = $10, is_active = $11, updated_at = $12
 		WHERE id = $1`
 
 	result, err := r.pool.Exec(ctx, query,
-		language.ID, language.Name, language.Code, language.IsActive, language.UpdatedAt,
+		language.ID, language.Name, language.NativeName, language.Code, language.ISO6391, language.ISO6392T,
+		language.ISO6392B, language.ISO6393, language.Script, language.Direction, language.IsActive,
+		language.UpdatedAt,
 	)
 
 	if err != nil {
@@ -101,7 +153,7 @@ func (r *LanguageRepository) Update(ctx context.Context, language *entities.Lang
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("language not found")
+		return fmt.Errorf("language %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -117,7 +169,7 @@ func (r *LanguageRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("language not found")
+		return fmt.Errorf("language %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -135,9 +187,10 @@ func (r *LanguageRepository) Count(ctx context.Context) (int64, error) {
 // Search searches languages by name or code
 func (r *LanguageRepository) Search(ctx context.Context, query string, limit, offset int) ([]*entities.Language, error) {
 	searchQuery := `
-		SELECT id, name, code, is_active, created_at, updated_at
+		SELECT id, name, native_name, code, iso639_1, iso639_2t, iso639_2b, iso639_3, script, direction,
+			   is_active, created_at, updated_at
 		FROM tm_languages
-		WHERE name ILIKE $1 OR code ILIKE $1
+		WHERE name ILIKE $1 OR native_name ILIKE $1 OR code ILIKE $1
 		ORDER BY name
 		LIMIT $2 OFFSET $3`
 
@@ -154,7 +207,8 @@ func (r *LanguageRepository) Search(ctx context.Context, query string, limit, of
 // GetByName retrieves a language by name
 func (r *LanguageRepository) GetByName(ctx context.Context, name string) (*entities.Language, error) {
 	query := `
-		SELECT id, name, code, is_active, created_at, updated_at
+		SELECT id, name, native_name, code, iso639_1, iso639_2t, iso639_2b, iso639_3, script, direction,
+			   is_active, created_at, updated_at
 		FROM tm_languages
 		WHERE name = $1`
 
@@ -162,13 +216,14 @@ func (r *LanguageRepository) GetByName(ctx context.Context, name string) (*entit
 	row := r.pool.QueryRow(ctx, query, name)
 
 	err := row.Scan(
-		&language.ID, &language.Name, &language.Code, &language.IsActive,
-		&language.CreatedAt, &language.UpdatedAt,
+		&language.ID, &language.Name, &language.NativeName, &language.Code, &language.ISO6391,
+		&language.ISO6392T, &language.ISO6392B, &language.ISO6393, &language.Script, &language.Direction,
+		&language.IsActive, &language.CreatedAt, &language.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("language not found")
+			return nil, fmt.Errorf("language %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -179,7 +234,8 @@ func (r *LanguageRepository) GetByName(ctx context.Context, name string) (*entit
 // GetByCode retrieves a language by code
 func (r *LanguageRepository) GetByCode(ctx context.Context, code string) (*entities.Language, error) {
 	query := `
-		SELECT id, name, code, is_active, created_at, updated_at
+		SELECT id, name, native_name, code, iso639_1, iso639_2t, iso639_2b, iso639_3, script, direction,
+			   is_active, created_at, updated_at
 		FROM tm_languages
 		WHERE code = $1`
 
@@ -187,13 +243,44 @@ func (r *LanguageRepository) GetByCode(ctx context.Context, code string) (*entit
 	row := r.pool.QueryRow(ctx, query, code)
 
 	err := row.Scan(
-		&language.ID, &language.Name, &language.Code, &language.IsActive,
-		&language.CreatedAt, &language.UpdatedAt,
+		&language.ID, &language.Name, &language.NativeName, &language.Code, &language.ISO6391,
+		&language.ISO6392T, &language.ISO6392B, &language.ISO6393, &language.Script, &language.Direction,
+		&language.IsActive, &language.CreatedAt, &language.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("language %w", repositories.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return &language, nil
+}
+
+// GetByAnyCode retrieves a language by its code or any of its ISO 639-1, ISO 639-2/T, ISO 639-2/B or ISO 639-3
+// codes, ignoring case. A match on the code wins over a match on an ISO code.
+func (r *LanguageRepository) GetByAnyCode(ctx context.Context, code string) (*entities.Language, error) {
+	query := `
+		SELECT id, name, native_name, code, iso639_1, iso639_2t, iso639_2b, iso639_3, script, direction,
+			   is_active, created_at, updated_at
+		FROM tm_languages
+		WHERE LOWER(code) = $1 OR iso639_1 = $1 OR iso639_2t = $1 OR iso639_2b = $1 OR iso639_3 = $1
+		ORDER BY LOWER(code) = $1 DESC, name
+		LIMIT 1`
+
+	var language entities.Language
+	row := r.pool.QueryRow(ctx, query, strings.ToLower(code))
+
+	err := row.Scan(
+		&language.ID, &language.Name, &language.NativeName, &language.Code, &language.ISO6391,
+		&language.ISO6392T, &language.ISO6392B, &language.ISO6393, &language.Script, &language.Direction,
+		&language.IsActive, &language.CreatedAt, &language.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("language not found")
+			return nil, fmt.Errorf("language %w", repositories.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -204,7 +291,8 @@ func (r *LanguageRepository) GetByCode(ctx context.Context, code string) (*entit
 // GetActive retrieves all active languages
 func (r *LanguageRepository) GetActive(ctx context.Context, limit, offset int) ([]*entities.Language, error) {
 	query := `
-		SELECT id, name, code, is_active, created_at, updated_at
+		SELECT id, name, native_name, code, iso639_1, iso639_2t, iso639_2b, iso639_3, script, direction,
+			   is_active, created_at, updated_at
 		FROM tm_languages
 		WHERE is_active = true
 		ORDER BY name
@@ -222,7 +310,8 @@ func (r *LanguageRepository) GetActive(ctx context.Context, limit, offset int) (
 // GetInactive retrieves all inactive languages
 func (r *LanguageRepository) GetInactive(ctx context.Context, limit, offset int) ([]*entities.Language, error) {
 	query := `
-		SELECT id, name, code, is_active, created_at, updated_at
+		SELECT id, name, native_name, code, iso639_1, iso639_2t, iso639_2b, iso639_3, script, direction,
+			   is_active, created_at, updated_at
 		FROM tm_languages
 		WHERE is_active = false
 		ORDER BY name
@@ -250,7 +339,7 @@ func (r *LanguageRepository) Activate(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("language not found")
+		return fmt.Errorf("language %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -269,7 +358,7 @@ func (r *LanguageRepository) Deactivate(ctx context.Context, id uuid.UUID) error
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("language not found")
+		return fmt.Errorf("language %w", repositories.ErrNotFound)
 	}
 
 	return nil
@@ -300,8 +389,9 @@ func (r *LanguageRepository) scanLanguages(rows pgx.Rows) ([]*entities.Language,
 	for rows.Next() {
 		var language entities.Language
 		err := rows.Scan(
-			&language.ID, &language.Name, &language.Code, &language.IsActive,
-			&language.CreatedAt, &language.UpdatedAt,
+			&language.ID, &language.Name, &language.NativeName, &language.Code, &language.ISO6391,
+			&language.ISO6392T, &language.ISO6392B, &language.ISO6393, &language.Script, &language.Direction,
+			&language.IsActive, &language.CreatedAt, &language.UpdatedAt,
 		)
 		if err != nil {
 			return nil, err
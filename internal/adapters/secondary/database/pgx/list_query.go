@@ -0,0 +1,91 @@
+package pgx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// likeEscaper escapes the wildcards of ILIKE patterns so that filter values match literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// buildListQuery appends the WHERE, ORDER BY, LIMIT and OFFSET clauses of a list query to a SELECT statement.
+// columns maps the fields of the entity list schema to trusted SQL expressions; filter values are only ever
+// passed as parameters. The default order is applied after the requested sort fields so that pages are stable.
+func buildListQuery(selectSQL string, query valueobjects.ListQuery, columns map[string]string, defaultOrder string) (string, []interface{}, error) {
+	var conditions []string
+	var args []interface{}
+
+	param := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	for _, filter := range query.Filters {
+		column, ok := columns[filter.Field]
+		if !ok {
+			return "", nil, fmt.Errorf("%w: field '%s' cannot be filtered", valueobjects.ErrInvalidFilter, filter.Field)
+		}
+
+		switch filter.Operator {
+		case valueobjects.FilterEq:
+			conditions = append(conditions, fmt.Sprintf("%s = %s", column, param(filter.Value)))
+		case valueobjects.FilterNe:
+			conditions = append(conditions, fmt.Sprintf("%s IS DISTINCT FROM %s", column, param(filter.Value)))
+		case valueobjects.FilterLt:
+			conditions = append(conditions, fmt.Sprintf("%s < %s", column, param(filter.Value)))
+		case valueobjects.FilterLte:
+			conditions = append(conditions, fmt.Sprintf("%s <= %s", column, param(filter.Value)))
+		case valueobjects.FilterGt:
+			conditions = append(conditions, fmt.Sprintf("%s > %s", column, param(filter.Value)))
+		case valueobjects.FilterGte:
+			conditions = append(conditions, fmt.Sprintf("%s >= %s", column, param(filter.Value)))
+		case valueobjects.FilterLike:
+			pattern := "%" + likeEscaper.Replace(fmt.Sprint(filter.Value)) + "%"
+			conditions = append(conditions, fmt.Sprintf(`%s ILIKE %s ESCAPE '\'`, column, param(pattern)))
+		case valueobjects.FilterIn:
+			values, ok := filter.Value.([]interface{})
+			if !ok || len(values) == 0 {
+				return "", nil, fmt.Errorf("%w: field '%s' needs at least one value", valueobjects.ErrInvalidFilter, filter.Field)
+			}
+			placeholders := make([]string, len(values))
+			for i, value := range values {
+				placeholders[i] = param(value)
+			}
+			conditions = append(conditions, fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholders, ", ")))
+		case valueobjects.FilterNull:
+			if isNull, _ := filter.Value.(bool); isNull {
+				conditions = append(conditions, column+" IS NULL")
+			} else {
+				conditions = append(conditions, column+" IS NOT NULL")
+			}
+		default:
+			return "", nil, fmt.Errorf("%w: unsupported operator '%s'", valueobjects.ErrInvalidFilter, filter.Operator)
+		}
+	}
+
+	var order []string
+	for _, sortField := range query.Sort {
+		column, ok := columns[sortField.Field]
+		if !ok {
+			return "", nil, fmt.Errorf("%w '%s'", valueobjects.ErrInvalidSort, sortField.Field)
+		}
+		direction := "ASC"
+		if sortField.Descending {
+			direction = "DESC"
+		}
+		order = append(order, column+" "+direction)
+	}
+	order = append(order, defaultOrder)
+
+	var sql strings.Builder
+	sql.WriteString(selectSQL)
+	if len(conditions) > 0 {
+		sql.WriteString("\n\t\tWHERE " + strings.Join(conditions, " AND "))
+	}
+	sql.WriteString("\n\t\tORDER BY " + strings.Join(order, ", "))
+	sql.WriteString(fmt.Sprintf("\n\t\tLIMIT %s OFFSET %s", param(query.Limit), param(query.Offset)))
+
+	return sql.String(), args, nil
+}
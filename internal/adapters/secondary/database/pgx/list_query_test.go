@@ -0,0 +1,159 @@
+package pgx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+const testListSelect = "SELECT id, name FROM tm_banks"
+
+var testListColumns = map[string]string{
+	"name":          "name",
+	"code":          "code",
+	"status":        "status",
+	"decimal":       "decimal_places",
+	"successor_id":  "successor_id",
+	"created_at":    "created_at",
+	"country_label": "country.name",
+}
+
+func TestBuildListQuery(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        valueobjects.ListQuery
+		expectedSQL  string
+		expectedArgs []interface{}
+		errorMsg     string
+	}{
+		{
+			name:         "no filters uses the default order",
+			query:        valueobjects.ListQuery{Limit: 10, Offset: 20},
+			expectedSQL:  testListSelect + "\n\t\tORDER BY id ASC\n\t\tLIMIT $1 OFFSET $2",
+			expectedArgs: []interface{}{10, 20},
+		},
+		{
+			name: "eq",
+			query: valueobjects.ListQuery{
+				Filters: []valueobjects.Filter{{Field: "code", Operator: valueobjects.FilterEq, Value: "014"}},
+				Limit:   10,
+			},
+			expectedSQL:  testListSelect + "\n\t\tWHERE code = $1\n\t\tORDER BY id ASC\n\t\tLIMIT $2 OFFSET $3",
+			expectedArgs: []interface{}{"014", 10, 0},
+		},
+		{
+			name: "ne",
+			query: valueobjects.ListQuery{
+				Filters: []valueobjects.Filter{{Field: "status", Operator: valueobjects.FilterNe, Value: "closed"}},
+				Limit:   10,
+			},
+			expectedSQL:  testListSelect + "\n\t\tWHERE status IS DISTINCT FROM $1\n\t\tORDER BY id ASC\n\t\tLIMIT $2 OFFSET $3",
+			expectedArgs: []interface{}{"closed", 10, 0},
+		},
+		{
+			name: "range operators",
+			query: valueobjects.ListQuery{
+				Filters: []valueobjects.Filter{
+					{Field: "decimal", Operator: valueobjects.FilterLt, Value: int64(4)},
+					{Field: "decimal", Operator: valueobjects.FilterLte, Value: int64(3)},
+					{Field: "decimal", Operator: valueobjects.FilterGt, Value: int64(0)},
+					{Field: "decimal", Operator: valueobjects.FilterGte, Value: int64(1)},
+				},
+				Limit: 10,
+			},
+			expectedSQL: testListSelect +
+				"\n\t\tWHERE decimal_places < $1 AND decimal_places <= $2 AND decimal_places > $3 AND decimal_places >= $4" +
+				"\n\t\tORDER BY id ASC\n\t\tLIMIT $5 OFFSET $6",
+			expectedArgs: []interface{}{int64(4), int64(3), int64(0), int64(1), 10, 0},
+		},
+		{
+			name: "like escapes wildcards",
+			query: valueobjects.ListQuery{
+				Filters: []valueobjects.Filter{{Field: "name", Operator: valueobjects.FilterLike, Value: `50%_off\now`}},
+				Limit:   10,
+			},
+			expectedSQL:  testListSelect + "\n\t\tWHERE name ILIKE $1 ESCAPE '\\'\n\t\tORDER BY id ASC\n\t\tLIMIT $2 OFFSET $3",
+			expectedArgs: []interface{}{`%50\%\_off\\now%`, 10, 0},
+		},
+		{
+			name: "in uses one placeholder per value",
+			query: valueobjects.ListQuery{
+				Filters: []valueobjects.Filter{{Field: "status", Operator: valueobjects.FilterIn, Value: []interface{}{"active", "merged", "closed"}}},
+				Limit:   10,
+			},
+			expectedSQL:  testListSelect + "\n\t\tWHERE status IN ($1, $2, $3)\n\t\tORDER BY id ASC\n\t\tLIMIT $4 OFFSET $5",
+			expectedArgs: []interface{}{"active", "merged", "closed", 10, 0},
+		},
+		{
+			name: "null and not null",
+			query: valueobjects.ListQuery{
+				Filters: []valueobjects.Filter{
+					{Field: "successor_id", Operator: valueobjects.FilterNull, Value: true},
+					{Field: "created_at", Operator: valueobjects.FilterNull, Value: false},
+				},
+				Limit: 10,
+			},
+			expectedSQL:  testListSelect + "\n\t\tWHERE successor_id IS NULL AND created_at IS NOT NULL\n\t\tORDER BY id ASC\n\t\tLIMIT $1 OFFSET $2",
+			expectedArgs: []interface{}{10, 0},
+		},
+		{
+			name: "sort fields come before the default order",
+			query: valueobjects.ListQuery{
+				Sort:  []valueobjects.SortField{{Field: "name", Descending: true}, {Field: "country_label"}},
+				Limit: 10,
+			},
+			expectedSQL:  testListSelect + "\n\t\tORDER BY name DESC, country.name ASC, id ASC\n\t\tLIMIT $1 OFFSET $2",
+			expectedArgs: []interface{}{10, 0},
+		},
+		{
+			name: "filter field not in the column map",
+			query: valueobjects.ListQuery{
+				Filters: []valueobjects.Filter{{Field: "name; DROP TABLE tm_banks", Operator: valueobjects.FilterEq, Value: "x"}},
+			},
+			errorMsg: "invalid filter: field 'name; DROP TABLE tm_banks' cannot be filtered",
+		},
+		{
+			name: "sort field not in the column map",
+			query: valueobjects.ListQuery{
+				Sort: []valueobjects.SortField{{Field: "password"}},
+			},
+			errorMsg: "invalid sort field 'password'",
+		},
+		{
+			name: "in without values",
+			query: valueobjects.ListQuery{
+				Filters: []valueobjects.Filter{{Field: "status", Operator: valueobjects.FilterIn, Value: []interface{}{}}},
+			},
+			errorMsg: "invalid filter: field 'status' needs at least one value",
+		},
+		{
+			name: "unsupported operator",
+			query: valueobjects.ListQuery{
+				Filters: []valueobjects.Filter{{Field: "name", Operator: "regex", Value: ".*"}},
+			},
+			errorMsg: "invalid filter: unsupported operator 'regex'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := buildListQuery(testListSelect, tt.query, testListColumns, "id ASC")
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, valueobjects.ErrInvalidFilter) || errors.Is(err, valueobjects.ErrInvalidSort), err.Error())
+				assert.Equal(t, tt.errorMsg, err.Error())
+				assert.Empty(t, sql)
+				assert.Nil(t, args)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSQL, sql)
+			assert.Equal(t, tt.expectedArgs, args)
+		})
+	}
+}
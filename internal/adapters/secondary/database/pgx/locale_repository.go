@@ -0,0 +1,218 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// localeSelect selects locales with their language and country
+const localeSelect = `
+		SELECT lc.id, lc.tag, lc.language_id, lc.country_id, lc.currency_id, lc.name, lc.native_name,
+			   lc.first_day_of_week, lc.date_pattern, lc.time_pattern, lc.decimal_separator, lc.group_separator,
+			   lc.is_active, lc.created_at, lc.updated_at,
+			   l.id, l.name, l.native_name, l.code, l.iso639_1, l.iso639_2t, l.iso639_2b, l.iso639_3, l.script,
+			   l.direction, l.is_active, l.created_at, l.updated_at,
+			   g.id, g.name, g.type, g.code, g.postal_code, g.longitude, g.latitude,
+			   g.record_left, g.record_right, g.record_ordering, g.record_depth, g.parent_id, g.created_at, g.updated_at
+		FROM tm_locales lc
+		JOIN tm_languages l ON l.id = lc.language_id
+		JOIN tm_geodirectories g ON g.id = lc.country_id`
+
+// LocaleRepository implements the LocaleRepository interface using pgx
+type LocaleRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewLocaleRepository creates a new LocaleRepository instance
+func NewLocaleRepository(pool *pgxpool.Pool) *LocaleRepository {
+	return &LocaleRepository{
+		pool: pool,
+	}
+}
+
+// Create creates a new locale in the database
+func (r *LocaleRepository) Create(ctx context.Context, locale *entities.Locale) error {
+	locale.GenerateID()
+	locale.CreatedAt = time.Now()
+	locale.UpdatedAt = time.Now()
+
+	query := `
+		INSERT INTO tm_locales (id, tag, language_id, country_id, currency_id, name, native_name, first_day_of_week,
+			date_pattern, time_pattern, decimal_separator, group_separator, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
+
+	_, err := r.pool.Exec(ctx, query,
+		locale.ID, locale.Tag, locale.LanguageID, locale.CountryID, locale.CurrencyID, locale.Name, locale.NativeName,
+		locale.FirstDayOfWeek, locale.DatePattern, locale.TimePattern, locale.DecimalSeparator, locale.GroupSeparator,
+		locale.IsActive, locale.CreatedAt, locale.UpdatedAt,
+	)
+
+	return err
+}
+
+// GetByTag retrieves a locale by its BCP 47 tag, ignoring case
+func (r *LocaleRepository) GetByTag(ctx context.Context, tag string) (*entities.Locale, error) {
+	query := localeSelect + `
+		WHERE LOWER(lc.tag) = LOWER($1)`
+
+	locale, err := r.scanLocale(r.pool.QueryRow(ctx, query, tag))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("locale %w", repositories.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return locale, nil
+}
+
+// GetAll retrieves all locales with pagination
+func (r *LocaleRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.Locale, error) {
+	query := localeSelect + `
+		ORDER BY lc.tag
+		LIMIT $1 OFFSET $2`
+
+	rows, err := r.pool.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanLocales(rows)
+}
+
+// GetActive retrieves all active locales
+func (r *LocaleRepository) GetActive(ctx context.Context) ([]*entities.Locale, error) {
+	query := localeSelect + `
+		WHERE lc.is_active = true
+		ORDER BY lc.tag`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanLocales(rows)
+}
+
+// GetByLanguage retrieves the locales of a language
+func (r *LocaleRepository) GetByLanguage(ctx context.Context, languageID uuid.UUID) ([]*entities.Locale, error) {
+	query := localeSelect + `
+		WHERE lc.language_id = $1
+		ORDER BY lc.tag`
+
+	rows, err := r.pool.Query(ctx, query, languageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanLocales(rows)
+}
+
+// Update updates an existing locale
+func (r *LocaleRepository) Update(ctx context.Context, locale *entities.Locale) error {
+	locale.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE tm_locales SET
+			tag = $2, language_id = $3, country_id = $4, currency_id = $5, name = $6, native_name = $7,
+			first_day_of_week = $8, date_pattern = $9, time_pattern = $10, decimal_separator = $11,
+			group_separator = $12, is_active = $13, updated_at = $14
+		WHERE id = $1`
+
+	result, err := r.pool.Exec(ctx, query,
+		locale.ID, locale.Tag, locale.LanguageID, locale.CountryID, locale.CurrencyID, locale.Name, locale.NativeName,
+		locale.FirstDayOfWeek, locale.DatePattern, locale.TimePattern, locale.DecimalSeparator, locale.GroupSeparator,
+		locale.IsActive, locale.UpdatedAt,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("locale %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Delete deletes a locale by ID
+func (r *LocaleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, "DELETE FROM tm_locales WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("locale %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Truncate removes all locale records efficiently using TRUNCATE
+func (r *LocaleRepository) Truncate(ctx context.Context) error {
+	query := `TRUNCATE TABLE tm_locales RESTART IDENTITY CASCADE`
+	_, err := r.pool.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to truncate locales table: %w", err)
+	}
+	return nil
+}
+
+// scanLocales is a helper method to scan rows into locale entities
+func (r *LocaleRepository) scanLocales(rows pgx.Rows) ([]*entities.Locale, error) {
+	var locales []*entities.Locale
+
+	for rows.Next() {
+		locale, err := r.scanLocale(rows)
+		if err != nil {
+			return nil, err
+		}
+		locales = append(locales, locale)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return locales, nil
+}
+
+// scanLocale scans a row of localeSelect into a locale with its language and country
+func (r *LocaleRepository) scanLocale(row pgx.Row) (*entities.Locale, error) {
+	var locale entities.Locale
+	var language entities.Language
+	var country entities.Geodirectory
+
+	err := row.Scan(
+		&locale.ID, &locale.Tag, &locale.LanguageID, &locale.CountryID, &locale.CurrencyID, &locale.Name,
+		&locale.NativeName, &locale.FirstDayOfWeek, &locale.DatePattern, &locale.TimePattern,
+		&locale.DecimalSeparator, &locale.GroupSeparator, &locale.IsActive, &locale.CreatedAt, &locale.UpdatedAt,
+		&language.ID, &language.Name, &language.NativeName, &language.Code, &language.ISO6391,
+		&language.ISO6392T, &language.ISO6392B, &language.ISO6393, &language.Script, &language.Direction,
+		&language.IsActive, &language.CreatedAt, &language.UpdatedAt,
+		&country.ID, &country.Name, &country.Type, &country.Code,
+		&country.PostalCode, &country.Longitude, &country.Latitude,
+		&country.RecordLeft, &country.RecordRight, &country.RecordOrdering, &country.RecordDepth,
+		&country.ParentID, &country.CreatedAt, &country.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	locale.Language = &language
+	locale.Country = &country
+
+	return &locale, nil
+}
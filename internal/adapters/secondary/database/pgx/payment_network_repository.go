@@ -0,0 +1,264 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// PaymentNetworkRepository implements the PaymentNetworkRepository interface using pgx
+type PaymentNetworkRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPaymentNetworkRepository creates a new PaymentNetworkRepository instance
+func NewPaymentNetworkRepository(pool *pgxpool.Pool) *PaymentNetworkRepository {
+	return &PaymentNetworkRepository{
+		pool: pool,
+	}
+}
+
+// Create creates a new payment network in the database
+func (r *PaymentNetworkRepository) Create(ctx context.Context, network *entities.PaymentNetwork) error {
+	network.GenerateID()
+	network.CreatedAt = time.Now()
+	network.UpdatedAt = time.Now()
+
+	query := `
+		INSERT INTO tm_payment_networks (id, code, name, type, country_code, operator, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := r.pool.Exec(ctx, query,
+		network.ID, network.Code, network.Name, network.Type, network.CountryCode, network.Operator,
+		network.CreatedAt, network.UpdatedAt,
+	)
+
+	return err
+}
+
+// GetByCode retrieves a payment network by its code
+func (r *PaymentNetworkRepository) GetByCode(ctx context.Context, code string) (*entities.PaymentNetwork, error) {
+	query := `
+		SELECT id, code, name, type, country_code, operator, created_at, updated_at
+		FROM tm_payment_networks
+		WHERE code = $1`
+
+	var network entities.PaymentNetwork
+	err := r.pool.QueryRow(ctx, query, code).Scan(
+		&network.ID, &network.Code, &network.Name, &network.Type, &network.CountryCode, &network.Operator,
+		&network.CreatedAt, &network.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("payment network %w", repositories.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return &network, nil
+}
+
+// GetAll retrieves all payment networks ordered by country and code
+func (r *PaymentNetworkRepository) GetAll(ctx context.Context) ([]*entities.PaymentNetwork, error) {
+	query := `
+		SELECT id, code, name, type, country_code, operator, created_at, updated_at
+		FROM tm_payment_networks
+		ORDER BY country_code, code`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var networks []*entities.PaymentNetwork
+	for rows.Next() {
+		var network entities.PaymentNetwork
+		err := rows.Scan(
+			&network.ID, &network.Code, &network.Name, &network.Type, &network.CountryCode, &network.Operator,
+			&network.CreatedAt, &network.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, &network)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return networks, nil
+}
+
+// Update updates an existing payment network
+func (r *PaymentNetworkRepository) Update(ctx context.Context, network *entities.PaymentNetwork) error {
+	network.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE tm_payment_networks SET
+			name = $2, type = $3, country_code = $4, operator = $5, updated_at = $6
+		WHERE code = $1`
+
+	result, err := r.pool.Exec(ctx, query,
+		network.Code, network.Name, network.Type, network.CountryCode, network.Operator, network.UpdatedAt,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("payment network %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Delete deletes a payment network by its code together with its memberships
+func (r *PaymentNetworkRepository) Delete(ctx context.Context, code string) error {
+	result, err := r.pool.Exec(ctx, "DELETE FROM tm_payment_networks WHERE code = $1", code)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("payment network %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// BankNetworkMembershipRepository implements the BankNetworkMembershipRepository interface using pgx
+type BankNetworkMembershipRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBankNetworkMembershipRepository creates a new BankNetworkMembershipRepository instance
+func NewBankNetworkMembershipRepository(pool *pgxpool.Pool) *BankNetworkMembershipRepository {
+	return &BankNetworkMembershipRepository{
+		pool: pool,
+	}
+}
+
+// Create creates a new bank network membership in the database
+func (r *BankNetworkMembershipRepository) Create(ctx context.Context, membership *entities.BankNetworkMembership) error {
+	membership.GenerateID()
+	membership.CreatedAt = time.Now()
+	membership.UpdatedAt = time.Now()
+
+	query := `
+		INSERT INTO tm_bank_network_memberships (id, bank_id, network_code, member_code, status,
+			effective_from, effective_until, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.pool.Exec(ctx, query,
+		membership.ID, membership.BankID, membership.NetworkCode, membership.MemberCode, membership.Status,
+		membership.EffectiveFrom, membership.EffectiveUntil, membership.CreatedAt, membership.UpdatedAt,
+	)
+
+	return err
+}
+
+// GetByBankAndNetwork retrieves the membership of a bank in a payment network
+func (r *BankNetworkMembershipRepository) GetByBankAndNetwork(ctx context.Context, bankID uuid.UUID, networkCode string) (*entities.BankNetworkMembership, error) {
+	query := `
+		SELECT id, bank_id, network_code, member_code, status, effective_from, effective_until, created_at, updated_at
+		FROM tm_bank_network_memberships
+		WHERE bank_id = $1 AND network_code = $2`
+
+	var membership entities.BankNetworkMembership
+	err := r.pool.QueryRow(ctx, query, bankID, networkCode).Scan(
+		&membership.ID, &membership.BankID, &membership.NetworkCode, &membership.MemberCode, &membership.Status,
+		&membership.EffectiveFrom, &membership.EffectiveUntil, &membership.CreatedAt, &membership.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("bank network membership %w", repositories.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return &membership, nil
+}
+
+// GetByBank retrieves all network memberships of a bank
+func (r *BankNetworkMembershipRepository) GetByBank(ctx context.Context, bankID uuid.UUID) ([]*entities.BankNetworkMembership, error) {
+	query := `
+		SELECT id, bank_id, network_code, member_code, status, effective_from, effective_until, created_at, updated_at
+		FROM tm_bank_network_memberships
+		WHERE bank_id = $1
+		ORDER BY network_code`
+
+	rows, err := r.pool.Query(ctx, query, bankID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var memberships []*entities.BankNetworkMembership
+	for rows.Next() {
+		var membership entities.BankNetworkMembership
+		err := rows.Scan(
+			&membership.ID, &membership.BankID, &membership.NetworkCode, &membership.MemberCode, &membership.Status,
+			&membership.EffectiveFrom, &membership.EffectiveUntil, &membership.CreatedAt, &membership.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		memberships = append(memberships, &membership)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return memberships, nil
+}
+
+// Update updates an existing bank network membership
+func (r *BankNetworkMembershipRepository) Update(ctx context.Context, membership *entities.BankNetworkMembership) error {
+	membership.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE tm_bank_network_memberships SET
+			member_code = $2, status = $3, effective_from = $4, effective_until = $5, updated_at = $6
+		WHERE id = $1`
+
+	result, err := r.pool.Exec(ctx, query,
+		membership.ID, membership.MemberCode, membership.Status, membership.EffectiveFrom, membership.EffectiveUntil,
+		membership.UpdatedAt,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("bank network membership %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Delete deletes a bank network membership by ID
+func (r *BankNetworkMembershipRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, "DELETE FROM tm_bank_network_memberships WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("bank network membership %w", repositories.ErrNotFound)
+	}
+
+	return nil
+}
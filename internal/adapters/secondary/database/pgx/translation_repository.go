@@ -0,0 +1,153 @@
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// translationListColumns maps the fields of entities.TranslationListSchema to their columns
+var translationListColumns = map[string]string{
+	"entity_type":   "entity_type",
+	"entity_id":     "entity_id",
+	"field":         "field",
+	"language_code": "language_code",
+	"value":         "value",
+	"updated_at":    "updated_at",
+}
+
+// TranslationRepository implements the TranslationRepository interface using pgx
+type TranslationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTranslationRepository creates a new TranslationRepository instance
+func NewTranslationRepository(pool *pgxpool.Pool) *TranslationRepository {
+	return &TranslationRepository{
+		pool: pool,
+	}
+}
+
+// Upsert creates or updates translations in a single transaction. A translation that already exists for the
+// same entity, field and language keeps its ID and creation time, which are set on the entity.
+func (r *TranslationRepository) Upsert(ctx context.Context, translations []*entities.Translation) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO tm_translations (id, entity_type, entity_id, field, language_code, value, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (entity_type, entity_id, field, language_code)
+		DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at`
+
+	for _, translation := range translations {
+		translation.GenerateID()
+		translation.CreatedAt = time.Now()
+		translation.UpdatedAt = time.Now()
+
+		err := tx.QueryRow(ctx, query,
+			translation.ID, translation.EntityType, translation.EntityID, translation.Field,
+			translation.LanguageCode, translation.Value, translation.CreatedAt, translation.UpdatedAt,
+		).Scan(&translation.ID, &translation.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// DeleteByKeys deletes translations by their keys in a single transaction and returns the number deleted;
+// keys without a translation are ignored
+func (r *TranslationRepository) DeleteByKeys(ctx context.Context, keys []entities.TranslationKey) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		DELETE FROM tm_translations
+		WHERE entity_type = $1 AND entity_id = $2 AND field = $3 AND language_code = $4`
+
+	var deleted int64
+	for _, key := range keys {
+		result, err := tx.Exec(ctx, query, key.EntityType, key.EntityID, key.Field, key.LanguageCode)
+		if err != nil {
+			return 0, err
+		}
+		deleted += result.RowsAffected()
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+// List retrieves translations matching the filters of a list query, ordered by the requested sort fields
+func (r *TranslationRepository) List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Translation, error) {
+	sql, args, err := buildListQuery(`
+		SELECT id, entity_type, entity_id, field, language_code, value, created_at, updated_at
+		FROM tm_translations`, query, translationListColumns, "entity_type, entity_id, field, language_code")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanTranslations(rows)
+}
+
+// GetByEntities retrieves the translations of entities of a type in a language
+func (r *TranslationRepository) GetByEntities(ctx context.Context, entityType string, entityIDs []uuid.UUID, languageCode string) ([]*entities.Translation, error) {
+	query := `
+		SELECT id, entity_type, entity_id, field, language_code, value, created_at, updated_at
+		FROM tm_translations
+		WHERE entity_type = $1 AND language_code = $2 AND entity_id = ANY($3::uuid[])`
+
+	rows, err := r.pool.Query(ctx, query, entityType, languageCode, entityIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanTranslations(rows)
+}
+
+// scanTranslations is a helper method to scan rows into translation entities
+func (r *TranslationRepository) scanTranslations(rows pgx.Rows) ([]*entities.Translation, error) {
+	var translations []*entities.Translation
+
+	for rows.Next() {
+		var translation entities.Translation
+		err := rows.Scan(
+			&translation.ID, &translation.EntityType, &translation.EntityID, &translation.Field,
+			&translation.LanguageCode, &translation.Value, &translation.CreatedAt, &translation.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		translations = append(translations, &translation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return translations, nil
+}
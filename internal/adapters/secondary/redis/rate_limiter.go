@@ -3,6 +3,8 @@ package redis
 import (
 	"context"
 	"fmt"
+	"math"
+	"strconv"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -62,6 +64,8 @@ func (r *RateLimiter) CheckRateLimit(ctx context.Context, identifier string, con
 		local window_start = tonumber(ARGV[1])
 		local window_end = tonumber(ARGV[2])
 		local max_requests = tonumber(ARGV[3])
+		local now = tonumber(ARGV[4])
+		local member = ARGV[5]
 		
 		-- Remove expired entries
 		redis.call('ZREMRANGEBYSCORE', key, 0, window_start - 1)
@@ -75,8 +79,8 @@ func (r *RateLimiter) CheckRateLimit(ctx context.Context, identifier string, con
 		end
 		
 		-- Add current request
-		redis.call('ZADD', key, now(), now())
-		redis.call('EXPIRE', key, window_end - now())
+		redis.call('ZADD', key, now, member)
+		redis.call('EXPIRE', key, math.max(window_end - now, 1))
 		
 		return {1, max_requests - current_requests - 1, window_end}
 	`
@@ -85,7 +89,9 @@ func (r *RateLimiter) CheckRateLimit(ctx context.Context, identifier string, con
 	result, err := r.client.Eval(ctx, script, []string{key},
 		windowStart.Unix(),
 		windowStart.Add(config.Window).Unix(),
-		config.Requests).Result()
+		config.Requests,
+		now.Unix(),
+		strconv.FormatInt(now.UnixNano(), 10)).Result()
 
 	if err != nil {
 		r.log.WithError(err).Error("Failed to execute rate limit script")
@@ -196,3 +202,146 @@ func (r *RateLimiter) GetRateLimitStats(ctx context.Context, config RateLimitCon
 
 	return stats, nil
 }
+
+// PlanLimitConfig holds the rate limit plan of a client: a token bucket refilled with RequestsPerMinute
+// tokens a minute holding up to Burst tokens, and an optional quota of requests per quota window
+type PlanLimitConfig struct {
+	RequestsPerMinute int       // Sustained number of requests per minute
+	Burst             int       // Number of requests allowed at once
+	Quota             int64     // Number of requests per quota window, 0 for no quota
+	QuotaWindowStart  time.Time // Start of the current quota window
+	QuotaWindowEnd    time.Time // End of the current quota window
+}
+
+// PlanLimitResult contains the result of a plan limit check
+type PlanLimitResult struct {
+	Allowed        bool          // Whether the request is allowed
+	Remaining      int           // Requests that can be made at once
+	ResetTime      time.Time     // When the bucket is full again
+	RetryAfter     time.Duration // How long to wait before retrying
+	QuotaUsed      int64         // Requests made in the current quota window
+	QuotaRemaining int64         // Requests left in the current quota window, -1 without quota
+	QuotaExceeded  bool          // Whether the request was rejected because of the quota
+}
+
+// planLimitScript refills the token bucket of KEYS[1] and counts the quota in KEYS[2]. A request is taken
+// from both only when ARGV[6] is 1 and both allow it. It returns whether the request is allowed, the
+// thousandths of tokens left, the requests made in the quota window and whether the quota was exceeded.
+const planLimitScript = `
+	local bucket = KEYS[1]
+	local quota_key = KEYS[2]
+	local now = tonumber(ARGV[1])
+	local rate = tonumber(ARGV[2])
+	local burst = tonumber(ARGV[3])
+	local quota = tonumber(ARGV[4])
+	local quota_ttl = tonumber(ARGV[5])
+	local consume = tonumber(ARGV[6])
+
+	local tokens = tonumber(redis.call('HGET', bucket, 'tokens'))
+	local updated = tonumber(redis.call('HGET', bucket, 'updated'))
+	if tokens == nil or updated == nil then
+		tokens = burst
+		updated = now
+	end
+	tokens = math.min(burst, tokens + math.max(now - updated, 0) * rate)
+
+	local used = tonumber(redis.call('GET', quota_key) or '0')
+	if quota > 0 and used >= quota then
+		return {0, math.floor(tokens * 1000), used, 1}
+	end
+	if tokens < 1 then
+		return {0, math.floor(tokens * 1000), used, 0}
+	end
+
+	if consume == 1 then
+		tokens = tokens - 1
+		redis.call('HSET', bucket, 'tokens', tostring(tokens), 'updated', tostring(now))
+		redis.call('PEXPIRE', bucket, math.ceil(burst / rate) + 1000)
+		if quota > 0 then
+			used = redis.call('INCR', quota_key)
+			if used == 1 then
+				redis.call('EXPIRE', quota_key, quota_ttl)
+			end
+		end
+	end
+
+	return {1, math.floor(tokens * 1000), used, 0}
+`
+
+// CheckPlanLimit takes a request from the token bucket and quota of an identifier when both allow it
+func (r *RateLimiter) CheckPlanLimit(ctx context.Context, identifier string, config PlanLimitConfig) (*PlanLimitResult, error) {
+	return r.evalPlanLimit(ctx, identifier, config, true)
+}
+
+// GetPlanLimitInfo gets the state of the token bucket and quota of an identifier without taking a request
+func (r *RateLimiter) GetPlanLimitInfo(ctx context.Context, identifier string, config PlanLimitConfig) (*PlanLimitResult, error) {
+	return r.evalPlanLimit(ctx, identifier, config, false)
+}
+
+// evalPlanLimit runs the plan limit script for an identifier
+func (r *RateLimiter) evalPlanLimit(ctx context.Context, identifier string, config PlanLimitConfig, consume bool) (*PlanLimitResult, error) {
+	quotaRemaining := int64(-1)
+	if config.Quota > 0 {
+		quotaRemaining = config.Quota
+	}
+
+	if r.client == nil {
+		// If Redis is not available, allow all requests
+		return &PlanLimitResult{
+			Allowed:        true,
+			Remaining:      config.Burst,
+			QuotaRemaining: quotaRemaining,
+		}, nil
+	}
+
+	now := time.Now()
+	rate := float64(config.RequestsPerMinute) / float64(time.Minute.Milliseconds()) // tokens per millisecond
+	bucketKey := fmt.Sprintf("rate_limit:plan:%s", identifier)
+	quotaKey := fmt.Sprintf("rate_limit:quota:%s:%d", identifier, config.QuotaWindowStart.Unix())
+	quotaTTL := int64(math.Ceil(config.QuotaWindowEnd.Sub(now).Seconds())) + 60
+
+	consumeArg := 0
+	if consume {
+		consumeArg = 1
+	}
+
+	result, err := r.client.Eval(ctx, planLimitScript, []string{bucketKey, quotaKey},
+		now.UnixMilli(),
+		strconv.FormatFloat(rate, 'f', -1, 64),
+		config.Burst,
+		config.Quota,
+		quotaTTL,
+		consumeArg).Result()
+	if err != nil {
+		r.log.WithError(err).Error("Failed to execute plan limit script")
+		return nil, fmt.Errorf("failed to check plan limit: %w", err)
+	}
+
+	results := result.([]interface{})
+	tokens := float64(results[1].(int64)) / 1000
+	used := results[2].(int64)
+
+	planResult := &PlanLimitResult{
+		Allowed:        results[0].(int64) == 1,
+		Remaining:      int(tokens),
+		ResetTime:      now.Add(time.Duration((float64(config.Burst) - tokens) / rate * float64(time.Millisecond))),
+		QuotaUsed:      used,
+		QuotaRemaining: quotaRemaining,
+		QuotaExceeded:  results[3].(int64) == 1,
+	}
+	if config.Quota > 0 {
+		planResult.QuotaRemaining = config.Quota - used
+		if planResult.QuotaRemaining < 0 {
+			planResult.QuotaRemaining = 0
+		}
+	}
+
+	switch {
+	case planResult.QuotaExceeded:
+		planResult.RetryAfter = config.QuotaWindowEnd.Sub(now)
+	case !planResult.Allowed:
+		planResult.RetryAfter = time.Duration((1 - tokens) / rate * float64(time.Millisecond))
+	}
+
+	return planResult, nil
+}
@@ -0,0 +1,152 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis is a minimal RESP server that records the EVAL commands it receives
+// and answers them as an allowed rate limit check
+type fakeRedis struct {
+	listener net.Listener
+
+	mu    sync.Mutex
+	evals [][]string
+}
+
+// newFakeRedis starts a fakeRedis on a random local port
+func newFakeRedis(t *testing.T) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &fakeRedis{listener: listener}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+
+	return server
+}
+
+func (s *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	for {
+		command, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+
+		if strings.EqualFold(command[0], "EVAL") {
+			s.mu.Lock()
+			s.evals = append(s.evals, command)
+			s.mu.Unlock()
+			// EVAL script numkeys key window_start window_end max_requests ...
+			fmt.Fprintf(conn, "*3\r\n:1\r\n:9\r\n:%s\r\n", command[5])
+			continue
+		}
+		fmt.Fprint(conn, "-ERR unknown command\r\n")
+	}
+}
+
+// evalCommands returns the EVAL commands received so far
+func (s *fakeRedis) evalCommands() [][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.evals
+}
+
+// readCommand reads a command sent as a RESP array of bulk strings
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+
+	command := make([]string, count)
+	for i := range command {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "$")))
+		if err != nil {
+			return nil, err
+		}
+		value := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, value); err != nil {
+			return nil, err
+		}
+		command[i] = string(value[:size])
+	}
+
+	return command, nil
+}
+
+func TestRateLimiter_CheckRateLimit(t *testing.T) {
+	// Setup
+	server := newFakeRedis(t)
+	client := redis.NewClient(&redis.Options{
+		Addr:            server.listener.Addr().String(),
+		Protocol:        2,
+		DisableIdentity: true,
+	})
+	defer client.Close()
+
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	limiter := NewRateLimiter(client, log)
+	config := RateLimitConfig{Requests: 10, Window: time.Minute, Key: "test"}
+
+	// Test
+	before := time.Now()
+	for i := 0; i < 2; i++ {
+		result, err := limiter.CheckRateLimit(context.Background(), "client", config)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 9, result.Remaining)
+	}
+	after := time.Now()
+
+	// Assertions
+	evals := server.evalCommands()
+	require.Len(t, evals, 2)
+	for _, eval := range evals {
+		script := eval[1]
+		assert.NotContains(t, script, "now()", "the script must not call an undefined Lua function")
+		assert.Equal(t, "rate_limit:test:client", eval[3])
+
+		now, err := strconv.ParseInt(eval[7], 10, 64)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, now, before.Unix())
+		assert.LessOrEqual(t, now, after.Unix())
+	}
+
+	// Requests in the same second are counted as separate members of the window
+	assert.NotEqual(t, evals[0][8], evals[1][8])
+}
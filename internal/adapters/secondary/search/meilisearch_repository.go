@@ -13,6 +13,7 @@ import (
 // Index names constants
 const (
 	BanksIndex          = "banks"
+	BankBranchesIndex   = "bank_branches"
 	CurrenciesIndex     = "currencies"
 	LanguagesIndex      = "languages"
 	GeodirectoriesIndex = "geodirectories"
@@ -37,6 +38,7 @@ func (r *MeilisearchRepository) CreateIndexes() error {
 		primaryKey string
 	}{
 		{BanksIndex, "id"},
+		{BankBranchesIndex, "id"},
 		{CurrenciesIndex, "id"},
 		{LanguagesIndex, "id"},
 		{GeodirectoriesIndex, "id"},
@@ -61,21 +63,35 @@ func (r *MeilisearchRepository) CreateIndexes() error {
 func (r *MeilisearchRepository) configureIndexSettings() error {
 	// Banks index settings
 	banksIndex := r.client.GetIndex(BanksIndex)
-	searchableAttrs := []string{"name", "alias", "company", "code"}
+	searchableAttrs := []string{"name", "alias", "company", "code", "bic"}
 	_, err := banksIndex.UpdateSearchableAttributes(&searchableAttrs)
 	if err != nil {
 		return fmt.Errorf("failed to update banks searchable attributes: %w", err)
 	}
 
-	filterableAttrs := []interface{}{"code", "company"}
+	filterableAttrs := []interface{}{"code", "company", "bic", "status"}
 	_, err = banksIndex.UpdateFilterableAttributes(&filterableAttrs)
 	if err != nil {
 		return fmt.Errorf("failed to update banks filterable attributes: %w", err)
 	}
 
+	// Bank branches index settings
+	branchesIndex := r.client.GetIndex(BankBranchesIndex)
+	branchSearchableAttrs := []string{"name", "code", "address", "bic"}
+	_, err = branchesIndex.UpdateSearchableAttributes(&branchSearchableAttrs)
+	if err != nil {
+		return fmt.Errorf("failed to update bank branches searchable attributes: %w", err)
+	}
+
+	branchFilterableAttrs := []interface{}{"bank_id", "geodirectory_id", "code", "bic"}
+	_, err = branchesIndex.UpdateFilterableAttributes(&branchFilterableAttrs)
+	if err != nil {
+		return fmt.Errorf("failed to update bank branches filterable attributes: %w", err)
+	}
+
 	// Currencies index settings
 	currenciesIndex := r.client.GetIndex(CurrenciesIndex)
-	currencySearchableAttrs := []string{"name", "code", "symbol"}
+	currencySearchableAttrs := []string{"name", "code", "symbol", "numeric_code"}
 	_, err = currenciesIndex.UpdateSearchableAttributes(&currencySearchableAttrs)
 	if err != nil {
 		return fmt.Errorf("failed to update currencies searchable attributes: %w", err)
@@ -89,13 +105,13 @@ func (r *MeilisearchRepository) configureIndexSettings() error {
 
 	// Languages index settings
 	languagesIndex := r.client.GetIndex(LanguagesIndex)
-	languageSearchableAttrs := []string{"name", "code"}
+	languageSearchableAttrs := []string{"name", "native_name", "code", "iso639_1", "iso639_2t", "iso639_2b", "iso639_3"}
 	_, err = languagesIndex.UpdateSearchableAttributes(&languageSearchableAttrs)
 	if err != nil {
 		return fmt.Errorf("failed to update languages searchable attributes: %w", err)
 	}
 
-	languageFilterableAttrs := []interface{}{"code", "is_active"}
+	languageFilterableAttrs := []interface{}{"code", "iso639_1", "iso639_2t", "iso639_2b", "iso639_3", "script", "direction", "is_active"}
 	_, err = languagesIndex.UpdateFilterableAttributes(&languageFilterableAttrs)
 	if err != nil {
 		return fmt.Errorf("failed to update languages filterable attributes: %w", err)
@@ -129,6 +145,16 @@ func (r *MeilisearchRepository) IndexBank(ctx context.Context, bank *entities.Ba
 	return nil
 }
 
+// IndexBankBranch adds or updates a bank branch in the search index
+func (r *MeilisearchRepository) IndexBankBranch(ctx context.Context, branch *entities.BankBranch) error {
+	index := r.client.GetIndex(BankBranchesIndex)
+	_, err := index.AddDocuments([]interface{}{branch}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to index bank branch %s: %w", branch.ID, err)
+	}
+	return nil
+}
+
 // IndexCurrency adds or updates a currency in the search index
 func (r *MeilisearchRepository) IndexCurrency(ctx context.Context, currency *entities.Currency) error {
 	index := r.client.GetIndex(CurrenciesIndex)
@@ -186,6 +212,33 @@ func (r *MeilisearchRepository) SearchBanks(ctx context.Context, query string, l
 	return banks, nil
 }
 
+// SearchBankBranches performs a search query on bank branches index
+func (r *MeilisearchRepository) SearchBankBranches(ctx context.Context, query string, limit, offset int) ([]*entities.BankBranch, error) {
+	index := r.client.GetIndex(BankBranchesIndex)
+	searchRequest := &meilisearch.SearchRequest{
+		Query:  query,
+		Limit:  int64(limit),
+		Offset: int64(offset),
+	}
+
+	result, err := index.Search(query, searchRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search bank branches: %w", err)
+	}
+
+	var branches []*entities.BankBranch
+	for _, hit := range result.Hits {
+		branch := &entities.BankBranch{}
+		if err := mapToStruct(hit, branch); err != nil {
+			log.Printf("Failed to map search hit to bank branch: %v", err)
+			continue
+		}
+		branches = append(branches, branch)
+	}
+
+	return branches, nil
+}
+
 // SearchCurrencies performs a search query on currencies index
 func (r *MeilisearchRepository) SearchCurrencies(ctx context.Context, query string, limit, offset int) ([]*entities.Currency, error) {
 	index := r.client.GetIndex(CurrenciesIndex)
@@ -288,6 +341,27 @@ func (r *MeilisearchRepository) IndexAllBanks(ctx context.Context, banks []*enti
 	return nil
 }
 
+// IndexAllBankBranches bulk indexes all bank branches
+func (r *MeilisearchRepository) IndexAllBankBranches(ctx context.Context, branches []*entities.BankBranch) error {
+	if len(branches) == 0 {
+		return nil
+	}
+
+	index := r.client.GetIndex(BankBranchesIndex)
+	documents := make([]interface{}, len(branches))
+	for i, branch := range branches {
+		documents[i] = branch
+	}
+
+	_, err := index.AddDocuments(documents, nil)
+	if err != nil {
+		return fmt.Errorf("failed to bulk index bank branches: %w", err)
+	}
+
+	log.Printf("Successfully indexed %d bank branches", len(branches))
+	return nil
+}
+
 // IndexAllCurrencies bulk indexes all currencies
 func (r *MeilisearchRepository) IndexAllCurrencies(ctx context.Context, currencies []*entities.Currency) error {
 	if len(currencies) == 0 {
@@ -361,6 +435,16 @@ func (r *MeilisearchRepository) DeleteBankFromIndex(ctx context.Context, bankID
 	return nil
 }
 
+// DeleteBankBranchFromIndex removes a bank branch from the search index
+func (r *MeilisearchRepository) DeleteBankBranchFromIndex(ctx context.Context, branchID string) error {
+	index := r.client.GetIndex(BankBranchesIndex)
+	_, err := index.DeleteDocument(branchID)
+	if err != nil {
+		return fmt.Errorf("failed to delete bank branch %s from index: %w", branchID, err)
+	}
+	return nil
+}
+
 // DeleteCurrencyFromIndex removes a currency from the search index
 func (r *MeilisearchRepository) DeleteCurrencyFromIndex(ctx context.Context, currencyID string) error {
 	index := r.client.GetIndex(CurrenciesIndex)
@@ -1,23 +1,118 @@
 package entities
 
 import (
+	"net/netip"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-// APIKey represents an API key entity
+// APIKeyPrefixLength is the number of leading characters of an API key kept visible to identify it
+const APIKeyPrefixLength = 8
+
+// API key scopes grant access to groups of endpoints. A write scope also grants the read scope of its resource.
+const (
+	ScopeGeoRead           = "geo:read"
+	ScopeBanksRead         = "banks:read"
+	ScopeBanksWrite        = "banks:write"
+	ScopeCurrenciesRead    = "currencies:read"
+	ScopeLanguagesRead     = "languages:read"
+	ScopeTranslationsRead  = "translations:read"
+	ScopeTranslationsWrite = "translations:write"
+	ScopeAdminAPIKeys      = "admin:api-keys"
+	ScopeAdminRateLimit    = "admin:rate-limit"
+)
+
+// APIKeyScopes lists every scope an API key can hold
+var APIKeyScopes = []string{
+	ScopeGeoRead, ScopeBanksRead, ScopeBanksWrite, ScopeCurrenciesRead,
+	ScopeLanguagesRead, ScopeTranslationsRead, ScopeTranslationsWrite, ScopeAdminAPIKeys, ScopeAdminRateLimit,
+}
+
+// DefaultAPIKeyScopes are the scopes of API keys created without scopes: read access to all master data
+var DefaultAPIKeyScopes = []string{ScopeGeoRead, ScopeBanksRead, ScopeCurrenciesRead, ScopeLanguagesRead, ScopeTranslationsRead}
+
+// IsAPIKeyScope checks if a scope is one of APIKeyScopes
+func IsAPIKeyScope(scope string) bool {
+	for _, known := range APIKeyScopes {
+		if scope == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Quota periods of API key plans
+const (
+	QuotaPeriodDay   = "day"
+	QuotaPeriodMonth = "month"
+)
+
+// APIKeyPlan is the rate limit plan of an API key: a sustained rate of requests per minute with bursts of up
+// to Burst requests, and an optional quota of requests per day or month
+type APIKeyPlan struct {
+	Name              string `json:"name" db:"plan_name"`
+	RequestsPerMinute int    `json:"requests_per_minute" db:"requests_per_minute"`
+	Burst             int    `json:"burst" db:"burst"`
+	Quota             int64  `json:"quota" db:"quota"`                         // Requests per quota period, 0 for no quota
+	QuotaPeriod       string `json:"quota_period,omitempty" db:"quota_period"` // day or month, empty without quota
+}
+
+// DefaultAPIKeyPlan returns the plan of API keys created without a plan
+func DefaultAPIKeyPlan() APIKeyPlan {
+	return APIKeyPlan{
+		Name:              "standard",
+		RequestsPerMinute: 1000,
+		Burst:             100,
+	}
+}
+
+// IsValid checks if the plan has a positive rate and burst, and a quota period exactly when it has a quota
+func (p APIKeyPlan) IsValid() bool {
+	if p.Name == "" || p.RequestsPerMinute <= 0 || p.Burst <= 0 || p.Quota < 0 {
+		return false
+	}
+	if p.Quota == 0 {
+		return p.QuotaPeriod == ""
+	}
+	return p.QuotaPeriod == QuotaPeriodDay || p.QuotaPeriod == QuotaPeriodMonth
+}
+
+// QuotaWindow returns the UTC start and end of the quota period containing the time
+func (p APIKeyPlan) QuotaWindow(at time.Time) (time.Time, time.Time) {
+	at = at.UTC()
+	if p.QuotaPeriod == QuotaPeriodMonth {
+		start := time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	}
+	start := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 0, 1)
+}
+
+// APIKey represents an API key entity. Only a hash of the key is stored; the key itself is known only
+// when the API key is created. Rotating a key issues a successor with the same IdentityID, so that the keys
+// of a client can be followed across rotations.
 type APIKey struct {
-	ID          uuid.UUID  `json:"id" db:"id"`
-	Name        string     `json:"name" db:"name"`
-	Key         string     `json:"key" db:"key"`
-	Description *string    `json:"description,omitempty" db:"description"`
-	IsActive    bool       `json:"is_active" db:"is_active"`
-	ExpiresAt   *time.Time `json:"expires_at,omitempty" db:"expires_at"`
-	LastUsedAt  *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
-	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
-	DeletedAt   *time.Time `json:"-" db:"deleted_at"`
+	ID               uuid.UUID  `json:"id" db:"id"`
+	IdentityID       uuid.UUID  `json:"identity_id" db:"identity_id"`
+	Name             string     `json:"name" db:"name"`
+	Key              string     `json:"key,omitempty" db:"-"`
+	KeyHash          string     `json:"-" db:"key_hash"`
+	KeyPrefix        string     `json:"key_prefix" db:"key_prefix"`
+	Description      *string    `json:"description,omitempty" db:"description"`
+	Scopes           []string   `json:"scopes" db:"scopes"`
+	Plan             APIKeyPlan `json:"plan"`
+	AllowedCIDRs     []string   `json:"allowed_cidrs" db:"allowed_cidrs"` // IPv4 and IPv6 ranges the key may be used from, any when empty
+	IsActive         bool       `json:"is_active" db:"is_active"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	LastUsedAt       *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	RotatedFromID    *uuid.UUID `json:"rotated_from_id,omitempty" db:"rotated_from_id"`     // Key this key replaces
+	ReplacedByID     *uuid.UUID `json:"replaced_by_id,omitempty" db:"replaced_by_id"`       // Successor of a rotated key
+	RotationDeadline *time.Time `json:"rotation_deadline,omitempty" db:"rotation_deadline"` // End of the grace period of a rotated key
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+	DeletedAt        *time.Time `json:"-" db:"deleted_at"`
 }
 
 // TableName returns the table name for the APIKey entity
@@ -27,12 +122,26 @@ func (APIKey) TableName() string {
 
 // NewAPIKey creates a new APIKey instance
 func NewAPIKey(name, key string) *APIKey {
+	id := uuid.New()
 	return &APIKey{
-		ID:       uuid.New(),
-		Name:     name,
-		Key:      key,
-		IsActive: true,
+		ID:           id,
+		IdentityID:   id,
+		Name:         name,
+		Key:          key,
+		KeyPrefix:    APIKeyPrefix(key),
+		Scopes:       []string{},
+		Plan:         DefaultAPIKeyPlan(),
+		AllowedCIDRs: []string{},
+		IsActive:     true,
+	}
+}
+
+// APIKeyPrefix returns the visible prefix of an API key
+func APIKeyPrefix(key string) string {
+	if len(key) <= APIKeyPrefixLength {
+		return key
 	}
+	return key[:APIKeyPrefixLength]
 }
 
 // SetDescription sets the description for the API key
@@ -40,6 +149,92 @@ func (a *APIKey) SetDescription(description string) {
 	a.Description = &description
 }
 
+// SetScopes sets the scopes of the API key, lowercased and without duplicates
+func (a *APIKey) SetScopes(scopes []string) {
+	a.Scopes = make([]string, 0, len(scopes))
+	seen := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if scope == "" || seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		a.Scopes = append(a.Scopes, scope)
+	}
+}
+
+// HasScope checks if the API key holds a scope; a write scope also grants the read scope of its resource
+func (a *APIKey) HasScope(scope string) bool {
+	writeScope := ""
+	if resource, ok := strings.CutSuffix(scope, ":read"); ok {
+		writeScope = resource + ":write"
+	}
+
+	for _, held := range a.Scopes {
+		if held == scope || (writeScope != "" && held == writeScope) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseAllowedCIDR parses an allowed CIDR range; a single IPv4 or IPv6 address is a range of one address
+func ParseAllowedCIDR(cidr string) (netip.Prefix, error) {
+	if !strings.Contains(cidr, "/") {
+		addr, err := netip.ParseAddr(cidr)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
+
+// SetAllowedCIDRs sets the CIDR ranges the API key may be used from, in canonical form and without
+// duplicates. Ranges that cannot be parsed are kept as given, so that validation can reject them.
+func (a *APIKey) SetAllowedCIDRs(cidrs []string) {
+	a.AllowedCIDRs = make([]string, 0, len(cidrs))
+	seen := make(map[string]bool, len(cidrs))
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if prefix, err := ParseAllowedCIDR(cidr); err == nil {
+			cidr = prefix.String()
+		}
+		if cidr == "" || seen[cidr] {
+			continue
+		}
+		seen[cidr] = true
+		a.AllowedCIDRs = append(a.AllowedCIDRs, cidr)
+	}
+}
+
+// IsIPAllowed checks if the API key may be used from an IP address; keys without allowed CIDR ranges may
+// be used from any address
+func (a *APIKey) IsIPAllowed(ip string) bool {
+	if len(a.AllowedCIDRs) == 0 {
+		return true
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, cidr := range a.AllowedCIDRs {
+		if prefix, err := ParseAllowedCIDR(cidr); err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 // SetExpiration sets the expiration date for the API key
 func (a *APIKey) SetExpiration(expiresAt time.Time) {
 	a.ExpiresAt = &expiresAt
@@ -53,9 +248,38 @@ func (a *APIKey) IsExpired() bool {
 	return time.Now().After(*a.ExpiresAt)
 }
 
-// IsValid checks if the API key is valid (active and not expired)
+// IsValid checks if the API key is valid (active, not expired and not past its rotation deadline)
 func (a *APIKey) IsValid() bool {
-	return a.IsActive && !a.IsExpired()
+	return a.IsActive && !a.IsExpired() && !a.IsRotationExpired()
+}
+
+// IsRotated checks if the API key has been replaced by a successor
+func (a *APIKey) IsRotated() bool {
+	return a.ReplacedByID != nil
+}
+
+// IsRotationExpired checks if the API key has been rotated and its grace period has ended
+func (a *APIKey) IsRotationExpired() bool {
+	if a.RotationDeadline == nil {
+		return false
+	}
+	return !time.Now().Before(*a.RotationDeadline)
+}
+
+// Rotate links the API key to its successor, which takes over its identity, name, description, scopes,
+// plan, allowed CIDR ranges and expiration. The API key stays valid until the deadline.
+func (a *APIKey) Rotate(successor *APIKey, deadline time.Time) {
+	successor.IdentityID = a.IdentityID
+	successor.Name = a.Name
+	successor.Description = a.Description
+	successor.Scopes = append([]string{}, a.Scopes...)
+	successor.Plan = a.Plan
+	successor.AllowedCIDRs = append([]string{}, a.AllowedCIDRs...)
+	successor.ExpiresAt = a.ExpiresAt
+	successor.RotatedFromID = &a.ID
+
+	a.ReplacedByID = &successor.ID
+	a.RotationDeadline = &deadline
 }
 
 // UpdateLastUsed updates the last used timestamp
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -21,14 +22,182 @@ func TestNewAPIKey(t *testing.T) {
 	assert.NotNil(t, apiKey)
 	assert.Equal(t, name, apiKey.Name)
 	assert.Equal(t, key, apiKey.Key)
+	assert.Equal(t, "test-key", apiKey.KeyPrefix)
+	assert.Empty(t, apiKey.KeyHash)
+	assert.Equal(t, DefaultAPIKeyPlan(), apiKey.Plan)
 	assert.True(t, apiKey.IsActive)
 	assert.NotEqual(t, uuid.Nil, apiKey.ID)
+	assert.Equal(t, apiKey.ID, apiKey.IdentityID)
+	assert.Empty(t, apiKey.AllowedCIDRs)
 	assert.Nil(t, apiKey.Description)
 	assert.Nil(t, apiKey.ExpiresAt)
 	assert.Nil(t, apiKey.LastUsedAt)
 	assert.Nil(t, apiKey.DeletedAt)
 }
 
+func TestAPIKeyPrefix(t *testing.T) {
+	assert.Equal(t, "0123abcd", APIKeyPrefix("0123abcdef456789"))
+	assert.Equal(t, "short", APIKeyPrefix("short"))
+	assert.Equal(t, "", APIKeyPrefix(""))
+}
+
+func TestAPIKey_JSONOmitsHash(t *testing.T) {
+	// Given
+	apiKey := NewAPIKey("Test", "0123abcdef456789")
+	apiKey.KeyHash = "hash"
+
+	// When
+	created, err := json.Marshal(apiKey)
+	require.NoError(t, err)
+	apiKey.Key = ""
+	stored, err := json.Marshal(apiKey)
+	require.NoError(t, err)
+
+	// Then
+	assert.Contains(t, string(created), `"key":"0123abcdef456789"`)
+	assert.NotContains(t, string(created), "hash")
+	assert.NotContains(t, string(stored), `"key":`)
+	assert.Contains(t, string(stored), `"key_prefix":"0123abcd"`)
+}
+
+func TestAPIKey_SetScopes(t *testing.T) {
+	// Given
+	apiKey := NewAPIKey("Test", "key")
+
+	// When
+	apiKey.SetScopes([]string{" Banks:Write ", "geo:read", "", "banks:write"})
+
+	// Then
+	assert.Equal(t, []string{"banks:write", "geo:read"}, apiKey.Scopes)
+}
+
+func TestAPIKey_HasScope(t *testing.T) {
+	apiKey := NewAPIKey("Test", "key")
+	apiKey.SetScopes([]string{ScopeBanksWrite, ScopeGeoRead})
+
+	tests := []struct {
+		scope    string
+		expected bool
+	}{
+		{ScopeBanksWrite, true},
+		{ScopeBanksRead, true},
+		{ScopeGeoRead, true},
+		{ScopeCurrenciesRead, false},
+		{ScopeTranslationsRead, false},
+		{ScopeTranslationsWrite, false},
+		{ScopeAdminAPIKeys, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scope, func(t *testing.T) {
+			assert.Equal(t, tt.expected, apiKey.HasScope(tt.scope))
+		})
+	}
+
+	assert.False(t, NewAPIKey("Test", "key").HasScope(ScopeGeoRead))
+
+	translator := NewAPIKey("Translator", "key")
+	translator.SetScopes([]string{ScopeTranslationsWrite})
+	assert.True(t, translator.HasScope(ScopeTranslationsRead))
+	assert.False(t, translator.HasScope(ScopeLanguagesRead))
+}
+
+func TestIsAPIKeyScope(t *testing.T) {
+	for _, scope := range APIKeyScopes {
+		assert.True(t, IsAPIKeyScope(scope), scope)
+	}
+	assert.False(t, IsAPIKeyScope("geo:write"))
+	assert.False(t, IsAPIKeyScope("*"))
+}
+
+func TestParseAllowedCIDR(t *testing.T) {
+	tests := []struct {
+		cidr     string
+		expected string
+	}{
+		{"203.0.113.0/24", "203.0.113.0/24"},
+		{"203.0.113.7/24", "203.0.113.0/24"},
+		{"203.0.113.7", "203.0.113.7/32"},
+		{"2001:db8::/32", "2001:db8::/32"},
+		{"2001:DB8::1", "2001:db8::1/128"},
+		{"::ffff:203.0.113.7", "203.0.113.7/32"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cidr, func(t *testing.T) {
+			prefix, err := ParseAllowedCIDR(tt.cidr)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, prefix.String())
+		})
+	}
+
+	for _, cidr := range []string{"", "203.0.113.0/33", "2001:db8::/129", "example.com", "10.0.0.0/8/8"} {
+		_, err := ParseAllowedCIDR(cidr)
+		assert.Error(t, err, cidr)
+	}
+}
+
+func TestAPIKey_SetAllowedCIDRs(t *testing.T) {
+	apiKey := NewAPIKey("Test", "key")
+
+	apiKey.SetAllowedCIDRs([]string{" 203.0.113.7/24 ", "203.0.113.0/24", "", "2001:DB8::/32", "not-a-cidr"})
+
+	assert.Equal(t, []string{"203.0.113.0/24", "2001:db8::/32", "not-a-cidr"}, apiKey.AllowedCIDRs)
+}
+
+func TestAPIKey_IsIPAllowed(t *testing.T) {
+	apiKey := NewAPIKey("Test", "key")
+	assert.True(t, apiKey.IsIPAllowed("198.51.100.1"), "any address without allowed CIDRs")
+
+	apiKey.SetAllowedCIDRs([]string{"203.0.113.0/24", "2001:db8::/32", "192.0.2.10"})
+
+	assert.True(t, apiKey.IsIPAllowed("203.0.113.200"))
+	assert.True(t, apiKey.IsIPAllowed("::ffff:203.0.113.200"))
+	assert.True(t, apiKey.IsIPAllowed("2001:db8:1::1"))
+	assert.True(t, apiKey.IsIPAllowed("192.0.2.10"))
+	assert.False(t, apiKey.IsIPAllowed("192.0.2.11"))
+	assert.False(t, apiKey.IsIPAllowed("203.0.114.1"))
+	assert.False(t, apiKey.IsIPAllowed("2001:db9::1"))
+	assert.False(t, apiKey.IsIPAllowed(""))
+	assert.False(t, apiKey.IsIPAllowed("not-an-ip"))
+}
+
+func TestAPIKeyPlan_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		plan     APIKeyPlan
+		expected bool
+	}{
+		{"default plan", DefaultAPIKeyPlan(), true},
+		{"daily quota", APIKeyPlan{Name: "free", RequestsPerMinute: 60, Burst: 10, Quota: 1000, QuotaPeriod: QuotaPeriodDay}, true},
+		{"monthly quota", APIKeyPlan{Name: "partner", RequestsPerMinute: 600, Burst: 50, Quota: 100000, QuotaPeriod: QuotaPeriodMonth}, true},
+		{"missing name", APIKeyPlan{RequestsPerMinute: 60, Burst: 10}, false},
+		{"zero burst", APIKeyPlan{Name: "free", RequestsPerMinute: 60}, false},
+		{"quota without period", APIKeyPlan{Name: "free", RequestsPerMinute: 60, Burst: 10, Quota: 1000}, false},
+		{"unknown period", APIKeyPlan{Name: "free", RequestsPerMinute: 60, Burst: 10, Quota: 1000, QuotaPeriod: "week"}, false},
+		{"period without quota", APIKeyPlan{Name: "free", RequestsPerMinute: 60, Burst: 10, QuotaPeriod: QuotaPeriodDay}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.plan.IsValid())
+		})
+	}
+}
+
+func TestAPIKeyPlan_QuotaWindow(t *testing.T) {
+	at := time.Date(2024, time.December, 31, 22, 30, 0, 0, time.FixedZone("UTC-5", -5*60*60))
+
+	start, end := APIKeyPlan{QuotaPeriod: QuotaPeriodDay}.QuotaWindow(at)
+	assert.Equal(t, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = APIKeyPlan{QuotaPeriod: QuotaPeriodMonth}.QuotaWindow(at)
+	assert.Equal(t, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC), end)
+}
+
 func TestAPIKey_SetDescription(t *testing.T) {
 	// Given
 	apiKey := NewAPIKey("Test", "key")
@@ -134,6 +303,24 @@ func TestAPIKey_IsValid(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "valid rotated key within its grace period",
+			setupKey: func() *APIKey {
+				key := NewAPIKey("Test", "key")
+				key.Rotate(NewAPIKey("Test", "successor"), time.Now().Add(time.Hour))
+				return key
+			},
+			expected: true,
+		},
+		{
+			name: "invalid rotated key past its rotation deadline",
+			setupKey: func() *APIKey {
+				key := NewAPIKey("Test", "key")
+				key.Rotate(NewAPIKey("Test", "successor"), time.Now().Add(-time.Minute))
+				return key
+			},
+			expected: false,
+		},
 		{
 			name: "invalid inactive and expired key",
 			setupKey: func() *APIKey {
@@ -160,6 +347,45 @@ func TestAPIKey_IsValid(t *testing.T) {
 	}
 }
 
+func TestAPIKey_Rotate(t *testing.T) {
+	// Given
+	apiKey := NewAPIKey("Partner", "old-key")
+	apiKey.SetDescription("Partner key")
+	apiKey.SetScopes([]string{ScopeBanksWrite})
+	apiKey.Plan = APIKeyPlan{Name: "partner", RequestsPerMinute: 6000, Burst: 500}
+	apiKey.SetAllowedCIDRs([]string{"203.0.113.0/24"})
+	apiKey.SetExpiration(time.Now().Add(30 * 24 * time.Hour))
+	successor := NewAPIKey("", "new-key")
+	deadline := time.Now().Add(24 * time.Hour)
+
+	// When
+	apiKey.Rotate(successor, deadline)
+
+	// Then
+	assert.True(t, apiKey.IsRotated())
+	assert.Equal(t, successor.ID, *apiKey.ReplacedByID)
+	assert.Equal(t, deadline, *apiKey.RotationDeadline)
+	assert.False(t, apiKey.IsRotationExpired())
+	assert.False(t, successor.IsRotated())
+	assert.Equal(t, apiKey.ID, *successor.RotatedFromID)
+	assert.Equal(t, apiKey.IdentityID, successor.IdentityID)
+	assert.NotEqual(t, apiKey.ID, successor.ID)
+	assert.Equal(t, "new-key", successor.Key)
+	assert.Equal(t, "Partner", successor.Name)
+	assert.Equal(t, apiKey.Description, successor.Description)
+	assert.Equal(t, apiKey.Scopes, successor.Scopes)
+	assert.Equal(t, apiKey.Plan, successor.Plan)
+	assert.Equal(t, apiKey.AllowedCIDRs, successor.AllowedCIDRs)
+	assert.Equal(t, apiKey.ExpiresAt, successor.ExpiresAt)
+	assert.Nil(t, successor.RotationDeadline)
+
+	// The identity follows further rotations
+	next := NewAPIKey("", "next-key")
+	successor.Rotate(next, deadline)
+	assert.Equal(t, apiKey.IdentityID, next.IdentityID)
+	assert.Equal(t, successor.ID, *next.RotatedFromID)
+}
+
 func TestAPIKey_UpdateLastUsed(t *testing.T) {
 	// Given
 	apiKey := NewAPIKey("Test", "key")
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Granularities of API key usage
+const (
+	UsageGranularityHour = "hour"
+	UsageGranularityDay  = "day"
+)
+
+// APIKeyUsage is the number of requests made with an API key to a route with responses of a status class,
+// e.g. 2xx, in the hour or day starting at PeriodStart (UTC)
+type APIKeyUsage struct {
+	APIKeyID    uuid.UUID `json:"api_key_id" db:"api_key_id"`
+	Granularity string    `json:"granularity" db:"granularity"`
+	PeriodStart time.Time `json:"period_start" db:"period_start"`
+	Route       string    `json:"route" db:"route"`
+	StatusClass string    `json:"status_class" db:"status_class"`
+	Requests    int64     `json:"requests" db:"requests"`
+}
+
+// TableName returns the table name for the APIKeyUsage entity
+func (APIKeyUsage) TableName() string {
+	return "tm_api_key_usage"
+}
+
+// IsUsageGranularity checks if a granularity is hour or day
+func IsUsageGranularity(granularity string) bool {
+	return granularity == UsageGranularityHour || granularity == UsageGranularityDay
+}
+
+// UsagePeriodStart returns the UTC start of the hour or day containing the time
+func UsagePeriodStart(at time.Time, granularity string) time.Time {
+	at = at.UTC()
+	if granularity == UsageGranularityDay {
+		return time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return at.Truncate(time.Hour)
+}
+
+// StatusClass returns the class of an HTTP status code, e.g. 2xx for 204
+func StatusClass(status int) string {
+	if status < 100 || status > 599 {
+		return "5xx"
+	}
+	return strconv.Itoa(status/100) + "xx"
+}
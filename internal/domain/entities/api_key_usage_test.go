@@ -0,0 +1,46 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusClass(t *testing.T) {
+	tests := []struct {
+		status   int
+		expected string
+	}{
+		{200, "2xx"},
+		{204, "2xx"},
+		{301, "3xx"},
+		{404, "4xx"},
+		{429, "4xx"},
+		{503, "5xx"},
+		{0, "5xx"},
+		{999, "5xx"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, StatusClass(tt.status), tt.status)
+	}
+}
+
+func TestUsagePeriodStart(t *testing.T) {
+	at := time.Date(2024, 3, 15, 23, 45, 10, 0, time.FixedZone("WIB", 7*60*60))
+
+	assert.Equal(t, time.Date(2024, 3, 15, 16, 0, 0, 0, time.UTC), UsagePeriodStart(at, UsageGranularityHour))
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), UsagePeriodStart(at, UsageGranularityDay))
+}
+
+func TestIsUsageGranularity(t *testing.T) {
+	assert.True(t, IsUsageGranularity(UsageGranularityHour))
+	assert.True(t, IsUsageGranularity(UsageGranularityDay))
+	assert.False(t, IsUsageGranularity("minute"))
+	assert.False(t, IsUsageGranularity(""))
+}
+
+func TestAPIKeyUsage_TableName(t *testing.T) {
+	assert.Equal(t, "tm_api_key_usage", APIKeyUsage{}.TableName())
+}
@@ -4,17 +4,47 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
-// Bank represents a bank entity
+// Bank lifecycle statuses
+const (
+	BankStatusActive = "active"
+	BankStatusMerged = "merged"
+	BankStatusClosed = "closed"
+)
+
+// BankListSchema is the whitelist of the fields bank lists can be filtered and sorted on
+var BankListSchema = valueobjects.ListSchema{
+	"name":            {Type: valueobjects.FieldTypeString, Sortable: true},
+	"alias":           {Type: valueobjects.FieldTypeString, Sortable: true},
+	"company":         {Type: valueobjects.FieldTypeString, Sortable: true},
+	"code":            {Type: valueobjects.FieldTypeString, Sortable: true},
+	"bic":             {Type: valueobjects.FieldTypeString, Sortable: true},
+	"status":          {Type: valueobjects.FieldTypeString, Operators: []valueobjects.FilterOperator{valueobjects.FilterEq, valueobjects.FilterNe, valueobjects.FilterIn}, Sortable: true},
+	"effective_from":  {Type: valueobjects.FieldTypeDate, Sortable: true},
+	"effective_until": {Type: valueobjects.FieldTypeDate, Sortable: true},
+	"successor_id":    {Type: valueobjects.FieldTypeUUID},
+	"created_at":      {Type: valueobjects.FieldTypeDate, Sortable: true},
+	"updated_at":      {Type: valueobjects.FieldTypeDate, Sortable: true},
+}
+
+// Bank represents a bank entity. Merged and closed banks are kept so that old codes keep resolving;
+// SuccessorID points to the bank that took over.
 type Bank struct {
-	ID        uuid.UUID `json:"id" db:"id"`
-	Name      string    `json:"name" db:"name"`
-	Alias     string    `json:"alias" db:"alias"`
-	Company   string    `json:"company" db:"company"`
-	Code      string    `json:"code" db:"code"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	ID             uuid.UUID  `json:"id" db:"id"`
+	Name           string     `json:"name" db:"name"`
+	Alias          string     `json:"alias" db:"alias"`
+	Company        string     `json:"company" db:"company"`
+	Code           string     `json:"code" db:"code"`
+	BIC            *string    `json:"bic,omitempty" db:"bic"`
+	Status         string     `json:"status" db:"status"`
+	EffectiveFrom  *time.Time `json:"effective_from,omitempty" db:"effective_from"`
+	EffectiveUntil *time.Time `json:"effective_until,omitempty" db:"effective_until"`
+	SuccessorID    *uuid.UUID `json:"successor_id,omitempty" db:"successor_id"`
+	Successor      *Bank      `json:"successor,omitempty" db:"-"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 // TableName returns the table name for the Bank entity
@@ -37,6 +67,7 @@ func NewBank(name, alias, company, code string) *Bank {
 		Alias:     alias,
 		Company:   company,
 		Code:      code,
+		Status:    BankStatusActive,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -66,7 +97,75 @@ func (b *Bank) SetCode(code string) {
 	b.UpdatedAt = time.Now()
 }
 
+// SetBIC sets the ISO 9362 BIC of the bank, a nil BIC clears it
+func (b *Bank) SetBIC(bic *valueobjects.BIC) {
+	if bic == nil {
+		b.BIC = nil
+	} else {
+		value := bic.Value()
+		b.BIC = &value
+	}
+	b.UpdatedAt = time.Now()
+}
+
+// GetBIC returns the BIC of the bank as a value object, or nil when not set
+func (b *Bank) GetBIC() (*valueobjects.BIC, error) {
+	if b.BIC == nil {
+		return nil, nil
+	}
+	return valueobjects.NewBIC(*b.BIC)
+}
+
+// MarkMerged marks the bank as merged into its successor as of the given date
+func (b *Bank) MarkMerged(successorID uuid.UUID, at time.Time) {
+	b.Status = BankStatusMerged
+	b.SuccessorID = &successorID
+	b.EffectiveUntil = &at
+	b.UpdatedAt = time.Now()
+}
+
+// MarkClosed marks the bank as closed as of the given date. The successor is optional, e.g. the bank
+// that took over the accounts of a liquidated bank.
+func (b *Bank) MarkClosed(successorID *uuid.UUID, at time.Time) {
+	b.Status = BankStatusClosed
+	b.SuccessorID = successorID
+	b.EffectiveUntil = &at
+	b.UpdatedAt = time.Now()
+}
+
+// Reactivate marks the bank as active again and removes its successor and end date
+func (b *Bank) Reactivate() {
+	b.Status = BankStatusActive
+	b.SuccessorID = nil
+	b.EffectiveUntil = nil
+	b.UpdatedAt = time.Now()
+}
+
+// SetEffectiveFrom sets the date the bank started operating under its current identity
+func (b *Bank) SetEffectiveFrom(from *time.Time) {
+	b.EffectiveFrom = from
+	b.UpdatedAt = time.Now()
+}
+
+// IsActive checks if the bank is still operating. Banks without a status are treated as active.
+func (b *Bank) IsActive() bool {
+	return b.Status == "" || b.Status == BankStatusActive
+}
+
 // IsValid validates the bank entity
 func (b *Bank) IsValid() bool {
-	return b.Name != "" && b.Code != ""
+	if b.Name == "" || b.Code == "" {
+		return false
+	}
+
+	switch b.Status {
+	case "", BankStatusActive:
+		return b.SuccessorID == nil
+	case BankStatusClosed:
+		return b.SuccessorID == nil || *b.SuccessorID != b.ID
+	case BankStatusMerged:
+		return b.SuccessorID != nil && *b.SuccessorID != b.ID
+	default:
+		return false
+	}
 }
@@ -0,0 +1,225 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Supported check-digit algorithms of bank account numbers
+const (
+	CheckDigitNone  = ""
+	CheckDigitLuhn  = "luhn"
+	CheckDigitMod11 = "mod11"
+)
+
+// BankAccountRule represents the account number format rules of a bank
+type BankAccountRule struct {
+	ID                  uuid.UUID `json:"id" db:"id"`
+	BankID              uuid.UUID `json:"bank_id" db:"bank_id"`
+	Lengths             []int     `json:"lengths" db:"lengths"`
+	DigitsOnly          bool      `json:"digits_only" db:"digits_only"`
+	CheckDigitAlgorithm string    `json:"check_digit_algorithm,omitempty" db:"check_digit_algorithm"`
+	PrefixPatterns      []string  `json:"prefix_patterns,omitempty" db:"prefix_patterns"`
+	CreatedAt           time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// TableName returns the table name for the BankAccountRule entity
+func (r *BankAccountRule) TableName() string {
+	return "tm_bank_account_rules"
+}
+
+// GenerateID generates a new UUID for the bank account rule if not set
+func (r *BankAccountRule) GenerateID() {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+}
+
+// NewBankAccountRule creates a new BankAccountRule instance for a bank
+func NewBankAccountRule(bankID uuid.UUID, lengths []int, digitsOnly bool) *BankAccountRule {
+	return &BankAccountRule{
+		ID:         uuid.New(),
+		BankID:     bankID,
+		Lengths:    lengths,
+		DigitsOnly: digitsOnly,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+}
+
+// SetLengths sets the allowed account number lengths
+func (r *BankAccountRule) SetLengths(lengths []int) {
+	r.Lengths = lengths
+	r.UpdatedAt = time.Now()
+}
+
+// SetDigitsOnly sets whether account numbers may only contain digits
+func (r *BankAccountRule) SetDigitsOnly(digitsOnly bool) {
+	r.DigitsOnly = digitsOnly
+	r.UpdatedAt = time.Now()
+}
+
+// SetCheckDigitAlgorithm sets the check-digit algorithm, empty when account numbers carry no check digit
+func (r *BankAccountRule) SetCheckDigitAlgorithm(algorithm string) {
+	r.CheckDigitAlgorithm = strings.ToLower(algorithm)
+	r.UpdatedAt = time.Now()
+}
+
+// SetPrefixPatterns sets the prefix patterns. Each pattern is a regular expression matched against
+// the start of the account number; an account number must match at least one of them.
+func (r *BankAccountRule) SetPrefixPatterns(patterns []string) {
+	r.PrefixPatterns = patterns
+	r.UpdatedAt = time.Now()
+}
+
+// Validate checks an account number against the rules and returns the reasons it fails, empty when it passes
+func (r *BankAccountRule) Validate(accountNumber string) []string {
+	var reasons []string
+
+	if accountNumber == "" {
+		return []string{"account number is required"}
+	}
+
+	if len(r.Lengths) > 0 && !containsInt(r.Lengths, len(accountNumber)) {
+		reasons = append(reasons, fmt.Sprintf("account number must be %s digits long: %d given", joinInts(r.Lengths), len(accountNumber)))
+	}
+
+	digits := isDigits(accountNumber)
+	if r.DigitsOnly && !digits {
+		reasons = append(reasons, "account number must only contain digits")
+	}
+
+	if len(r.PrefixPatterns) > 0 && !r.matchesPrefix(accountNumber) {
+		reasons = append(reasons, fmt.Sprintf("account number must start with %s", strings.Join(r.PrefixPatterns, ", ")))
+	}
+
+	if r.CheckDigitAlgorithm != CheckDigitNone {
+		if !digits {
+			reasons = append(reasons, fmt.Sprintf("%s check digit requires a numeric account number", r.CheckDigitAlgorithm))
+		} else if !ValidateCheckDigit(r.CheckDigitAlgorithm, accountNumber) {
+			reasons = append(reasons, fmt.Sprintf("account number fails the %s check digit", r.CheckDigitAlgorithm))
+		}
+	}
+
+	return reasons
+}
+
+// IsValid checks if the bank account rule has valid data
+func (r *BankAccountRule) IsValid() bool {
+	if r.BankID == uuid.Nil {
+		return false
+	}
+
+	for _, length := range r.Lengths {
+		if length <= 0 || length > 34 {
+			return false
+		}
+	}
+
+	switch r.CheckDigitAlgorithm {
+	case CheckDigitNone, CheckDigitLuhn, CheckDigitMod11:
+	default:
+		return false
+	}
+
+	for _, pattern := range r.PrefixPatterns {
+		if pattern == "" {
+			return false
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesPrefix checks if the account number starts with one of the prefix patterns
+func (r *BankAccountRule) matchesPrefix(accountNumber string) bool {
+	for _, pattern := range r.PrefixPatterns {
+		re, err := regexp.Compile("^(?:" + pattern + ")")
+		if err != nil {
+			continue
+		}
+		if re.MatchString(accountNumber) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateCheckDigit checks the trailing check digit of a numeric account number with the given algorithm
+func ValidateCheckDigit(algorithm, number string) bool {
+	if len(number) < 2 || !isDigits(number) {
+		return false
+	}
+
+	switch strings.ToLower(algorithm) {
+	case CheckDigitLuhn:
+		sum := 0
+		for i := 0; i < len(number); i++ {
+			digit := int(number[len(number)-1-i] - '0')
+			if i%2 == 1 {
+				digit *= 2
+				if digit > 9 {
+					digit -= 9
+				}
+			}
+			sum += digit
+		}
+		return sum%10 == 0
+	case CheckDigitMod11:
+		// Weights 2..7 are applied from the rightmost payload digit; the check digit is 11 - sum mod 11,
+		// where 11 maps to 0 and 10 cannot be represented
+		sum := 0
+		payload := number[:len(number)-1]
+		for i := 0; i < len(payload); i++ {
+			digit := int(payload[len(payload)-1-i] - '0')
+			sum += digit * (i%6 + 2)
+		}
+		check := 11 - sum%11
+		if check == 11 {
+			check = 0
+		}
+		return check < 10 && check == int(number[len(number)-1]-'0')
+	default:
+		return false
+	}
+}
+
+// isDigits checks if a string only contains ASCII digits
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
+
+// containsInt checks if a slice contains a value
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// joinInts formats lengths as "10", "10 or 15" or "10, 12 or 15"
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%d", v)
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " or " + parts[len(parts)-1]
+}
@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBankAccountRule(t *testing.T) {
+	// Given
+	bankID := uuid.New()
+
+	// When
+	rule := NewBankAccountRule(bankID, []int{10}, true)
+
+	// Then
+	assert.NotEqual(t, uuid.Nil, rule.ID)
+	assert.Equal(t, bankID, rule.BankID)
+	assert.Equal(t, []int{10}, rule.Lengths)
+	assert.True(t, rule.DigitsOnly)
+	assert.Equal(t, CheckDigitNone, rule.CheckDigitAlgorithm)
+	assert.Equal(t, "tm_bank_account_rules", rule.TableName())
+	assert.True(t, rule.IsValid())
+}
+
+func TestBankAccountRule_Validate(t *testing.T) {
+	rule := NewBankAccountRule(uuid.New(), []int{10, 15}, true)
+	rule.SetPrefixPatterns([]string{"0", "1[0-9]"})
+
+	tests := []struct {
+		name          string
+		accountNumber string
+		reasons       int
+	}{
+		{"valid 10 digits", "0123456789", 0},
+		{"valid 15 digits", "123456789012345", 0},
+		{"wrong length", "012345678", 1},
+		{"not digits", "01234567AB", 1},
+		{"wrong prefix", "2123456789", 1},
+		{"empty", "", 1},
+		{"wrong length and prefix", "99", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Len(t, rule.Validate(tt.accountNumber), tt.reasons)
+		})
+	}
+}
+
+func TestBankAccountRule_ValidateWithCheckDigit(t *testing.T) {
+	rule := NewBankAccountRule(uuid.New(), nil, true)
+	rule.SetCheckDigitAlgorithm("LUHN")
+
+	assert.Equal(t, CheckDigitLuhn, rule.CheckDigitAlgorithm)
+	assert.Empty(t, rule.Validate("79927398713"))
+	assert.Equal(t, []string{"account number fails the luhn check digit"}, rule.Validate("79927398710"))
+}
+
+func TestValidateCheckDigit(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		number    string
+		expected  bool
+	}{
+		{CheckDigitLuhn, "79927398713", true},
+		{CheckDigitLuhn, "4111111111111111", true},
+		{CheckDigitLuhn, "4111111111111112", false},
+		{CheckDigitMod11, "123455", true},
+		{CheckDigitMod11, "1234560", true},
+		{CheckDigitMod11, "123454", false},
+		{CheckDigitLuhn, "12AB", false},
+		{"unknown", "79927398713", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algorithm+"_"+tt.number, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ValidateCheckDigit(tt.algorithm, tt.number))
+		})
+	}
+}
+
+func TestBankAccountRule_IsValid(t *testing.T) {
+	valid := NewBankAccountRule(uuid.New(), []int{10}, true)
+	assert.True(t, valid.IsValid())
+
+	noBank := NewBankAccountRule(uuid.Nil, []int{10}, true)
+	assert.False(t, noBank.IsValid())
+
+	badLength := NewBankAccountRule(uuid.New(), []int{0}, true)
+	assert.False(t, badLength.IsValid())
+
+	badAlgorithm := NewBankAccountRule(uuid.New(), []int{10}, true)
+	badAlgorithm.SetCheckDigitAlgorithm("crc32")
+	assert.False(t, badAlgorithm.IsValid())
+
+	badPattern := NewBankAccountRule(uuid.New(), []int{10}, true)
+	badPattern.SetPrefixPatterns([]string{"[0-9"})
+	assert.False(t, badPattern.IsValid())
+}
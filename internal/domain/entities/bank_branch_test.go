@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+func TestNewBankBranch(t *testing.T) {
+	// Given
+	bankID := uuid.New()
+
+	// When
+	branch := NewBankBranch(bankID, "KCU Bandung", "0081", "Jl. Asia Afrika No. 2")
+
+	// Then
+	assert.NotEqual(t, uuid.Nil, branch.ID)
+	assert.Equal(t, bankID, branch.BankID)
+	assert.Equal(t, "KCU Bandung", branch.Name)
+	assert.Equal(t, "0081", branch.Code)
+	assert.Equal(t, "Jl. Asia Afrika No. 2", branch.Address)
+	assert.Nil(t, branch.GeodirectoryID)
+	assert.Nil(t, branch.BIC)
+	assert.False(t, branch.CreatedAt.IsZero())
+	assert.Equal(t, "tm_bank_branches", branch.TableName())
+}
+
+func TestBankBranch_Setters(t *testing.T) {
+	// Given
+	branch := NewBankBranch(uuid.New(), "Old", "001", "")
+	originalTime := branch.UpdatedAt
+	geodirectoryID := uuid.New()
+	bic, err := valueobjects.NewBIC("CENAIDJA081")
+	require.NoError(t, err)
+
+	time.Sleep(1 * time.Millisecond)
+
+	// When
+	branch.SetName("New")
+	branch.SetCode("002")
+	branch.SetAddress("Jl. Braga")
+	branch.SetGeodirectory(&geodirectoryID)
+	branch.SetBIC(bic)
+
+	// Then
+	assert.Equal(t, "New", branch.Name)
+	assert.Equal(t, "002", branch.Code)
+	assert.Equal(t, "Jl. Braga", branch.Address)
+	assert.Equal(t, &geodirectoryID, branch.GeodirectoryID)
+	require.NotNil(t, branch.BIC)
+	assert.Equal(t, "CENAIDJA081", *branch.BIC)
+	assert.True(t, branch.UpdatedAt.After(originalTime))
+
+	// When cleared
+	branch.SetGeodirectory(nil)
+	branch.SetBIC(nil)
+
+	// Then
+	assert.Nil(t, branch.GeodirectoryID)
+	assert.Nil(t, branch.BIC)
+}
+
+func TestBankBranch_IsValid(t *testing.T) {
+	assert.True(t, NewBankBranch(uuid.New(), "Branch", "001", "").IsValid())
+	assert.False(t, NewBankBranch(uuid.Nil, "Branch", "001", "").IsValid())
+	assert.False(t, NewBankBranch(uuid.New(), "", "001", "").IsValid())
+	assert.False(t, NewBankBranch(uuid.New(), "Branch", "", "").IsValid())
+}
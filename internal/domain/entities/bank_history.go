@@ -0,0 +1,83 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BankHistoryRecord is a snapshot of a former identity of a bank (name, code, BIC, status) and the
+// period it was valid, so that records referring to an old name or code still resolve
+type BankHistoryRecord struct {
+	ID         uuid.UUID  `json:"id" db:"id"`
+	BankID     uuid.UUID  `json:"bank_id" db:"bank_id"`
+	Name       string     `json:"name" db:"name"`
+	Alias      string     `json:"alias" db:"alias"`
+	Company    string     `json:"company" db:"company"`
+	Code       string     `json:"code" db:"code"`
+	BIC        *string    `json:"bic,omitempty" db:"bic"`
+	Status     string     `json:"status" db:"status"`
+	ValidFrom  *time.Time `json:"valid_from,omitempty" db:"valid_from"`
+	ValidUntil time.Time  `json:"valid_until" db:"valid_until"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+// TableName returns the table name for the BankHistoryRecord entity
+func (h *BankHistoryRecord) TableName() string {
+	return "tm_bank_history"
+}
+
+// GenerateID generates a new UUID for the history record if not set
+func (h *BankHistoryRecord) GenerateID() {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+}
+
+// NewBankHistoryRecord creates a snapshot of the bank valid from validFrom (nil when unknown) until validUntil
+func NewBankHistoryRecord(bank *Bank, validFrom *time.Time, validUntil time.Time) *BankHistoryRecord {
+	status := bank.Status
+	if status == "" {
+		status = BankStatusActive
+	}
+
+	return &BankHistoryRecord{
+		ID:         uuid.New(),
+		BankID:     bank.ID,
+		Name:       bank.Name,
+		Alias:      bank.Alias,
+		Company:    bank.Company,
+		Code:       bank.Code,
+		BIC:        bank.BIC,
+		Status:     status,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// IsValidAt checks if the snapshot describes the bank at the given time
+func (h *BankHistoryRecord) IsValidAt(at time.Time) bool {
+	if h.ValidFrom != nil && at.Before(*h.ValidFrom) {
+		return false
+	}
+	return at.Before(h.ValidUntil)
+}
+
+// IdentityChanged checks if the name, alias, company, code, BIC or status of a bank differs between two states
+func IdentityChanged(previous, current *Bank) bool {
+	return previous.Name != current.Name ||
+		previous.Alias != current.Alias ||
+		previous.Company != current.Company ||
+		previous.Code != current.Code ||
+		previous.Status != current.Status ||
+		!equalStringPtr(previous.BIC, current.BIC)
+}
+
+// equalStringPtr compares two optional strings
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
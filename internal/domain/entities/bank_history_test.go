@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBankHistoryRecord(t *testing.T) {
+	// Given
+	bic := "SYBMIDJ1"
+	bank := NewBank("Bank Syariah Mandiri", "BSM", "PT Bank Syariah Mandiri", "451")
+	bank.BIC = &bic
+	validFrom := time.Date(1999, 11, 1, 0, 0, 0, 0, time.UTC)
+	validUntil := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	// When
+	record := NewBankHistoryRecord(bank, &validFrom, validUntil)
+
+	// Then
+	assert.Equal(t, bank.ID, record.BankID)
+	assert.Equal(t, "Bank Syariah Mandiri", record.Name)
+	assert.Equal(t, "451", record.Code)
+	assert.Equal(t, &bic, record.BIC)
+	assert.Equal(t, BankStatusActive, record.Status)
+	assert.Equal(t, "tm_bank_history", record.TableName())
+
+	assert.True(t, record.IsValidAt(time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, record.IsValidAt(time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, record.IsValidAt(validUntil))
+}
+
+func TestIdentityChanged(t *testing.T) {
+	bic := "BSMDIDJA"
+	otherBIC := "BSMDIDJAXXX"
+	previous := NewBank("Bank Syariah Mandiri", "BSM", "PT Bank Syariah Mandiri", "451")
+	previous.BIC = &bic
+
+	tests := []struct {
+		name     string
+		change   func(b *Bank)
+		expected bool
+	}{
+		{"unchanged", func(b *Bank) {}, false},
+		{"renamed", func(b *Bank) { b.Name = "Bank Syariah Indonesia" }, true},
+		{"code changed", func(b *Bank) { b.Code = "452" }, true},
+		{"BIC changed", func(b *Bank) { b.BIC = &otherBIC }, true},
+		{"BIC removed", func(b *Bank) { b.BIC = nil }, true},
+		{"status changed", func(b *Bank) { b.Status = BankStatusClosed }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := *previous
+			tt.change(&current)
+
+			assert.Equal(t, tt.expected, IdentityChanged(previous, &current))
+		})
+	}
+}
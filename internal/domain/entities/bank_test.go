@@ -6,6 +6,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 func TestNewBank(t *testing.T) {
@@ -119,6 +121,33 @@ func TestBank_SetCode(t *testing.T) {
 	assert.True(t, bank.UpdatedAt.After(originalTime))
 }
 
+func TestBank_SetBIC(t *testing.T) {
+	// Given
+	bank := NewBank("Name", "ALIAS", "Company", "001")
+	bic, err := valueobjects.NewBIC("cenaidja")
+	require.NoError(t, err)
+
+	// When
+	bank.SetBIC(bic)
+
+	// Then
+	require.NotNil(t, bank.BIC)
+	assert.Equal(t, "CENAIDJA", *bank.BIC)
+
+	stored, err := bank.GetBIC()
+	require.NoError(t, err)
+	assert.Equal(t, "CENAIDJA", stored.Value())
+
+	// When cleared
+	bank.SetBIC(nil)
+
+	// Then
+	assert.Nil(t, bank.BIC)
+	stored, err = bank.GetBIC()
+	assert.NoError(t, err)
+	assert.Nil(t, stored)
+}
+
 func TestBank_IsValid(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -179,3 +208,65 @@ func TestBank_TableName(t *testing.T) {
 	// Then
 	assert.Equal(t, "tm_banks", tableName)
 }
+
+func TestBank_Lifecycle(t *testing.T) {
+	t.Run("merged into successor", func(t *testing.T) {
+		// Given
+		bank := NewBank("Bank Syariah Mandiri", "BSM", "PT Bank Syariah Mandiri", "451")
+		successorID := uuid.New()
+		mergedAt := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+
+		// When
+		bank.MarkMerged(successorID, mergedAt)
+
+		// Then
+		assert.Equal(t, BankStatusMerged, bank.Status)
+		assert.Equal(t, &successorID, bank.SuccessorID)
+		assert.Equal(t, &mergedAt, bank.EffectiveUntil)
+		assert.False(t, bank.IsActive())
+		assert.True(t, bank.IsValid())
+	})
+
+	t.Run("closed without successor", func(t *testing.T) {
+		// Given
+		bank := NewBank("Bank Test", "BT", "PT Bank Test", "999")
+
+		// When
+		bank.MarkClosed(nil, time.Now())
+
+		// Then
+		assert.Equal(t, BankStatusClosed, bank.Status)
+		assert.Nil(t, bank.SuccessorID)
+		assert.False(t, bank.IsActive())
+		assert.True(t, bank.IsValid())
+	})
+
+	t.Run("reactivated", func(t *testing.T) {
+		// Given
+		bank := NewBank("Bank Test", "BT", "PT Bank Test", "999")
+		bank.MarkMerged(uuid.New(), time.Now())
+
+		// When
+		bank.Reactivate()
+
+		// Then
+		assert.Equal(t, BankStatusActive, bank.Status)
+		assert.Nil(t, bank.SuccessorID)
+		assert.Nil(t, bank.EffectiveUntil)
+		assert.True(t, bank.IsActive())
+	})
+
+	t.Run("invalid lifecycle", func(t *testing.T) {
+		merged := NewBank("Bank Test", "BT", "PT Bank Test", "999")
+		merged.Status = BankStatusMerged
+		assert.False(t, merged.IsValid(), "merged bank without successor")
+
+		self := NewBank("Bank Test", "BT", "PT Bank Test", "999")
+		self.MarkMerged(self.ID, time.Now())
+		assert.False(t, self.IsValid(), "bank succeeding itself")
+
+		unknown := NewBank("Bank Test", "BT", "PT Bank Test", "999")
+		unknown.Status = "renamed"
+		assert.False(t, unknown.IsValid(), "unknown status")
+	})
+}
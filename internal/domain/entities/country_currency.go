@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CountryCurrency links a COUNTRY geodirectory to a currency used in that country. A country can use several
+// currencies (e.g. Panama uses the Balboa and the US Dollar) and a currency can be used in several countries.
+// The primary currency is the one prices are normally quoted in; the effective dates record currency changes
+// such as Croatia adopting the Euro.
+type CountryCurrency struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	CountryID      uuid.UUID  `json:"country_id" db:"country_id"`
+	CurrencyID     uuid.UUID  `json:"currency_id" db:"currency_id"`
+	IsLegalTender  bool       `json:"is_legal_tender" db:"is_legal_tender"`
+	IsPrimary      bool       `json:"is_primary" db:"is_primary"`
+	EffectiveFrom  *time.Time `json:"effective_from,omitempty" db:"effective_from"`
+	EffectiveUntil *time.Time `json:"effective_until,omitempty" db:"effective_until"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
+
+	// Relations (not stored in DB, populated when needed)
+	Country  *Geodirectory `json:"country,omitempty"`
+	Currency *Currency     `json:"currency,omitempty"`
+}
+
+// TableName returns the table name for the CountryCurrency entity
+func (c *CountryCurrency) TableName() string {
+	return "tm_country_currencies"
+}
+
+// GenerateID generates a new UUID for the country currency if not set
+func (c *CountryCurrency) GenerateID() {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+}
+
+// NewCountryCurrency creates a new CountryCurrency instance
+func NewCountryCurrency(countryID, currencyID uuid.UUID, isLegalTender, isPrimary bool) *CountryCurrency {
+	return &CountryCurrency{
+		ID:            uuid.New(),
+		CountryID:     countryID,
+		CurrencyID:    currencyID,
+		IsLegalTender: isLegalTender,
+		IsPrimary:     isPrimary,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+}
+
+// SetLegalTender sets whether the currency is legal tender in the country
+func (c *CountryCurrency) SetLegalTender(isLegalTender bool) {
+	c.IsLegalTender = isLegalTender
+	c.UpdatedAt = time.Now()
+}
+
+// SetPrimary sets whether the currency is the primary currency of the country
+func (c *CountryCurrency) SetPrimary(isPrimary bool) {
+	c.IsPrimary = isPrimary
+	c.UpdatedAt = time.Now()
+}
+
+// SetEffectivePeriod sets the dates the currency is used in the country from and until; nil leaves the period open
+func (c *CountryCurrency) SetEffectivePeriod(from, until *time.Time) {
+	c.EffectiveFrom = from
+	c.EffectiveUntil = until
+	c.UpdatedAt = time.Now()
+}
+
+// IsEffective checks if the currency is used in the country at the given time
+func (c *CountryCurrency) IsEffective(at time.Time) bool {
+	if c.EffectiveFrom != nil && at.Before(*c.EffectiveFrom) {
+		return false
+	}
+	if c.EffectiveUntil != nil && !at.Before(*c.EffectiveUntil) {
+		return false
+	}
+	return true
+}
+
+// IsValid checks if the country currency has valid data
+func (c *CountryCurrency) IsValid() bool {
+	if c.CountryID == uuid.Nil || c.CurrencyID == uuid.Nil {
+		return false
+	}
+
+	if c.EffectiveFrom != nil && c.EffectiveUntil != nil && !c.EffectiveUntil.After(*c.EffectiveFrom) {
+		return false
+	}
+
+	return true
+}
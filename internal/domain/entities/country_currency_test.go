@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCountryCurrency(t *testing.T) {
+	// Given
+	countryID := uuid.New()
+	currencyID := uuid.New()
+
+	// When
+	countryCurrency := NewCountryCurrency(countryID, currencyID, true, true)
+
+	// Then
+	assert.NotEqual(t, uuid.Nil, countryCurrency.ID)
+	assert.Equal(t, countryID, countryCurrency.CountryID)
+	assert.Equal(t, currencyID, countryCurrency.CurrencyID)
+	assert.True(t, countryCurrency.IsLegalTender)
+	assert.True(t, countryCurrency.IsPrimary)
+	assert.Equal(t, "tm_country_currencies", countryCurrency.TableName())
+	assert.True(t, countryCurrency.IsValid())
+}
+
+func TestCountryCurrency_IsEffective(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	past := now.AddDate(-1, 0, 0)
+	future := now.AddDate(1, 0, 0)
+
+	tests := []struct {
+		name     string
+		from     *time.Time
+		until    *time.Time
+		expected bool
+	}{
+		{"open ended", nil, nil, true},
+		{"within period", &past, &future, true},
+		{"not yet effective", &future, nil, false},
+		{"ended", nil, &past, false},
+		{"ends today", &past, &now, false},
+		{"starts today", &now, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			countryCurrency := NewCountryCurrency(uuid.New(), uuid.New(), true, true)
+			countryCurrency.SetEffectivePeriod(tt.from, tt.until)
+
+			assert.Equal(t, tt.expected, countryCurrency.IsEffective(now))
+		})
+	}
+}
+
+func TestCountryCurrency_IsValid(t *testing.T) {
+	assert.False(t, NewCountryCurrency(uuid.Nil, uuid.New(), true, true).IsValid())
+	assert.False(t, NewCountryCurrency(uuid.New(), uuid.Nil, true, true).IsValid())
+
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	countryCurrency := NewCountryCurrency(uuid.New(), uuid.New(), false, false)
+	countryCurrency.SetEffectivePeriod(&from, &from)
+	assert.False(t, countryCurrency.IsValid(), "the period must end after it starts")
+
+	until := from.AddDate(1, 0, 0)
+	countryCurrency.SetEffectivePeriod(&from, &until)
+	assert.True(t, countryCurrency.IsValid())
+}
@@ -1,21 +1,51 @@
 package entities
 
 import (
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
-// Currency represents a currency entity
+// currencyNumericCodePattern matches an ISO 4217 numeric currency code
+var currencyNumericCodePattern = regexp.MustCompile(`^[0-9]{3}$`)
+
+// CurrencyListSchema is the whitelist of the fields currency lists can be filtered and sorted on
+var CurrencyListSchema = valueobjects.ListSchema{
+	"name":               {Type: valueobjects.FieldTypeString, Sortable: true},
+	"code":               {Type: valueobjects.FieldTypeString, Sortable: true},
+	"numeric_code":       {Type: valueobjects.FieldTypeString, Sortable: true},
+	"symbol":             {Type: valueobjects.FieldTypeString},
+	"decimal_places":     {Type: valueobjects.FieldTypeInt, Sortable: true},
+	"is_active":          {Type: valueobjects.FieldTypeBool, Sortable: true},
+	"withdrawn_at":       {Type: valueobjects.FieldTypeDate, Sortable: true},
+	"replaced_by":        {Type: valueobjects.FieldTypeString},
+	"cash_rounding_mode": {Type: valueobjects.FieldTypeString, Operators: []valueobjects.FilterOperator{valueobjects.FilterEq, valueobjects.FilterNe, valueobjects.FilterIn}},
+	"created_at":         {Type: valueobjects.FieldTypeDate, Sortable: true},
+	"updated_at":         {Type: valueobjects.FieldTypeDate, Sortable: true},
+}
+
+// Currency represents a currency entity. Withdrawn currencies (ISO 4217 list three) are kept inactive
+// with their withdrawal date and the code of the currency that replaced them. Cash payments may be rounded
+// to a coarser increment than the decimal places, e.g. 0.05 for the Swiss franc.
 type Currency struct {
-	ID            uuid.UUID `json:"id" db:"id"`
-	Name          string    `json:"name" db:"name"`
-	Code          string    `json:"code" db:"code"`
-	Symbol        *string   `json:"symbol,omitempty" db:"symbol"`
-	DecimalPlaces int       `json:"decimal_places" db:"decimal_places"`
-	IsActive      bool      `json:"is_active" db:"is_active"`
-	CreatedAt     time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+	ID            uuid.UUID  `json:"id" db:"id"`
+	Name          string     `json:"name" db:"name"`
+	Code          string     `json:"code" db:"code"`
+	NumericCode   *string    `json:"numeric_code,omitempty" db:"numeric_code"`
+	Symbol        *string    `json:"symbol,omitempty" db:"symbol"`
+	DecimalPlaces int        `json:"decimal_places" db:"decimal_places"`
+	IsActive      bool       `json:"is_active" db:"is_active"`
+	WithdrawnAt   *time.Time `json:"withdrawn_at,omitempty" db:"withdrawn_at"`
+	ReplacedBy    *string    `json:"replaced_by,omitempty" db:"replaced_by"`
+
+	CashRoundingIncrement *valueobjects.Decimal     `json:"cash_rounding_increment,omitempty" db:"cash_rounding_increment"`
+	CashRoundingMode      valueobjects.RoundingMode `json:"cash_rounding_mode" db:"cash_rounding_mode"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // TableName returns the table name for the Currency entity
@@ -33,13 +63,14 @@ func (c *Currency) GenerateID() {
 // NewCurrency creates a new Currency instance
 func NewCurrency(name, code string, decimalPlaces int) *Currency {
 	return &Currency{
-		ID:            uuid.New(),
-		Name:          name,
-		Code:          code,
-		DecimalPlaces: decimalPlaces,
-		IsActive:      true,
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
+		ID:               uuid.New(),
+		Name:             name,
+		Code:             code,
+		DecimalPlaces:    decimalPlaces,
+		IsActive:         true,
+		CashRoundingMode: valueobjects.RoundHalfUp,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
 	}
 }
 
@@ -61,6 +92,12 @@ func (c *Currency) SetSymbol(symbol string) {
 	c.UpdatedAt = time.Now()
 }
 
+// SetNumericCode sets the ISO 4217 numeric code of the currency, e.g. "360"
+func (c *Currency) SetNumericCode(numericCode string) {
+	c.NumericCode = &numericCode
+	c.UpdatedAt = time.Now()
+}
+
 // SetDecimalPlaces sets the decimal places of the currency
 func (c *Currency) SetDecimalPlaces(places int) {
 	c.DecimalPlaces = places
@@ -79,9 +116,81 @@ func (c *Currency) Deactivate() {
 	c.UpdatedAt = time.Now()
 }
 
+// Withdraw marks the currency as withdrawn at the given date, optionally replaced by another currency code.
+// Withdrawn currencies are deactivated.
+func (c *Currency) Withdraw(at time.Time, replacedBy *string) {
+	c.WithdrawnAt = &at
+	c.ReplacedBy = replacedBy
+	c.IsActive = false
+	c.UpdatedAt = time.Now()
+}
+
+// SetCashRounding sets the increment cash payments are rounded to and the rounding mode; a nil increment
+// rounds cash payments like electronic payments
+func (c *Currency) SetCashRounding(increment *valueobjects.Decimal, mode valueobjects.RoundingMode) {
+	c.CashRoundingIncrement = increment
+	c.CashRoundingMode = mode
+	c.UpdatedAt = time.Now()
+}
+
+// MinorUnit returns the smallest amount of the currency in electronic payments, e.g. 0.01 for 2 decimal places
+func (c *Currency) MinorUnit() valueobjects.Decimal {
+	if c.DecimalPlaces <= 0 {
+		return valueobjects.NewDecimalFromInt(1)
+	}
+	unit, _ := valueobjects.NewDecimal("0." + strings.Repeat("0", c.DecimalPlaces-1) + "1")
+	return unit
+}
+
+// RoundElectronic rounds an amount to the decimal places of the currency, halves away from zero
+func (c *Currency) RoundElectronic(amount valueobjects.Decimal) valueobjects.Decimal {
+	return amount.RoundWithMode(c.DecimalPlaces, valueobjects.RoundHalfUp)
+}
+
+// RoundCash rounds an amount paid in cash to the cash rounding increment with the cash rounding mode.
+// Currencies without a cash rounding increment are rounded to their decimal places.
+func (c *Currency) RoundCash(amount valueobjects.Decimal) valueobjects.Decimal {
+	mode := c.CashRoundingMode
+	if !mode.IsValid() {
+		mode = valueobjects.RoundHalfUp
+	}
+
+	if c.CashRoundingIncrement == nil || c.CashRoundingIncrement.Sign() <= 0 {
+		return amount.RoundWithMode(c.DecimalPlaces, mode)
+	}
+
+	rounded, _ := amount.RoundToIncrement(*c.CashRoundingIncrement, mode)
+	return rounded
+}
+
+// IsWithdrawn reports whether the currency has been withdrawn
+func (c *Currency) IsWithdrawn() bool {
+	return c.WithdrawnAt != nil
+}
+
 // IsValid validates the currency entity
 func (c *Currency) IsValid() bool {
-	return c.Name != "" && c.Code != "" && len(c.Code) <= 3 && c.DecimalPlaces >= 0
+	if c.Name == "" || c.Code == "" || len(c.Code) > 3 || c.DecimalPlaces < 0 {
+		return false
+	}
+
+	if c.NumericCode != nil && !currencyNumericCodePattern.MatchString(*c.NumericCode) {
+		return false
+	}
+
+	if c.ReplacedBy != nil && (c.WithdrawnAt == nil || *c.ReplacedBy == c.Code) {
+		return false
+	}
+
+	if c.CashRoundingIncrement != nil && c.CashRoundingIncrement.Sign() <= 0 {
+		return false
+	}
+
+	if c.CashRoundingMode != "" && !c.CashRoundingMode.IsValid() {
+		return false
+	}
+
+	return true
 }
 
 // GetDisplayName returns the display name with symbol if available
@@ -91,3 +200,56 @@ func (c *Currency) GetDisplayName() string {
 	}
 	return c.Name
 }
+
+// newWithdrawnCurrency creates a withdrawn currency of ISO 4217 list three, withdrawn in the given month
+func newWithdrawnCurrency(code, numericCode, name string, decimalPlaces, year int, month time.Month, replacedBy string) *Currency {
+	currency := NewCurrency(name, code, decimalPlaces)
+	currency.SetNumericCode(numericCode)
+	currency.Withdraw(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), &replacedBy)
+	return currency
+}
+
+// DefaultWithdrawnCurrencies returns the withdrawn ISO 4217 currencies seeded when no historical currencies
+// file is present: the euro legacy currencies and the currencies replaced by a redenomination since 2000
+func DefaultWithdrawnCurrencies() []*Currency {
+	return []*Currency{
+		newWithdrawnCurrency("ATS", "040", "Schilling", 2, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("BEF", "056", "Belgian Franc", 0, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("DEM", "276", "Deutsche Mark", 2, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("ESP", "724", "Spanish Peseta", 0, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("FIM", "246", "Markka", 2, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("FRF", "250", "French Franc", 2, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("GRD", "300", "Drachma", 0, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("IEP", "372", "Irish Pound", 2, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("ITL", "380", "Italian Lira", 0, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("LUF", "442", "Luxembourg Franc", 0, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("NLG", "528", "Netherlands Guilder", 2, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("PTE", "620", "Portuguese Escudo", 0, 2002, time.March, "EUR"),
+		newWithdrawnCurrency("SIT", "705", "Tolar", 2, 2007, time.January, "EUR"),
+		newWithdrawnCurrency("CYP", "196", "Cyprus Pound", 2, 2008, time.January, "EUR"),
+		newWithdrawnCurrency("MTL", "470", "Maltese Lira", 2, 2008, time.January, "EUR"),
+		newWithdrawnCurrency("SKK", "703", "Slovak Koruna", 2, 2009, time.January, "EUR"),
+		newWithdrawnCurrency("EEK", "233", "Kroon", 2, 2011, time.January, "EUR"),
+		newWithdrawnCurrency("LVL", "428", "Latvian Lats", 2, 2014, time.January, "EUR"),
+		newWithdrawnCurrency("LTL", "440", "Lithuanian Litas", 2, 2014, time.December, "EUR"),
+		newWithdrawnCurrency("HRK", "191", "Kuna", 2, 2023, time.January, "EUR"),
+		newWithdrawnCurrency("ECS", "218", "Sucre", 0, 2000, time.September, "USD"),
+		newWithdrawnCurrency("AFA", "004", "Afghani", 2, 2003, time.January, "AFN"),
+		newWithdrawnCurrency("BGL", "100", "Lev", 2, 2003, time.November, "BGN"),
+		newWithdrawnCurrency("SRG", "740", "Surinam Guilder", 2, 2004, time.January, "SRD"),
+		newWithdrawnCurrency("MGF", "450", "Malagasy Franc", 0, 2004, time.December, "MGA"),
+		newWithdrawnCurrency("ROL", "642", "Leu", 2, 2005, time.June, "RON"),
+		newWithdrawnCurrency("AZM", "031", "Azerbaijanian Manat", 2, 2005, time.December, "AZN"),
+		newWithdrawnCurrency("TRL", "792", "Old Turkish Lira", 0, 2005, time.December, "TRY"),
+		newWithdrawnCurrency("MZM", "508", "Mozambique Metical", 2, 2006, time.June, "MZN"),
+		newWithdrawnCurrency("CSD", "891", "Serbian Dinar", 2, 2006, time.October, "RSD"),
+		newWithdrawnCurrency("SDD", "736", "Sudanese Dinar", 2, 2007, time.July, "SDG"),
+		newWithdrawnCurrency("GHC", "288", "Cedi", 2, 2008, time.January, "GHS"),
+		newWithdrawnCurrency("VEB", "862", "Bolivar", 2, 2008, time.January, "VEF"),
+		newWithdrawnCurrency("TMM", "795", "Turkmenistan Manat", 2, 2009, time.January, "TMT"),
+		newWithdrawnCurrency("ZMK", "894", "Zambian Kwacha", 2, 2012, time.December, "ZMW"),
+		newWithdrawnCurrency("BYR", "974", "Belarusian Ruble", 0, 2017, time.January, "BYN"),
+		newWithdrawnCurrency("MRO", "478", "Ouguiya", 2, 2017, time.December, "MRU"),
+		newWithdrawnCurrency("STD", "678", "Dobra", 2, 2017, time.December, "STN"),
+	}
+}
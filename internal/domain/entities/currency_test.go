@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 func TestNewCurrency(t *testing.T) {
@@ -269,3 +270,92 @@ func TestCurrency_TableName(t *testing.T) {
 	// Then
 	assert.Equal(t, "tm_currencies", tableName)
 }
+
+func TestCurrency_SetNumericCode(t *testing.T) {
+	currency := NewCurrency("Rupiah", "IDR", 2)
+
+	currency.SetNumericCode("360")
+	assert.Equal(t, "360", *currency.NumericCode)
+	assert.True(t, currency.IsValid())
+
+	currency.SetNumericCode("36")
+	assert.False(t, currency.IsValid())
+}
+
+func TestCurrency_Withdraw(t *testing.T) {
+	// Given
+	currency := NewCurrency("Deutsche Mark", "DEM", 2)
+	withdrawnAt := time.Date(2002, 3, 1, 0, 0, 0, 0, time.UTC)
+	replacedBy := "EUR"
+
+	// When
+	currency.Withdraw(withdrawnAt, &replacedBy)
+
+	// Then
+	assert.True(t, currency.IsWithdrawn())
+	assert.False(t, currency.IsActive)
+	assert.Equal(t, withdrawnAt, *currency.WithdrawnAt)
+	assert.Equal(t, "EUR", *currency.ReplacedBy)
+	assert.True(t, currency.IsValid())
+
+	self := "DEM"
+	currency.Withdraw(withdrawnAt, &self)
+	assert.False(t, currency.IsValid())
+
+	active := NewCurrency("Euro", "EUR", 2)
+	active.ReplacedBy = &replacedBy
+	assert.False(t, active.IsValid(), "only withdrawn currencies can be replaced")
+}
+
+func TestDefaultWithdrawnCurrencies(t *testing.T) {
+	codes := make(map[string]bool)
+	numericCodes := make(map[string]bool)
+	for _, currency := range DefaultWithdrawnCurrencies() {
+		assert.True(t, currency.IsValid(), currency.Code)
+		assert.True(t, currency.IsWithdrawn(), currency.Code)
+		assert.False(t, codes[currency.Code], "duplicate currency %s", currency.Code)
+		assert.False(t, numericCodes[*currency.NumericCode], "duplicate numeric code %s", *currency.NumericCode)
+		codes[currency.Code] = true
+		numericCodes[*currency.NumericCode] = true
+	}
+
+	assert.True(t, codes["DEM"])
+	assert.True(t, codes["HRK"])
+}
+
+func TestCurrency_RoundCash(t *testing.T) {
+	increment, _ := valueobjects.NewDecimal("0.05")
+	chf := NewCurrency("Swiss Franc", "CHF", 2)
+	chf.SetCashRounding(&increment, valueobjects.RoundHalfUp)
+	assert.True(t, chf.IsValid())
+
+	amount, _ := valueobjects.NewDecimal("12.325")
+	assert.Equal(t, "12.35", chf.RoundCash(amount).StringFixed(2))
+	assert.Equal(t, "12.33", chf.RoundElectronic(amount).StringFixed(2))
+
+	amount, _ = valueobjects.NewDecimal("12.32")
+	assert.Equal(t, "12.30", chf.RoundCash(amount).StringFixed(2))
+
+	hundred := valueobjects.NewDecimalFromInt(100)
+	idr := NewCurrency("Rupiah", "IDR", 0)
+	idr.SetCashRounding(&hundred, valueobjects.RoundDown)
+	amount, _ = valueobjects.NewDecimal("15299.5")
+	assert.Equal(t, "15200", idr.RoundCash(amount).String())
+	assert.Equal(t, "15300", idr.RoundElectronic(amount).String())
+
+	usd := NewCurrency("US Dollar", "USD", 2)
+	amount, _ = valueobjects.NewDecimal("1.005")
+	assert.Equal(t, "1.01", usd.RoundCash(amount).StringFixed(2), "without an increment cash is rounded like electronic payments")
+	assert.Equal(t, "0.01", usd.MinorUnit().String())
+	assert.Equal(t, "1", idr.MinorUnit().String())
+}
+
+func TestCurrency_IsValid_CashRounding(t *testing.T) {
+	zero := valueobjects.NewDecimalFromInt(0)
+	currency := NewCurrency("Swiss Franc", "CHF", 2)
+	currency.SetCashRounding(&zero, valueobjects.RoundHalfUp)
+	assert.False(t, currency.IsValid(), "the increment must be positive")
+
+	currency.SetCashRounding(nil, valueobjects.RoundingMode("nearest"))
+	assert.False(t, currency.IsValid(), "the rounding mode must be supported")
+}
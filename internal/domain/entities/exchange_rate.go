@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// ExchangeRateDateLayout is the layout of exchange rate dates
+const ExchangeRateDateLayout = "2006-01-02"
+
+// currencyCodePattern matches an ISO 4217 alphabetic currency code
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ExchangeRate represents the rate of a quote currency in units per one unit of the base currency
+// on a given date, as published by a source such as the ECB
+type ExchangeRate struct {
+	ID            uuid.UUID            `json:"id" db:"id"`
+	BaseCurrency  string               `json:"base_currency" db:"base_currency"`
+	QuoteCurrency string               `json:"quote_currency" db:"quote_currency"`
+	Rate          valueobjects.Decimal `json:"rate" db:"rate"`
+	RateDate      time.Time            `json:"rate_date" db:"rate_date"`
+	Source        string               `json:"source" db:"source"`
+	CreatedAt     time.Time            `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time            `json:"updated_at" db:"updated_at"`
+}
+
+// TableName returns the table name for the ExchangeRate entity
+func (r *ExchangeRate) TableName() string {
+	return "tm_exchange_rates"
+}
+
+// GenerateID generates a new UUID for the exchange rate if not set
+func (r *ExchangeRate) GenerateID() {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+}
+
+// NewExchangeRate creates a new ExchangeRate instance. Currency codes are upper-cased and the
+// date is truncated to the day.
+func NewExchangeRate(baseCurrency, quoteCurrency string, rate valueobjects.Decimal, rateDate time.Time, source string) *ExchangeRate {
+	return &ExchangeRate{
+		ID:            uuid.New(),
+		BaseCurrency:  strings.ToUpper(strings.TrimSpace(baseCurrency)),
+		QuoteCurrency: strings.ToUpper(strings.TrimSpace(quoteCurrency)),
+		Rate:          rate,
+		RateDate:      time.Date(rateDate.Year(), rateDate.Month(), rateDate.Day(), 0, 0, 0, 0, time.UTC),
+		Source:        strings.TrimSpace(source),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+}
+
+// SetRate sets the rate
+func (r *ExchangeRate) SetRate(rate valueobjects.Decimal) {
+	r.Rate = rate
+	r.UpdatedAt = time.Now()
+}
+
+// IsValid checks if the exchange rate has valid data
+func (r *ExchangeRate) IsValid() bool {
+	return currencyCodePattern.MatchString(r.BaseCurrency) &&
+		currencyCodePattern.MatchString(r.QuoteCurrency) &&
+		r.BaseCurrency != r.QuoteCurrency &&
+		r.Rate.Sign() > 0 &&
+		!r.RateDate.IsZero()
+}
@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+func TestNewExchangeRate(t *testing.T) {
+	// Given
+	rate, _ := valueobjects.NewDecimal("1.0856")
+	date := time.Date(2024, 1, 2, 16, 0, 0, 0, time.Local)
+
+	// When
+	exchangeRate := NewExchangeRate("eur", " usd ", rate, date, "ECB")
+
+	// Then
+	assert.NotEqual(t, uuid.Nil, exchangeRate.ID)
+	assert.Equal(t, "EUR", exchangeRate.BaseCurrency)
+	assert.Equal(t, "USD", exchangeRate.QuoteCurrency)
+	assert.Equal(t, "1.0856", exchangeRate.Rate.String())
+	assert.Equal(t, "2024-01-02", exchangeRate.RateDate.Format(ExchangeRateDateLayout))
+	assert.Equal(t, 0, exchangeRate.RateDate.Hour())
+	assert.Equal(t, "ECB", exchangeRate.Source)
+	assert.Equal(t, "tm_exchange_rates", exchangeRate.TableName())
+	assert.True(t, exchangeRate.IsValid())
+}
+
+func TestExchangeRate_IsValid(t *testing.T) {
+	rate, _ := valueobjects.NewDecimal("1.0856")
+	date := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	assert.False(t, NewExchangeRate("EU", "USD", rate, date, "ECB").IsValid())
+	assert.False(t, NewExchangeRate("EUR", "EUR", rate, date, "ECB").IsValid())
+	assert.False(t, NewExchangeRate("EUR", "USD", valueobjects.NewDecimalFromInt(0), date, "ECB").IsValid())
+	assert.False(t, NewExchangeRate("EUR", "USD", valueobjects.NewDecimalFromInt(-1), date, "ECB").IsValid())
+
+	noDate := NewExchangeRate("EUR", "USD", rate, date, "ECB")
+	noDate.RateDate = time.Time{}
+	assert.False(t, noDate.IsValid())
+}
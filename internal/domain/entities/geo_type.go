@@ -0,0 +1,127 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GeoTypeDefinition represents a registered geodirectory type stored in the geo types registry
+type GeoTypeDefinition struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	Code          GeoType   `json:"code" db:"code"`
+	Label         string    `json:"label" db:"label"`
+	DefaultDepth  int       `json:"default_depth" db:"default_depth"`
+	HierarchyRank int       `json:"hierarchy_rank" db:"hierarchy_rank"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// TableName returns the table name for the GeoTypeDefinition entity
+func (d *GeoTypeDefinition) TableName() string {
+	return "tm_geo_types"
+}
+
+// GenerateID generates a new UUID for the geo type definition if not set
+func (d *GeoTypeDefinition) GenerateID() {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+}
+
+// NewGeoTypeDefinition creates a new GeoTypeDefinition instance
+func NewGeoTypeDefinition(code GeoType, label string, defaultDepth, hierarchyRank int) *GeoTypeDefinition {
+	return &GeoTypeDefinition{
+		ID:            uuid.New(),
+		Code:          GeoType(strings.ToUpper(string(code))),
+		Label:         label,
+		DefaultDepth:  defaultDepth,
+		HierarchyRank: hierarchyRank,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+}
+
+// IsValid checks if the geo type definition has valid data
+func (d *GeoTypeDefinition) IsValid() bool {
+	return d.Code != "" && d.Label != "" && d.DefaultDepth >= 0 && d.HierarchyRank > 0
+}
+
+// DefaultGeoTypes returns the built-in geo types used until the registry is loaded from the database
+func DefaultGeoTypes() []*GeoTypeDefinition {
+	return []*GeoTypeDefinition{
+		NewGeoTypeDefinition(GeoTypeContinent, "Continent", 1, 1),
+		NewGeoTypeDefinition(GeoTypeSubcontinent, "Subcontinent", 2, 2),
+		NewGeoTypeDefinition(GeoTypeCountry, "Country", 3, 3),
+		NewGeoTypeDefinition(GeoTypeState, "State", 4, 4),
+		NewGeoTypeDefinition(GeoTypeProvince, "Province", 4, 4),
+		NewGeoTypeDefinition(GeoTypeRegency, "Regency", 5, 5),
+		NewGeoTypeDefinition(GeoTypeCity, "City", 6, 6),
+		NewGeoTypeDefinition(GeoTypeDistrict, "District", 7, 7),
+		NewGeoTypeDefinition(GeoTypeSubdistrict, "Subdistrict", 8, 8),
+		NewGeoTypeDefinition(GeoTypeVillage, "Village", 9, 9),
+	}
+}
+
+// geoTypeRegistry holds the geo types known to the application
+var geoTypeRegistry = struct {
+	sync.RWMutex
+	types map[GeoType]*GeoTypeDefinition
+}{
+	types: indexGeoTypes(DefaultGeoTypes()),
+}
+
+// indexGeoTypes builds a lookup map of geo type definitions by code
+func indexGeoTypes(definitions []*GeoTypeDefinition) map[GeoType]*GeoTypeDefinition {
+	types := make(map[GeoType]*GeoTypeDefinition, len(definitions))
+	for _, definition := range definitions {
+		types[definition.Code] = definition
+	}
+	return types
+}
+
+// LoadGeoTypes replaces the registered geo types, typically with the contents of the tm_geo_types table.
+// An empty list keeps the current registry untouched.
+func LoadGeoTypes(definitions []*GeoTypeDefinition) {
+	if len(definitions) == 0 {
+		return
+	}
+
+	geoTypeRegistry.Lock()
+	defer geoTypeRegistry.Unlock()
+	geoTypeRegistry.types = indexGeoTypes(definitions)
+}
+
+// LookupGeoType returns the registered definition of a geo type
+func LookupGeoType(code GeoType) (*GeoTypeDefinition, bool) {
+	geoTypeRegistry.RLock()
+	defer geoTypeRegistry.RUnlock()
+	definition, exists := geoTypeRegistry.types[code]
+	return definition, exists
+}
+
+// RegisteredGeoTypes returns all registered geo types ordered by hierarchy rank and code
+func RegisteredGeoTypes() []*GeoTypeDefinition {
+	geoTypeRegistry.RLock()
+	definitions := make([]*GeoTypeDefinition, 0, len(geoTypeRegistry.types))
+	for _, definition := range geoTypeRegistry.types {
+		definitions = append(definitions, definition)
+	}
+	geoTypeRegistry.RUnlock()
+
+	SortGeoTypes(definitions)
+	return definitions
+}
+
+// SortGeoTypes orders geo type definitions by hierarchy rank and code
+func SortGeoTypes(definitions []*GeoTypeDefinition) {
+	sort.SliceStable(definitions, func(i, j int) bool {
+		if definitions[i].HierarchyRank != definitions[j].HierarchyRank {
+			return definitions[i].HierarchyRank < definitions[j].HierarchyRank
+		}
+		return definitions[i].Code < definitions[j].Code
+	})
+}
@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGeoTypeDefinition(t *testing.T) {
+	// When
+	definition := NewGeoTypeDefinition("island", "Island", 5, 5)
+
+	// Then
+	assert.NotEqual(t, uuid.Nil, definition.ID)
+	assert.Equal(t, GeoType("ISLAND"), definition.Code)
+	assert.Equal(t, "Island", definition.Label)
+	assert.Equal(t, 5, definition.DefaultDepth)
+	assert.Equal(t, 5, definition.HierarchyRank)
+	assert.True(t, definition.IsValid())
+	assert.Equal(t, "tm_geo_types", definition.TableName())
+}
+
+func TestGeoTypeDefinition_IsValid(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition *GeoTypeDefinition
+		expected   bool
+	}{
+		{"valid", NewGeoTypeDefinition("RW", "Rukun Warga", 10, 10), true},
+		{"missing code", NewGeoTypeDefinition("", "Rukun Warga", 10, 10), false},
+		{"missing label", NewGeoTypeDefinition("RW", "", 10, 10), false},
+		{"zero rank", NewGeoTypeDefinition("RW", "Rukun Warga", 10, 0), false},
+		{"negative depth", NewGeoTypeDefinition("RW", "Rukun Warga", -1, 10), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.definition.IsValid())
+		})
+	}
+}
+
+func TestRegisteredGeoTypes_DefaultsOrderedByRank(t *testing.T) {
+	// When
+	definitions := RegisteredGeoTypes()
+
+	// Then
+	require.Len(t, definitions, 10)
+	assert.Equal(t, GeoTypeContinent, definitions[0].Code)
+	assert.Equal(t, GeoTypeVillage, definitions[len(definitions)-1].Code)
+	for i := 1; i < len(definitions); i++ {
+		assert.LessOrEqual(t, definitions[i-1].HierarchyRank, definitions[i].HierarchyRank)
+	}
+}
+
+func TestLoadGeoTypes(t *testing.T) {
+	// Given
+	defer LoadGeoTypes(DefaultGeoTypes())
+
+	// When
+	LoadGeoTypes([]*GeoTypeDefinition{
+		NewGeoTypeDefinition(GeoTypeCountry, "Country", 1, 1),
+		NewGeoTypeDefinition("ISLAND", "Island", 2, 2),
+	})
+
+	// Then
+	_, exists := LookupGeoType(GeoTypeVillage)
+	assert.False(t, exists)
+	island, exists := LookupGeoType("ISLAND")
+	require.True(t, exists)
+	assert.Equal(t, "Island", island.Label)
+	assert.Equal(t, 1, (&Geodirectory{Type: GeoTypeCountry}).GetDepthForType())
+}
+
+func TestLoadGeoTypes_EmptyKeepsRegistry(t *testing.T) {
+	// When
+	LoadGeoTypes(nil)
+
+	// Then
+	_, exists := LookupGeoType(GeoTypeVillage)
+	assert.True(t, exists)
+}
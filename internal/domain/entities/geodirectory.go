@@ -4,9 +4,11 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
-// GeoType represents the type of geographical location
+// GeoType represents the type of geographical location.
+// The constants below are the built-in types; additional types are registered in the geo types registry.
 type GeoType string
 
 const (
@@ -22,6 +24,19 @@ const (
 	GeoTypeVillage      GeoType = "VILLAGE"
 )
 
+// GeodirectoryListSchema is the whitelist of the fields geodirectory lists can be filtered and sorted on
+var GeodirectoryListSchema = valueobjects.ListSchema{
+	"name":            {Type: valueobjects.FieldTypeString, Sortable: true},
+	"type":            {Type: valueobjects.FieldTypeString, Operators: []valueobjects.FilterOperator{valueobjects.FilterEq, valueobjects.FilterNe, valueobjects.FilterIn}, Sortable: true},
+	"code":            {Type: valueobjects.FieldTypeString, Sortable: true},
+	"postal_code":     {Type: valueobjects.FieldTypeString, Sortable: true},
+	"parent_id":       {Type: valueobjects.FieldTypeUUID},
+	"record_depth":    {Type: valueobjects.FieldTypeInt, Sortable: true},
+	"record_ordering": {Type: valueobjects.FieldTypeInt, Sortable: true},
+	"created_at":      {Type: valueobjects.FieldTypeDate, Sortable: true},
+	"updated_at":      {Type: valueobjects.FieldTypeDate, Sortable: true},
+}
+
 // Geodirectory represents a geographical location in a hierarchical structure
 type Geodirectory struct {
 	ID             uuid.UUID  `json:"id" db:"id"`
@@ -29,8 +44,8 @@ type Geodirectory struct {
 	Type           GeoType    `json:"type" db:"type"`
 	Code           *string    `json:"code,omitempty" db:"code"`
 	PostalCode     *string    `json:"postal_code,omitempty" db:"postal_code"`
-	Longitude      *string    `json:"longitude,omitempty" db:"longitude"`
-	Latitude       *string    `json:"latitude,omitempty" db:"latitude"`
+	Longitude      *float64   `json:"longitude,omitempty,string" db:"longitude"`
+	Latitude       *float64   `json:"latitude,omitempty,string" db:"latitude"`
 	RecordLeft     *int       `json:"record_left,omitempty" db:"record_left"`
 	RecordRight    *int       `json:"record_right,omitempty" db:"record_right"`
 	RecordOrdering *int       `json:"record_ordering,omitempty" db:"record_ordering"`
@@ -80,12 +95,27 @@ func (g *Geodirectory) SetPostalCode(postalCode string) {
 }
 
 // SetCoordinates sets the latitude and longitude for the geodirectory
-func (g *Geodirectory) SetCoordinates(latitude, longitude string) {
+func (g *Geodirectory) SetCoordinates(coordinates *valueobjects.Coordinates) {
+	latitude := coordinates.Latitude()
+	longitude := coordinates.Longitude()
 	g.Latitude = &latitude
 	g.Longitude = &longitude
 	g.UpdatedAt = time.Now()
 }
 
+// GetCoordinates returns the validated coordinates of the geodirectory, or nil when they are not set
+func (g *Geodirectory) GetCoordinates() *valueobjects.Coordinates {
+	if g.Latitude == nil || g.Longitude == nil {
+		return nil
+	}
+
+	coordinates, err := valueobjects.NewCoordinates(*g.Latitude, *g.Longitude)
+	if err != nil {
+		return nil
+	}
+	return coordinates
+}
+
 // SetParent sets the parent geodirectory
 func (g *Geodirectory) SetParent(parentID uuid.UUID) {
 	g.ParentID = &parentID
@@ -112,30 +142,13 @@ func (g *Geodirectory) SetOrderingID(orderingID int) {
 	g.UpdatedAt = time.Now()
 }
 
-// GetDepthForType returns the appropriate depth based on geodirectory type
+// GetDepthForType returns the default depth registered for the geodirectory type
 func (g *Geodirectory) GetDepthForType() int {
-	switch g.Type {
-	case GeoTypeContinent:
-		return 1
-	case GeoTypeSubcontinent:
-		return 2
-	case GeoTypeCountry:
-		return 3
-	case GeoTypeState, GeoTypeProvince:
-		return 4
-	case GeoTypeRegency:
-		return 5
-	case GeoTypeCity:
-		return 6
-	case GeoTypeDistrict:
-		return 7
-	case GeoTypeSubdistrict:
-		return 8
-	case GeoTypeVillage:
-		return 9
-	default:
+	definition, exists := LookupGeoType(g.Type)
+	if !exists {
 		return 0
 	}
+	return definition.DefaultDepth
 }
 
 // IsLeaf checks if this geodirectory is a leaf node (has no children)
@@ -156,30 +169,13 @@ func (g *Geodirectory) IsRoot() bool {
 	return g.ParentID == nil
 }
 
-// GetHierarchyLevel returns the hierarchy level based on the type
+// GetHierarchyLevel returns the hierarchy rank registered for the geodirectory type
 func (g *Geodirectory) GetHierarchyLevel() int {
-	switch g.Type {
-	case GeoTypeContinent:
-		return 1
-	case GeoTypeSubcontinent:
-		return 2
-	case GeoTypeCountry:
-		return 3
-	case GeoTypeState, GeoTypeProvince:
-		return 4
-	case GeoTypeRegency:
-		return 5
-	case GeoTypeCity:
-		return 6
-	case GeoTypeDistrict:
-		return 7
-	case GeoTypeSubdistrict:
-		return 8
-	case GeoTypeVillage:
-		return 9
-	default:
+	definition, exists := LookupGeoType(g.Type)
+	if !exists {
 		return 0
 	}
+	return definition.HierarchyRank
 }
 
 // GetFullPath returns the full hierarchical path as a string
@@ -190,45 +186,18 @@ func (g *Geodirectory) GetFullPath() string {
 	return g.Parent.GetFullPath() + " > " + g.Name
 }
 
-// ValidateType checks if the geodirectory type is valid
+// ValidateType checks if the geodirectory type is registered in the geo types registry
 func (g *Geodirectory) ValidateType() bool {
-	validTypes := []GeoType{
-		GeoTypeContinent, GeoTypeSubcontinent, GeoTypeCountry,
-		GeoTypeState, GeoTypeProvince, GeoTypeRegency,
-		GeoTypeCity, GeoTypeDistrict, GeoTypeSubdistrict, GeoTypeVillage,
-	}
-
-	for _, validType := range validTypes {
-		if g.Type == validType {
-			return true
-		}
-	}
-	return false
+	_, exists := LookupGeoType(g.Type)
+	return exists
 }
 
 // CanHaveParentType checks if the current type can have the specified parent type
 func (g *Geodirectory) CanHaveParentType(parentType GeoType) bool {
-	validParents := map[GeoType][]GeoType{
-		GeoTypeSubcontinent: {GeoTypeContinent},
-		GeoTypeCountry:      {GeoTypeContinent, GeoTypeSubcontinent},
-		GeoTypeState:        {GeoTypeCountry},
-		GeoTypeProvince:     {GeoTypeCountry},
-		GeoTypeRegency:      {GeoTypeState, GeoTypeProvince},
-		GeoTypeCity:         {GeoTypeState, GeoTypeProvince, GeoTypeRegency},
-		GeoTypeDistrict:     {GeoTypeCity, GeoTypeRegency},
-		GeoTypeSubdistrict:  {GeoTypeDistrict},
-		GeoTypeVillage:      {GeoTypeDistrict, GeoTypeSubdistrict},
-	}
-
-	allowedParents, exists := validParents[g.Type]
-	if !exists {
-		return false // Root types like CONTINENT don't have parents
-	}
+	return g.CanHaveParentTypeIn(DefaultHierarchySchema(), parentType)
+}
 
-	for _, allowedParent := range allowedParents {
-		if parentType == allowedParent {
-			return true
-		}
-	}
-	return false
+// CanHaveParentTypeIn checks if the current type can have the specified parent type within the given schema
+func (g *Geodirectory) CanHaveParentTypeIn(schema *HierarchySchema, parentType GeoType) bool {
+	return schema.AllowsParent(g.Type, parentType)
 }
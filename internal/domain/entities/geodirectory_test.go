@@ -1,12 +1,14 @@
 package entities
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 func TestNewGeodirectory(t *testing.T) {
@@ -89,23 +91,52 @@ func TestGeodirectory_SetPostalCode(t *testing.T) {
 func TestGeodirectory_SetCoordinates(t *testing.T) {
 	// Given
 	geo := NewGeodirectory("Test", GeoTypeCity)
-	latitude := "-6.2088"
-	longitude := "106.8456"
+	coordinates, err := valueobjects.ParseCoordinates("-6.2088", "106.8456")
+	require.NoError(t, err)
 	originalTime := geo.UpdatedAt
 
 	time.Sleep(1 * time.Millisecond)
 
 	// When
-	geo.SetCoordinates(latitude, longitude)
+	geo.SetCoordinates(coordinates)
 
 	// Then
 	require.NotNil(t, geo.Latitude)
 	require.NotNil(t, geo.Longitude)
-	assert.Equal(t, latitude, *geo.Latitude)
-	assert.Equal(t, longitude, *geo.Longitude)
+	assert.Equal(t, -6.2088, *geo.Latitude)
+	assert.Equal(t, 106.8456, *geo.Longitude)
+	assert.True(t, coordinates.Equals(geo.GetCoordinates()))
 	assert.True(t, geo.UpdatedAt.After(originalTime))
 }
 
+func TestGeodirectory_GetCoordinates_NotSet(t *testing.T) {
+	// Given
+	geo := NewGeodirectory("Test", GeoTypeCity)
+
+	// Then
+	assert.Nil(t, geo.GetCoordinates())
+}
+
+func TestGeodirectory_CoordinatesJSONBackwardCompatible(t *testing.T) {
+	// Given
+	geo := NewGeodirectory("Test", GeoTypeCity)
+	coordinates, err := valueobjects.NewCoordinates(-6.2088, 106.8456)
+	require.NoError(t, err)
+	geo.SetCoordinates(coordinates)
+
+	// When
+	data, err := json.Marshal(geo)
+	require.NoError(t, err)
+
+	// Then
+	assert.Contains(t, string(data), `"latitude":"-6.2088"`)
+	assert.Contains(t, string(data), `"longitude":"106.8456"`)
+
+	var decoded Geodirectory
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, coordinates.Equals(decoded.GetCoordinates()))
+}
+
 func TestGeodirectory_SetParent(t *testing.T) {
 	// Given
 	geo := NewGeodirectory("Test", GeoTypeCity)
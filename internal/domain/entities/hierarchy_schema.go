@@ -0,0 +1,146 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HierarchyRule represents an allowed parent/child type pair within a country's administrative hierarchy
+type HierarchyRule struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	CountryCode string    `json:"country_code" db:"country_code"`
+	ParentType  GeoType   `json:"parent_type" db:"parent_type"`
+	ChildType   GeoType   `json:"child_type" db:"child_type"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// TableName returns the table name for the HierarchyRule entity
+func (r *HierarchyRule) TableName() string {
+	return "tm_geo_hierarchy_rules"
+}
+
+// GenerateID generates a new UUID for the hierarchy rule if not set
+func (r *HierarchyRule) GenerateID() {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+}
+
+// NewHierarchyRule creates a new HierarchyRule instance
+func NewHierarchyRule(countryCode string, parentType, childType GeoType) *HierarchyRule {
+	return &HierarchyRule{
+		ID:          uuid.New(),
+		CountryCode: countryCode,
+		ParentType:  parentType,
+		ChildType:   childType,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// HierarchySchema describes which geodirectory types may appear in a hierarchy and under which parents
+type HierarchySchema struct {
+	CountryCode  string                `json:"country_code,omitempty"`
+	AllowedTypes []GeoType             `json:"allowed_types"`
+	Parents      map[GeoType][]GeoType `json:"parents"`
+}
+
+// supranationalParents lists the parent types that apply above the country level in every schema
+var supranationalParents = map[GeoType][]GeoType{
+	GeoTypeSubcontinent: {GeoTypeContinent},
+	GeoTypeCountry:      {GeoTypeContinent, GeoTypeSubcontinent},
+}
+
+// DefaultHierarchySchema returns the global schema used when a country has no rules of its own
+func DefaultHierarchySchema() *HierarchySchema {
+	parents := map[GeoType][]GeoType{
+		GeoTypeState:       {GeoTypeCountry},
+		GeoTypeProvince:    {GeoTypeCountry},
+		GeoTypeRegency:     {GeoTypeState, GeoTypeProvince},
+		GeoTypeCity:        {GeoTypeState, GeoTypeProvince, GeoTypeRegency},
+		GeoTypeDistrict:    {GeoTypeCity, GeoTypeRegency},
+		GeoTypeSubdistrict: {GeoTypeDistrict},
+		GeoTypeVillage:     {GeoTypeDistrict, GeoTypeSubdistrict},
+	}
+	for childType, parentTypes := range supranationalParents {
+		parents[childType] = parentTypes
+	}
+
+	return newHierarchySchema("", parents)
+}
+
+// NewHierarchySchema builds the schema for a country from its rules, falling back to the default schema when there are none
+func NewHierarchySchema(countryCode string, rules []*HierarchyRule) *HierarchySchema {
+	if len(rules) == 0 {
+		schema := DefaultHierarchySchema()
+		schema.CountryCode = countryCode
+		return schema
+	}
+
+	parents := make(map[GeoType][]GeoType)
+	for childType, parentTypes := range supranationalParents {
+		parents[childType] = parentTypes
+	}
+	for _, rule := range rules {
+		parents[rule.ChildType] = append(parents[rule.ChildType], rule.ParentType)
+	}
+
+	return newHierarchySchema(countryCode, parents)
+}
+
+// newHierarchySchema assembles a schema and derives its allowed types from the parent map
+func newHierarchySchema(countryCode string, parents map[GeoType][]GeoType) *HierarchySchema {
+	seen := map[GeoType]bool{GeoTypeContinent: true}
+	for childType, parentTypes := range parents {
+		seen[childType] = true
+		for _, parentType := range parentTypes {
+			seen[parentType] = true
+		}
+	}
+
+	// Keep the allowed types in registry hierarchy order so the output is stable
+	allowedTypes := make([]GeoType, 0, len(seen))
+	for _, definition := range RegisteredGeoTypes() {
+		if seen[definition.Code] {
+			allowedTypes = append(allowedTypes, definition.Code)
+		}
+	}
+
+	return &HierarchySchema{
+		CountryCode:  countryCode,
+		AllowedTypes: allowedTypes,
+		Parents:      parents,
+	}
+}
+
+// AllowsType checks if the geodirectory type may appear in this hierarchy
+func (s *HierarchySchema) AllowsType(geoType GeoType) bool {
+	for _, allowedType := range s.AllowedTypes {
+		if allowedType == geoType {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsParent checks if the child type may be placed under the parent type in this hierarchy
+func (s *HierarchySchema) AllowsParent(childType, parentType GeoType) bool {
+	allowedParents, exists := s.Parents[childType]
+	if !exists {
+		return false // Root types like CONTINENT don't have parents
+	}
+
+	for _, allowedParent := range allowedParents {
+		if parentType == allowedParent {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCountryScoped checks if nodes of the given type are governed by their country's schema
+func IsCountryScoped(geoType GeoType) bool {
+	return geoType != GeoTypeContinent && geoType != GeoTypeSubcontinent && geoType != GeoTypeCountry
+}
@@ -0,0 +1,116 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHierarchyRule(t *testing.T) {
+	// When
+	rule := NewHierarchyRule("US", GeoTypeCountry, GeoTypeState)
+
+	// Then
+	assert.NotEqual(t, uuid.Nil, rule.ID)
+	assert.Equal(t, "US", rule.CountryCode)
+	assert.Equal(t, GeoTypeCountry, rule.ParentType)
+	assert.Equal(t, GeoTypeState, rule.ChildType)
+	assert.False(t, rule.CreatedAt.IsZero())
+	assert.False(t, rule.UpdatedAt.IsZero())
+	assert.Equal(t, "tm_geo_hierarchy_rules", rule.TableName())
+}
+
+func TestDefaultHierarchySchema(t *testing.T) {
+	// When
+	schema := DefaultHierarchySchema()
+
+	// Then
+	assert.Empty(t, schema.CountryCode)
+	assert.Equal(t, []GeoType{
+		GeoTypeContinent, GeoTypeSubcontinent, GeoTypeCountry,
+		GeoTypeProvince, GeoTypeState, GeoTypeRegency,
+		GeoTypeCity, GeoTypeDistrict, GeoTypeSubdistrict, GeoTypeVillage,
+	}, schema.AllowedTypes)
+	assert.True(t, schema.AllowsParent(GeoTypeProvince, GeoTypeCountry))
+	assert.False(t, schema.AllowsParent(GeoTypeContinent, GeoTypeCountry))
+}
+
+func TestNewHierarchySchema_WithoutRulesFallsBackToDefault(t *testing.T) {
+	// When
+	schema := NewHierarchySchema("FR", nil)
+
+	// Then
+	assert.Equal(t, "FR", schema.CountryCode)
+	assert.Equal(t, DefaultHierarchySchema().AllowedTypes, schema.AllowedTypes)
+	assert.True(t, schema.AllowsParent(GeoTypeCity, GeoTypeRegency))
+}
+
+func TestNewHierarchySchema_WithRules(t *testing.T) {
+	// Given
+	rules := []*HierarchyRule{
+		NewHierarchyRule("US", GeoTypeCountry, GeoTypeState),
+		NewHierarchyRule("US", GeoTypeState, GeoTypeCity),
+	}
+
+	// When
+	schema := NewHierarchySchema("US", rules)
+
+	// Then
+	assert.Equal(t, "US", schema.CountryCode)
+	assert.Equal(t, []GeoType{
+		GeoTypeContinent, GeoTypeSubcontinent, GeoTypeCountry, GeoTypeState, GeoTypeCity,
+	}, schema.AllowedTypes)
+
+	tests := []struct {
+		childType  GeoType
+		parentType GeoType
+		expected   bool
+	}{
+		{GeoTypeCountry, GeoTypeContinent, true}, // Supranational rules always apply
+		{GeoTypeState, GeoTypeCountry, true},
+		{GeoTypeCity, GeoTypeState, true},
+		{GeoTypeProvince, GeoTypeCountry, false}, // Not part of this country's hierarchy
+		{GeoTypeCity, GeoTypeRegency, false},
+		{GeoTypeVillage, GeoTypeDistrict, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.childType)+"_"+string(tt.parentType), func(t *testing.T) {
+			assert.Equal(t, tt.expected, schema.AllowsParent(tt.childType, tt.parentType))
+		})
+	}
+}
+
+func TestHierarchySchema_AllowsType(t *testing.T) {
+	// Given
+	schema := NewHierarchySchema("US", []*HierarchyRule{
+		NewHierarchyRule("US", GeoTypeCountry, GeoTypeState),
+	})
+
+	// Then
+	assert.True(t, schema.AllowsType(GeoTypeContinent))
+	assert.True(t, schema.AllowsType(GeoTypeState))
+	assert.False(t, schema.AllowsType(GeoTypeProvince))
+	assert.False(t, schema.AllowsType(GeoTypeVillage))
+}
+
+func TestGeodirectory_CanHaveParentTypeIn(t *testing.T) {
+	// Given
+	schema := NewHierarchySchema("US", []*HierarchyRule{
+		NewHierarchyRule("US", GeoTypeCountry, GeoTypeState),
+	})
+	geo := &Geodirectory{Type: GeoTypeState}
+
+	// Then
+	assert.True(t, geo.CanHaveParentTypeIn(schema, GeoTypeCountry))
+	assert.False(t, geo.CanHaveParentTypeIn(schema, GeoTypeProvince))
+}
+
+func TestIsCountryScoped(t *testing.T) {
+	assert.False(t, IsCountryScoped(GeoTypeContinent))
+	assert.False(t, IsCountryScoped(GeoTypeSubcontinent))
+	assert.False(t, IsCountryScoped(GeoTypeCountry))
+	assert.True(t, IsCountryScoped(GeoTypeProvince))
+	assert.True(t, IsCountryScoped(GeoTypeVillage))
+}
@@ -0,0 +1,237 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// bbanStructureElement matches one element of the SWIFT IBAN registry notation, e.g. "8!n" or "11!c"
+var bbanStructureElement = regexp.MustCompile(`(\d+)(!?)([nace])`)
+
+// IBANFormat represents the country specific IBAN format published in the SWIFT IBAN registry
+type IBANFormat struct {
+	ID             uuid.UUID `json:"id" db:"id"`
+	CountryCode    string    `json:"country_code" db:"country_code"`
+	Length         int       `json:"length" db:"length"`
+	BBANStructure  string    `json:"bban_structure" db:"bban_structure"`
+	BankIDOffset   int       `json:"bank_id_offset" db:"bank_id_offset"`
+	BankIDLength   int       `json:"bank_id_length" db:"bank_id_length"`
+	BranchIDOffset int       `json:"branch_id_offset" db:"branch_id_offset"`
+	BranchIDLength int       `json:"branch_id_length" db:"branch_id_length"`
+	IsSEPA         bool      `json:"is_sepa" db:"is_sepa"`
+	Example        string    `json:"example" db:"example"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// TableName returns the table name for the IBANFormat entity
+func (f *IBANFormat) TableName() string {
+	return "tm_iban_formats"
+}
+
+// GenerateID generates a new UUID for the IBAN format if not set
+func (f *IBANFormat) GenerateID() {
+	if f.ID == uuid.Nil {
+		f.ID = uuid.New()
+	}
+}
+
+// NewIBANFormat creates a new IBANFormat instance. The bank identifier offset is relative to the BBAN.
+func NewIBANFormat(countryCode string, length int, bbanStructure string, bankIDOffset, bankIDLength int) *IBANFormat {
+	return &IBANFormat{
+		ID:            uuid.New(),
+		CountryCode:   strings.ToUpper(countryCode),
+		Length:        length,
+		BBANStructure: bbanStructure,
+		BankIDOffset:  bankIDOffset,
+		BankIDLength:  bankIDLength,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+}
+
+// SetBranchIdentifier sets the position of the branch identifier within the BBAN
+func (f *IBANFormat) SetBranchIdentifier(offset, length int) {
+	f.BranchIDOffset = offset
+	f.BranchIDLength = length
+	f.UpdatedAt = time.Now()
+}
+
+// SetSEPA sets whether the country is part of the Single Euro Payments Area
+func (f *IBANFormat) SetSEPA(isSEPA bool) {
+	f.IsSEPA = isSEPA
+	f.UpdatedAt = time.Now()
+}
+
+// SetExample sets the example IBAN of the format
+func (f *IBANFormat) SetExample(example string) {
+	f.Example = example
+	f.UpdatedAt = time.Now()
+}
+
+// BBANLength returns the length of the BBAN part of the IBAN
+func (f *IBANFormat) BBANLength() int {
+	return f.Length - 4
+}
+
+// BBANPattern returns the regular expression matching BBANs of this format
+func (f *IBANFormat) BBANPattern() (*regexp.Regexp, error) {
+	pattern, _, err := ParseBBANStructure(f.BBANStructure)
+	return pattern, err
+}
+
+// MatchesBBAN checks if a BBAN matches the structure of this format
+func (f *IBANFormat) MatchesBBAN(bban string) bool {
+	pattern, err := f.BBANPattern()
+	if err != nil {
+		return false
+	}
+	return len(bban) == f.BBANLength() && pattern.MatchString(bban)
+}
+
+// BankIdentifier extracts the bank identifier from a BBAN of this format
+func (f *IBANFormat) BankIdentifier(bban string) string {
+	return extractIdentifier(bban, f.BankIDOffset, f.BankIDLength)
+}
+
+// BranchIdentifier extracts the branch identifier from a BBAN of this format, empty when the format has none
+func (f *IBANFormat) BranchIdentifier(bban string) string {
+	return extractIdentifier(bban, f.BranchIDOffset, f.BranchIDLength)
+}
+
+// IsValid checks if the IBAN format has valid data
+func (f *IBANFormat) IsValid() bool {
+	if len(f.CountryCode) != 2 || f.Length < 15 || f.Length > 34 {
+		return false
+	}
+
+	_, length, err := ParseBBANStructure(f.BBANStructure)
+	if err != nil || length != f.BBANLength() {
+		return false
+	}
+
+	if f.BankIDLength <= 0 || f.BankIDOffset < 0 || f.BankIDOffset+f.BankIDLength > f.BBANLength() {
+		return false
+	}
+
+	return f.BranchIDLength == 0 || (f.BranchIDOffset >= 0 && f.BranchIDOffset+f.BranchIDLength <= f.BBANLength())
+}
+
+// ParseBBANStructure converts a BBAN structure in SWIFT IBAN registry notation (e.g. "4!a6!n8!n")
+// into an anchored regular expression and returns the maximum BBAN length it describes.
+// Supported character types are n (digits), a (upper case letters), c (alphanumerics) and e (space);
+// "!" marks a fixed length, otherwise the length is a maximum.
+func ParseBBANStructure(structure string) (*regexp.Regexp, int, error) {
+	if structure == "" {
+		return nil, 0, fmt.Errorf("BBAN structure cannot be empty")
+	}
+
+	elements := bbanStructureElement.FindAllStringSubmatchIndex(structure, -1)
+
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	total, position := 0, 0
+
+	for _, element := range elements {
+		if element[0] != position {
+			return nil, 0, fmt.Errorf("invalid BBAN structure: %s", structure)
+		}
+		position = element[1]
+
+		count, err := strconv.Atoi(structure[element[2]:element[3]])
+		if err != nil || count <= 0 {
+			return nil, 0, fmt.Errorf("invalid BBAN structure: %s", structure)
+		}
+		fixed := element[5] > element[4]
+
+		var class string
+		switch structure[element[6]:element[7]] {
+		case "n":
+			class = "[0-9]"
+		case "a":
+			class = "[A-Z]"
+		case "c":
+			class = "[A-Z0-9]"
+		case "e":
+			class = " "
+		}
+
+		if fixed {
+			pattern.WriteString(fmt.Sprintf("%s{%d}", class, count))
+		} else {
+			pattern.WriteString(fmt.Sprintf("%s{1,%d}", class, count))
+		}
+		total += count
+	}
+
+	if position != len(structure) {
+		return nil, 0, fmt.Errorf("invalid BBAN structure: %s", structure)
+	}
+
+	pattern.WriteString("$")
+	return regexp.MustCompile(pattern.String()), total, nil
+}
+
+// extractIdentifier returns the substring at the given offset and length, or an empty string when out of range
+func extractIdentifier(bban string, offset, length int) string {
+	if length <= 0 || offset < 0 || offset+length > len(bban) {
+		return ""
+	}
+	return bban[offset : offset+length]
+}
+
+// DefaultIBANFormats returns the IBAN formats seeded when no IBAN formats file is provided
+func DefaultIBANFormats() []*IBANFormat {
+	type definition struct {
+		country      string
+		length       int
+		structure    string
+		bankOffset   int
+		bankLength   int
+		branchOffset int
+		branchLength int
+		sepa         bool
+		example      string
+	}
+
+	definitions := []definition{
+		{"AD", 24, "4!n4!n12!c", 0, 4, 4, 4, true, "AD1200012030200359100100"},
+		{"AE", 23, "3!n16!n", 0, 3, 0, 0, false, "AE070331234567890123456"},
+		{"AT", 20, "5!n11!n", 0, 5, 0, 0, true, "AT611904300234573201"},
+		{"BE", 16, "3!n7!n2!n", 0, 3, 0, 0, true, "BE68539007547034"},
+		{"CH", 21, "5!n12!c", 0, 5, 0, 0, true, "CH9300762011623852957"},
+		{"CZ", 24, "4!n6!n10!n", 0, 4, 0, 0, true, "CZ6508000000192000145399"},
+		{"DE", 22, "8!n10!n", 0, 8, 0, 0, true, "DE89370400440532013000"},
+		{"DK", 18, "4!n9!n1!n", 0, 4, 0, 0, true, "DK5000400440116243"},
+		{"ES", 24, "4!n4!n1!n1!n10!n", 0, 4, 4, 4, true, "ES9121000418450200051332"},
+		{"FI", 18, "3!n11!n", 0, 3, 0, 0, true, "FI2112345600000785"},
+		{"FR", 27, "5!n5!n11!c2!n", 0, 5, 5, 5, true, "FR1420041010050500013M02606"},
+		{"GB", 22, "4!a6!n8!n", 0, 4, 4, 6, true, "GB29NWBK60161331926819"},
+		{"GR", 27, "3!n4!n16!c", 0, 3, 3, 4, true, "GR1601101250000000012300695"},
+		{"IE", 22, "4!a6!n8!n", 0, 4, 4, 6, true, "IE29AIBK93115212345678"},
+		{"IT", 27, "1!a5!n5!n12!c", 1, 5, 6, 5, true, "IT60X0542811101000000123456"},
+		{"LU", 20, "3!n13!c", 0, 3, 0, 0, true, "LU280019400644750000"},
+		{"NL", 18, "4!a10!n", 0, 4, 0, 0, true, "NL91ABNA0417164300"},
+		{"NO", 15, "4!n6!n1!n", 0, 4, 0, 0, true, "NO9386011117947"},
+		{"PL", 28, "8!n16!n", 0, 8, 0, 0, true, "PL61109010140000071219812874"},
+		{"PT", 25, "4!n4!n11!n2!n", 0, 4, 4, 4, true, "PT50000201231234567890154"},
+		{"SA", 24, "2!n18!c", 0, 2, 0, 0, false, "SA0380000000608010167519"},
+		{"SE", 24, "3!n16!n1!n", 0, 3, 0, 0, true, "SE4550000000058398257466"},
+		{"TR", 26, "5!n1!n16!c", 0, 5, 0, 0, false, "TR330006100519786457841326"},
+	}
+
+	formats := make([]*IBANFormat, 0, len(definitions))
+	for _, d := range definitions {
+		format := NewIBANFormat(d.country, d.length, d.structure, d.bankOffset, d.bankLength)
+		format.SetBranchIdentifier(d.branchOffset, d.branchLength)
+		format.SetSEPA(d.sepa)
+		format.SetExample(d.example)
+		formats = append(formats, format)
+	}
+	return formats
+}
@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+func TestNewIBANFormat(t *testing.T) {
+	// When
+	format := NewIBANFormat("de", 22, "8!n10!n", 0, 8)
+
+	// Then
+	assert.NotEqual(t, uuid.Nil, format.ID)
+	assert.Equal(t, "DE", format.CountryCode)
+	assert.Equal(t, 22, format.Length)
+	assert.Equal(t, 18, format.BBANLength())
+	assert.False(t, format.CreatedAt.IsZero())
+	assert.Equal(t, "tm_iban_formats", format.TableName())
+	assert.True(t, format.IsValid())
+}
+
+func TestParseBBANStructure(t *testing.T) {
+	tests := []struct {
+		structure   string
+		length      int
+		matches     []string
+		mismatches  []string
+		expectError bool
+	}{
+		{structure: "8!n10!n", length: 18, matches: []string{"370400440532013000"}, mismatches: []string{"37040044053201300A"}},
+		{structure: "4!a6!n8!n", length: 18, matches: []string{"NWBK60161331926819"}, mismatches: []string{"NWB160161331926819"}},
+		{structure: "5!n5!n11!c2!n", length: 23, matches: []string{"20041010050500013M02606"}},
+		{structure: "3a", length: 3, matches: []string{"AB", "ABC"}, mismatches: []string{"ABCD"}},
+		{structure: "", expectError: true},
+		{structure: "8!x", expectError: true},
+		{structure: "8!n-2!n", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.structure, func(t *testing.T) {
+			pattern, length, err := ParseBBANStructure(tt.structure)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.length, length)
+			for _, value := range tt.matches {
+				assert.True(t, pattern.MatchString(value), value)
+			}
+			for _, value := range tt.mismatches {
+				assert.False(t, pattern.MatchString(value), value)
+			}
+		})
+	}
+}
+
+func TestIBANFormat_Identifiers(t *testing.T) {
+	// Given
+	format := NewIBANFormat("IT", 27, "1!a5!n5!n12!c", 1, 5)
+	format.SetBranchIdentifier(6, 5)
+	bban := "X0542811101000000123456"
+
+	// Then
+	assert.True(t, format.MatchesBBAN(bban))
+	assert.Equal(t, "05428", format.BankIdentifier(bban))
+	assert.Equal(t, "11101", format.BranchIdentifier(bban))
+	assert.False(t, format.MatchesBBAN(bban[1:]))
+}
+
+func TestIBANFormat_IsValid(t *testing.T) {
+	assert.False(t, NewIBANFormat("DEU", 22, "8!n10!n", 0, 8).IsValid())
+	assert.False(t, NewIBANFormat("DE", 23, "8!n10!n", 0, 8).IsValid())
+	assert.False(t, NewIBANFormat("DE", 22, "8!n10!n", 12, 8).IsValid())
+	assert.False(t, NewIBANFormat("DE", 22, "8!n10!n", 0, 0).IsValid())
+
+	format := NewIBANFormat("DE", 22, "8!n10!n", 0, 8)
+	format.SetBranchIdentifier(10, 10)
+	assert.False(t, format.IsValid())
+}
+
+func TestDefaultIBANFormats(t *testing.T) {
+	for _, format := range DefaultIBANFormats() {
+		t.Run(format.CountryCode, func(t *testing.T) {
+			assert.True(t, format.IsValid())
+
+			iban, err := valueobjects.NewIBAN(format.Example)
+			require.NoError(t, err)
+			assert.Equal(t, format.CountryCode, iban.CountryCode())
+			assert.Len(t, iban.Value(), format.Length)
+			assert.True(t, format.MatchesBBAN(iban.BBAN()))
+			assert.NotEmpty(t, format.BankIdentifier(iban.BBAN()))
+		})
+	}
+}
@@ -1,19 +1,67 @@
 package entities
 
 import (
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
-// Language represents a language entity
+// Text directions of a language
+const (
+	LanguageDirectionLTR = "ltr"
+	LanguageDirectionRTL = "rtl"
+)
+
+var (
+	// iso6391Pattern matches an ISO 639-1 language code
+	iso6391Pattern = regexp.MustCompile(`^[a-z]{2}$`)
+	// iso6392Pattern matches an ISO 639-2 or ISO 639-3 language code
+	iso6392Pattern = regexp.MustCompile(`^[a-z]{3}$`)
+	// scriptPattern matches an ISO 15924 script code
+	scriptPattern = regexp.MustCompile(`^[A-Z][a-z]{3}$`)
+)
+
+// rtlScripts are the ISO 15924 scripts written from right to left
+var rtlScripts = map[string]bool{
+	"Adlm": true, "Arab": true, "Hebr": true, "Mand": true, "Mend": true, "Nkoo": true,
+	"Rohg": true, "Samr": true, "Syrc": true, "Thaa": true, "Yezi": true,
+}
+
+// LanguageListSchema is the whitelist of the fields language lists can be filtered and sorted on
+var LanguageListSchema = valueobjects.ListSchema{
+	"name":        {Type: valueobjects.FieldTypeString, Sortable: true},
+	"native_name": {Type: valueobjects.FieldTypeString, Sortable: true},
+	"code":        {Type: valueobjects.FieldTypeString, Sortable: true},
+	"iso639_1":    {Type: valueobjects.FieldTypeString, Sortable: true},
+	"iso639_2t":   {Type: valueobjects.FieldTypeString, Sortable: true},
+	"iso639_2b":   {Type: valueobjects.FieldTypeString, Sortable: true},
+	"iso639_3":    {Type: valueobjects.FieldTypeString, Sortable: true},
+	"script":      {Type: valueobjects.FieldTypeString, Operators: []valueobjects.FilterOperator{valueobjects.FilterEq, valueobjects.FilterNe, valueobjects.FilterIn, valueobjects.FilterNull}, Sortable: true},
+	"direction":   {Type: valueobjects.FieldTypeString, Operators: []valueobjects.FilterOperator{valueobjects.FilterEq, valueobjects.FilterNe}, Sortable: true},
+	"is_active":   {Type: valueobjects.FieldTypeBool, Sortable: true},
+	"created_at":  {Type: valueobjects.FieldTypeDate, Sortable: true},
+	"updated_at":  {Type: valueobjects.FieldTypeDate, Sortable: true},
+}
+
+// Language represents a language entity. Code is the code the language is known by in this API, usually
+// its ISO 639-1 code; the ISO 639 codes identify it in the other code sets.
 type Language struct {
-	ID        uuid.UUID `json:"id" db:"id"`
-	Name      string    `json:"name" db:"name"`
-	Code      string    `json:"code" db:"code"`
-	IsActive  bool      `json:"is_active" db:"is_active"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	ID         uuid.UUID `json:"id" db:"id"`
+	Name       string    `json:"name" db:"name"`
+	NativeName *string   `json:"native_name,omitempty" db:"native_name"`
+	Code       string    `json:"code" db:"code"`
+	ISO6391    *string   `json:"iso639_1,omitempty" db:"iso639_1"`
+	ISO6392T   *string   `json:"iso639_2t,omitempty" db:"iso639_2t"`
+	ISO6392B   *string   `json:"iso639_2b,omitempty" db:"iso639_2b"`
+	ISO6393    *string   `json:"iso639_3,omitempty" db:"iso639_3"`
+	Script     *string   `json:"script,omitempty" db:"script"`
+	Direction  string    `json:"direction" db:"direction"`
+	IsActive   bool      `json:"is_active" db:"is_active"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // TableName returns the table name for the Language entity
@@ -34,6 +82,7 @@ func NewLanguage(name, code string) *Language {
 		ID:        uuid.New(),
 		Name:      name,
 		Code:      code,
+		Direction: LanguageDirectionLTR,
 		IsActive:  true,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -46,12 +95,74 @@ func (l *Language) SetName(name string) {
 	l.UpdatedAt = time.Now()
 }
 
+// SetNativeName sets the name of the language in the language itself
+func (l *Language) SetNativeName(nativeName string) {
+	l.NativeName = optionalString(nativeName)
+	l.UpdatedAt = time.Now()
+}
+
 // SetCode sets the code of the language
 func (l *Language) SetCode(code string) {
 	l.Code = code
 	l.UpdatedAt = time.Now()
 }
 
+// SetISOCodes sets the ISO 639-1, ISO 639-2/T, ISO 639-2/B and ISO 639-3 codes of the language; empty codes
+// are cleared. The bibliographic code defaults to the terminology code, as they only differ for a few languages.
+func (l *Language) SetISOCodes(iso6391, iso6392T, iso6392B, iso6393 string) {
+	iso6392T = strings.ToLower(strings.TrimSpace(iso6392T))
+	iso6392B = strings.ToLower(strings.TrimSpace(iso6392B))
+	if iso6392B == "" {
+		iso6392B = iso6392T
+	}
+
+	l.ISO6391 = optionalString(strings.ToLower(strings.TrimSpace(iso6391)))
+	l.ISO6392T = optionalString(iso6392T)
+	l.ISO6392B = optionalString(iso6392B)
+	l.ISO6393 = optionalString(strings.ToLower(strings.TrimSpace(iso6393)))
+	l.UpdatedAt = time.Now()
+}
+
+// SetScript sets the default ISO 15924 script of the language, e.g. Latn or Arab
+func (l *Language) SetScript(script string) {
+	script = strings.TrimSpace(script)
+	if len(script) == 4 {
+		script = strings.ToUpper(script[:1]) + strings.ToLower(script[1:])
+	}
+	l.Script = optionalString(script)
+	l.UpdatedAt = time.Now()
+}
+
+// SetDirection sets the text direction of the language, ltr or rtl. An empty direction is derived from the script.
+func (l *Language) SetDirection(direction string) {
+	direction = strings.ToLower(strings.TrimSpace(direction))
+	if direction == "" {
+		direction = LanguageDirectionLTR
+		if l.Script != nil && rtlScripts[*l.Script] {
+			direction = LanguageDirectionRTL
+		}
+	}
+	l.Direction = direction
+	l.UpdatedAt = time.Now()
+}
+
+// HasCode checks if the code matches the code or any ISO 639 code of the language, ignoring case
+func (l *Language) HasCode(code string) bool {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if code == "" {
+		return false
+	}
+	if strings.ToLower(l.Code) == code {
+		return true
+	}
+	for _, isoCode := range []*string{l.ISO6391, l.ISO6392T, l.ISO6392B, l.ISO6393} {
+		if isoCode != nil && *isoCode == code {
+			return true
+		}
+	}
+	return false
+}
+
 // Activate activates the language
 func (l *Language) Activate() {
 	l.IsActive = true
@@ -66,5 +177,27 @@ func (l *Language) Deactivate() {
 
 // IsValid validates the language entity
 func (l *Language) IsValid() bool {
-	return l.Name != "" && l.Code != "" && len(l.Code) <= 10
+	if l.Name == "" || l.Code == "" || len(l.Code) > 10 {
+		return false
+	}
+	if l.ISO6391 != nil && !iso6391Pattern.MatchString(*l.ISO6391) {
+		return false
+	}
+	for _, isoCode := range []*string{l.ISO6392T, l.ISO6392B, l.ISO6393} {
+		if isoCode != nil && !iso6392Pattern.MatchString(*isoCode) {
+			return false
+		}
+	}
+	if l.Script != nil && !scriptPattern.MatchString(*l.Script) {
+		return false
+	}
+	return l.Direction == "" || l.Direction == LanguageDirectionLTR || l.Direction == LanguageDirectionRTL
+}
+
+// optionalString returns nil for an empty string and a pointer to the string otherwise
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
 }
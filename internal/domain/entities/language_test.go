@@ -21,6 +21,7 @@ func TestNewLanguage(t *testing.T) {
 	assert.Equal(t, name, language.Name)
 	assert.Equal(t, code, language.Code)
 	assert.True(t, language.IsActive)
+	assert.Equal(t, LanguageDirectionLTR, language.Direction)
 	assert.NotEqual(t, uuid.Nil, language.ID)
 	assert.False(t, language.CreatedAt.IsZero())
 	assert.False(t, language.UpdatedAt.IsZero())
@@ -83,6 +84,90 @@ func TestLanguage_SetCode(t *testing.T) {
 	assert.True(t, language.UpdatedAt.After(originalTime))
 }
 
+func TestLanguage_SetISOCodes(t *testing.T) {
+	t.Run("normalizes codes", func(t *testing.T) {
+		// Given
+		language := NewLanguage("German", "de")
+
+		// When
+		language.SetISOCodes(" DE ", "DEU", "ger", "deu")
+
+		// Then
+		assert.Equal(t, "de", *language.ISO6391)
+		assert.Equal(t, "deu", *language.ISO6392T)
+		assert.Equal(t, "ger", *language.ISO6392B)
+		assert.Equal(t, "deu", *language.ISO6393)
+	})
+
+	t.Run("bibliographic code defaults to terminology code", func(t *testing.T) {
+		// Given
+		language := NewLanguage("Indonesian", "id")
+
+		// When
+		language.SetISOCodes("id", "ind", "", "ind")
+
+		// Then
+		assert.Equal(t, "ind", *language.ISO6392B)
+	})
+
+	t.Run("empty codes are cleared", func(t *testing.T) {
+		// Given
+		language := NewLanguage("Hawaiian", "haw")
+		language.SetISOCodes("en", "eng", "eng", "eng")
+
+		// When
+		language.SetISOCodes("", "haw", "", "haw")
+
+		// Then
+		assert.Nil(t, language.ISO6391)
+		assert.Equal(t, "haw", *language.ISO6392B)
+	})
+}
+
+func TestLanguage_SetScriptAndDirection(t *testing.T) {
+	tests := []struct {
+		name              string
+		script            string
+		direction         string
+		expectedScript    *string
+		expectedDirection string
+	}{
+		{name: "latin script is left to right", script: "latn", expectedScript: optionalString("Latn"), expectedDirection: LanguageDirectionLTR},
+		{name: "arabic script is right to left", script: "Arab", expectedScript: optionalString("Arab"), expectedDirection: LanguageDirectionRTL},
+		{name: "hebrew script is right to left", script: "HEBR", expectedScript: optionalString("Hebr"), expectedDirection: LanguageDirectionRTL},
+		{name: "explicit direction wins", script: "Arab", direction: "LTR", expectedScript: optionalString("Arab"), expectedDirection: LanguageDirectionLTR},
+		{name: "no script", expectedDirection: LanguageDirectionLTR},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			language := NewLanguage("Name", "xx")
+
+			// When
+			language.SetScript(tt.script)
+			language.SetDirection(tt.direction)
+
+			// Then
+			assert.Equal(t, tt.expectedScript, language.Script)
+			assert.Equal(t, tt.expectedDirection, language.Direction)
+		})
+	}
+}
+
+func TestLanguage_HasCode(t *testing.T) {
+	// Given
+	language := NewLanguage("German", "de")
+	language.SetISOCodes("de", "deu", "ger", "deu")
+
+	// Then
+	assert.True(t, language.HasCode("de"))
+	assert.True(t, language.HasCode("DEU"))
+	assert.True(t, language.HasCode("ger"))
+	assert.False(t, language.HasCode("en"))
+	assert.False(t, language.HasCode(""))
+}
+
 func TestLanguage_Activate(t *testing.T) {
 	// Given
 	language := NewLanguage("Name", "code")
@@ -182,6 +267,36 @@ func TestLanguage_IsValid(t *testing.T) {
 	}
 }
 
+func TestLanguage_IsValid_ISOFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(language *Language)
+		expected bool
+	}{
+		{name: "valid codes and script", modify: func(l *Language) { l.SetISOCodes("de", "deu", "ger", "deu"); l.SetScript("Latn") }, expected: true},
+		{name: "three letter ISO 639-1 code", modify: func(l *Language) { l.SetISOCodes("deu", "", "", "") }, expected: false},
+		{name: "two letter ISO 639-3 code", modify: func(l *Language) { l.SetISOCodes("", "", "", "de") }, expected: false},
+		{name: "numeric ISO 639-2 code", modify: func(l *Language) { l.SetISOCodes("", "123", "", "") }, expected: false},
+		{name: "invalid script", modify: func(l *Language) { l.SetScript("Latin") }, expected: false},
+		{name: "invalid direction", modify: func(l *Language) { l.SetDirection("ttb") }, expected: false},
+		{name: "right to left", modify: func(l *Language) { l.SetDirection("rtl") }, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			language := NewLanguage("German", "de")
+			tt.modify(language)
+
+			// When
+			isValid := language.IsValid()
+
+			// Then
+			assert.Equal(t, tt.expected, isValid)
+		})
+	}
+}
+
 func TestLanguage_TableName(t *testing.T) {
 	// Given
 	language := &Language{}
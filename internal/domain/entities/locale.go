@@ -0,0 +1,141 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// Days of the week as numbered by ISO 8601, used for the first day of the week of a locale
+const (
+	WeekdayMonday   = 1
+	WeekdaySaturday = 6
+	WeekdaySunday   = 7
+)
+
+// Locale combines a language and a COUNTRY geodirectory into the conventions of a BCP 47 tag such as id-ID:
+// display names, the first day of the week, CLDR date and time patterns, number separators and the default
+// currency.
+type Locale struct {
+	ID               uuid.UUID  `json:"id" db:"id"`
+	Tag              string     `json:"tag" db:"tag"`
+	LanguageID       uuid.UUID  `json:"language_id" db:"language_id"`
+	CountryID        uuid.UUID  `json:"country_id" db:"country_id"`
+	CurrencyID       *uuid.UUID `json:"currency_id,omitempty" db:"currency_id"`
+	Name             string     `json:"name" db:"name"`
+	NativeName       *string    `json:"native_name,omitempty" db:"native_name"`
+	FirstDayOfWeek   int        `json:"first_day_of_week" db:"first_day_of_week"`
+	DatePattern      string     `json:"date_pattern" db:"date_pattern"`
+	TimePattern      string     `json:"time_pattern" db:"time_pattern"`
+	DecimalSeparator string     `json:"decimal_separator" db:"decimal_separator"`
+	GroupSeparator   string     `json:"group_separator" db:"group_separator"`
+	IsActive         bool       `json:"is_active" db:"is_active"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+
+	// Relations (not stored in DB, populated when needed)
+	Language *Language     `json:"language,omitempty"`
+	Country  *Geodirectory `json:"country,omitempty"`
+	Currency *Currency     `json:"currency,omitempty"`
+}
+
+// TableName returns the table name for the Locale entity
+func (l *Locale) TableName() string {
+	return "tm_locales"
+}
+
+// GenerateID generates a new UUID for the locale if not set
+func (l *Locale) GenerateID() {
+	if l.ID == uuid.Nil {
+		l.ID = uuid.New()
+	}
+}
+
+// NewLocale creates a new Locale instance with ISO 8601 conventions: weeks start on Monday, dates are y-MM-dd,
+// times HH:mm and numbers use a decimal point and comma grouping
+func NewLocale(tag string, languageID, countryID uuid.UUID, name string) *Locale {
+	return &Locale{
+		ID:               uuid.New(),
+		Tag:              tag,
+		LanguageID:       languageID,
+		CountryID:        countryID,
+		Name:             name,
+		FirstDayOfWeek:   WeekdayMonday,
+		DatePattern:      "y-MM-dd",
+		TimePattern:      "HH:mm",
+		DecimalSeparator: ".",
+		GroupSeparator:   ",",
+		IsActive:         true,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+}
+
+// SetDisplayNames sets the English name of the locale and its name in its own language
+func (l *Locale) SetDisplayNames(name, nativeName string) {
+	l.Name = name
+	l.NativeName = optionalString(nativeName)
+	l.UpdatedAt = time.Now()
+}
+
+// SetFirstDayOfWeek sets the first day of the week, numbered as in ISO 8601 from 1 (Monday) to 7 (Sunday)
+func (l *Locale) SetFirstDayOfWeek(day int) {
+	l.FirstDayOfWeek = day
+	l.UpdatedAt = time.Now()
+}
+
+// SetPatterns sets the CLDR date and time patterns of the locale, e.g. dd/MM/y and HH.mm
+func (l *Locale) SetPatterns(datePattern, timePattern string) {
+	l.DatePattern = datePattern
+	l.TimePattern = timePattern
+	l.UpdatedAt = time.Now()
+}
+
+// SetSeparators sets the decimal and grouping separators of numbers
+func (l *Locale) SetSeparators(decimalSeparator, groupSeparator string) {
+	l.DecimalSeparator = decimalSeparator
+	l.GroupSeparator = groupSeparator
+	l.UpdatedAt = time.Now()
+}
+
+// SetDefaultCurrency sets the default currency of the locale; nil clears it
+func (l *Locale) SetDefaultCurrency(currencyID *uuid.UUID) {
+	l.CurrencyID = currencyID
+	l.UpdatedAt = time.Now()
+}
+
+// FirstWeekday returns the first day of the week as a time.Weekday
+func (l *Locale) FirstWeekday() time.Weekday {
+	return time.Weekday(l.FirstDayOfWeek % 7)
+}
+
+// Activate activates the locale
+func (l *Locale) Activate() {
+	l.IsActive = true
+	l.UpdatedAt = time.Now()
+}
+
+// Deactivate deactivates the locale
+func (l *Locale) Deactivate() {
+	l.IsActive = false
+	l.UpdatedAt = time.Now()
+}
+
+// IsValid validates the locale entity
+func (l *Locale) IsValid() bool {
+	tag, err := valueobjects.NormalizeLanguageTag(l.Tag)
+	if err != nil || tag != l.Tag || len(l.Tag) > 35 {
+		return false
+	}
+	if l.LanguageID == uuid.Nil || l.CountryID == uuid.Nil || l.Name == "" {
+		return false
+	}
+	if l.FirstDayOfWeek < WeekdayMonday || l.FirstDayOfWeek > WeekdaySunday {
+		return false
+	}
+	if l.DatePattern == "" || l.TimePattern == "" {
+		return false
+	}
+	return l.DecimalSeparator != "" && l.GroupSeparator != "" && l.DecimalSeparator != l.GroupSeparator
+}
@@ -0,0 +1,109 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLocale(t *testing.T) {
+	// Given
+	languageID := uuid.New()
+	countryID := uuid.New()
+
+	// When
+	locale := NewLocale("id-ID", languageID, countryID, "Indonesian (Indonesia)")
+
+	// Then
+	assert.NotEqual(t, uuid.Nil, locale.ID)
+	assert.Equal(t, "id-ID", locale.Tag)
+	assert.Equal(t, languageID, locale.LanguageID)
+	assert.Equal(t, countryID, locale.CountryID)
+	assert.Equal(t, WeekdayMonday, locale.FirstDayOfWeek)
+	assert.Equal(t, ".", locale.DecimalSeparator)
+	assert.Equal(t, ",", locale.GroupSeparator)
+	assert.True(t, locale.IsActive)
+	assert.True(t, locale.IsValid())
+}
+
+func TestLocale_Setters(t *testing.T) {
+	// Given
+	locale := NewLocale("id-ID", uuid.New(), uuid.New(), "Indonesian")
+	currencyID := uuid.New()
+	originalTime := locale.UpdatedAt
+
+	time.Sleep(1 * time.Millisecond)
+
+	// When
+	locale.SetDisplayNames("Indonesian (Indonesia)", "Indonesia (Indonesia)")
+	locale.SetFirstDayOfWeek(WeekdaySunday)
+	locale.SetPatterns("dd/MM/yy", "HH.mm")
+	locale.SetSeparators(",", ".")
+	locale.SetDefaultCurrency(&currencyID)
+
+	// Then
+	assert.Equal(t, "Indonesian (Indonesia)", locale.Name)
+	assert.Equal(t, "Indonesia (Indonesia)", *locale.NativeName)
+	assert.Equal(t, time.Sunday, locale.FirstWeekday())
+	assert.Equal(t, "dd/MM/yy", locale.DatePattern)
+	assert.Equal(t, "HH.mm", locale.TimePattern)
+	assert.Equal(t, ",", locale.DecimalSeparator)
+	assert.Equal(t, ".", locale.GroupSeparator)
+	assert.Equal(t, &currencyID, locale.CurrencyID)
+	assert.True(t, locale.UpdatedAt.After(originalTime))
+
+	// When the native name is cleared
+	locale.SetDisplayNames("Indonesian (Indonesia)", "")
+
+	// Then
+	assert.Nil(t, locale.NativeName)
+}
+
+func TestLocale_FirstWeekday(t *testing.T) {
+	locale := NewLocale("en-GB", uuid.New(), uuid.New(), "English (United Kingdom)")
+	assert.Equal(t, time.Monday, locale.FirstWeekday())
+
+	locale.SetFirstDayOfWeek(WeekdaySaturday)
+	assert.Equal(t, time.Saturday, locale.FirstWeekday())
+}
+
+func TestLocale_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(locale *Locale)
+		expected bool
+	}{
+		{name: "valid locale", modify: func(l *Locale) {}, expected: true},
+		{name: "tag with script", modify: func(l *Locale) { l.Tag = "zh-Hant-TW" }, expected: true},
+		{name: "tag not normalized", modify: func(l *Locale) { l.Tag = "en-us" }, expected: false},
+		{name: "invalid tag", modify: func(l *Locale) { l.Tag = "english-" }, expected: false},
+		{name: "missing language", modify: func(l *Locale) { l.LanguageID = uuid.Nil }, expected: false},
+		{name: "missing country", modify: func(l *Locale) { l.CountryID = uuid.Nil }, expected: false},
+		{name: "missing name", modify: func(l *Locale) { l.Name = "" }, expected: false},
+		{name: "first day out of range", modify: func(l *Locale) { l.FirstDayOfWeek = 0 }, expected: false},
+		{name: "missing date pattern", modify: func(l *Locale) { l.DatePattern = "" }, expected: false},
+		{name: "same separators", modify: func(l *Locale) { l.GroupSeparator = "." }, expected: false},
+		{name: "missing group separator", modify: func(l *Locale) { l.GroupSeparator = "" }, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			locale := NewLocale("en-US", uuid.New(), uuid.New(), "English (United States)")
+			tt.modify(locale)
+
+			// When
+			isValid := locale.IsValid()
+
+			// Then
+			assert.Equal(t, tt.expected, isValid)
+		})
+	}
+}
+
+func TestLocale_TableName(t *testing.T) {
+	locale := &Locale{}
+	assert.Equal(t, "tm_locales", locale.TableName())
+}
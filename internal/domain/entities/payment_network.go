@@ -0,0 +1,171 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Payment network types
+const (
+	PaymentNetworkTypeRTGS       = "rtgs"
+	PaymentNetworkTypeClearing   = "clearing"
+	PaymentNetworkTypeInstant    = "instant"
+	PaymentNetworkTypeCardSwitch = "card_switch"
+)
+
+// Bank network membership statuses
+const (
+	MembershipStatusActive     = "active"
+	MembershipStatusSuspended  = "suspended"
+	MembershipStatusTerminated = "terminated"
+)
+
+// PaymentNetwork represents a clearing or payment network banks participate in, e.g. RTGS, SKN or BI-FAST
+type PaymentNetwork struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	Code        string    `json:"code" db:"code"`
+	Name        string    `json:"name" db:"name"`
+	Type        string    `json:"type" db:"type"`
+	CountryCode string    `json:"country_code" db:"country_code"`
+	Operator    string    `json:"operator" db:"operator"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// TableName returns the table name for the PaymentNetwork entity
+func (n *PaymentNetwork) TableName() string {
+	return "tm_payment_networks"
+}
+
+// GenerateID generates a new UUID for the payment network if not set
+func (n *PaymentNetwork) GenerateID() {
+	if n.ID == uuid.Nil {
+		n.ID = uuid.New()
+	}
+}
+
+// NewPaymentNetwork creates a new PaymentNetwork instance
+func NewPaymentNetwork(code, name, networkType, countryCode, operator string) *PaymentNetwork {
+	return &PaymentNetwork{
+		ID:          uuid.New(),
+		Code:        strings.ToUpper(code),
+		Name:        name,
+		Type:        strings.ToLower(networkType),
+		CountryCode: strings.ToUpper(countryCode),
+		Operator:    operator,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// IsValid checks if the payment network has valid data
+func (n *PaymentNetwork) IsValid() bool {
+	if n.Code == "" || n.Name == "" || len(n.CountryCode) != 2 {
+		return false
+	}
+
+	switch n.Type {
+	case PaymentNetworkTypeRTGS, PaymentNetworkTypeClearing, PaymentNetworkTypeInstant, PaymentNetworkTypeCardSwitch:
+		return true
+	default:
+		return false
+	}
+}
+
+// DefaultPaymentNetworks returns the Indonesian payment networks seeded when no payment networks file is present
+func DefaultPaymentNetworks() []*PaymentNetwork {
+	return []*PaymentNetwork{
+		NewPaymentNetwork("RTGS", "Bank Indonesia Real Time Gross Settlement", PaymentNetworkTypeRTGS, "ID", "Bank Indonesia"),
+		NewPaymentNetwork("SKN", "Sistem Kliring Nasional Bank Indonesia", PaymentNetworkTypeClearing, "ID", "Bank Indonesia"),
+		NewPaymentNetwork("BI-FAST", "Bank Indonesia Fast Payment", PaymentNetworkTypeInstant, "ID", "Bank Indonesia"),
+		NewPaymentNetwork("ATM-BERSAMA", "ATM Bersama", PaymentNetworkTypeCardSwitch, "ID", "PT Artajasa Pembayaran Elektronis"),
+		NewPaymentNetwork("PRIMA", "Jaringan PRIMA", PaymentNetworkTypeCardSwitch, "ID", "PT Rintis Sejahtera"),
+		NewPaymentNetwork("ALTO", "ALTO", PaymentNetworkTypeCardSwitch, "ID", "PT Alto Network"),
+		NewPaymentNetwork("JALIN", "Jalin", PaymentNetworkTypeCardSwitch, "ID", "PT Jalin Pembayaran Nusantara"),
+	}
+}
+
+// BankNetworkMembership represents the participation of a bank in a payment network under a network specific member code
+type BankNetworkMembership struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	BankID         uuid.UUID  `json:"bank_id" db:"bank_id"`
+	NetworkCode    string     `json:"network_code" db:"network_code"`
+	MemberCode     string     `json:"member_code" db:"member_code"`
+	Status         string     `json:"status" db:"status"`
+	EffectiveFrom  *time.Time `json:"effective_from,omitempty" db:"effective_from"`
+	EffectiveUntil *time.Time `json:"effective_until,omitempty" db:"effective_until"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// TableName returns the table name for the BankNetworkMembership entity
+func (m *BankNetworkMembership) TableName() string {
+	return "tm_bank_network_memberships"
+}
+
+// GenerateID generates a new UUID for the membership if not set
+func (m *BankNetworkMembership) GenerateID() {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+}
+
+// NewBankNetworkMembership creates a new active BankNetworkMembership instance
+func NewBankNetworkMembership(bankID uuid.UUID, networkCode, memberCode string) *BankNetworkMembership {
+	return &BankNetworkMembership{
+		ID:          uuid.New(),
+		BankID:      bankID,
+		NetworkCode: strings.ToUpper(networkCode),
+		MemberCode:  memberCode,
+		Status:      MembershipStatusActive,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// SetStatus sets the status of the membership
+func (m *BankNetworkMembership) SetStatus(status string) {
+	m.Status = strings.ToLower(status)
+	m.UpdatedAt = time.Now()
+}
+
+// SetEffectivePeriod sets the dates the membership is effective from and until; nil leaves the period open
+func (m *BankNetworkMembership) SetEffectivePeriod(from, until *time.Time) {
+	m.EffectiveFrom = from
+	m.EffectiveUntil = until
+	m.UpdatedAt = time.Now()
+}
+
+// IsEffective checks if the membership is active at the given time
+func (m *BankNetworkMembership) IsEffective(at time.Time) bool {
+	if m.Status != MembershipStatusActive {
+		return false
+	}
+	if m.EffectiveFrom != nil && at.Before(*m.EffectiveFrom) {
+		return false
+	}
+	if m.EffectiveUntil != nil && !at.Before(*m.EffectiveUntil) {
+		return false
+	}
+	return true
+}
+
+// IsValid checks if the membership has valid data
+func (m *BankNetworkMembership) IsValid() bool {
+	if m.BankID == uuid.Nil || m.NetworkCode == "" || m.MemberCode == "" {
+		return false
+	}
+
+	if m.EffectiveFrom != nil && m.EffectiveUntil != nil && !m.EffectiveUntil.After(*m.EffectiveFrom) {
+		return false
+	}
+
+	switch m.Status {
+	case MembershipStatusActive, MembershipStatusSuspended, MembershipStatusTerminated:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPaymentNetwork(t *testing.T) {
+	// When
+	network := NewPaymentNetwork("bi-fast", "Bank Indonesia Fast Payment", "INSTANT", "id", "Bank Indonesia")
+
+	// Then
+	assert.NotEqual(t, uuid.Nil, network.ID)
+	assert.Equal(t, "BI-FAST", network.Code)
+	assert.Equal(t, PaymentNetworkTypeInstant, network.Type)
+	assert.Equal(t, "ID", network.CountryCode)
+	assert.Equal(t, "tm_payment_networks", network.TableName())
+	assert.True(t, network.IsValid())
+}
+
+func TestPaymentNetwork_IsValid(t *testing.T) {
+	assert.False(t, NewPaymentNetwork("", "Name", PaymentNetworkTypeRTGS, "ID", "").IsValid())
+	assert.False(t, NewPaymentNetwork("RTGS", "", PaymentNetworkTypeRTGS, "ID", "").IsValid())
+	assert.False(t, NewPaymentNetwork("RTGS", "Name", "wire", "ID", "").IsValid())
+	assert.False(t, NewPaymentNetwork("RTGS", "Name", PaymentNetworkTypeRTGS, "IDN", "").IsValid())
+}
+
+func TestDefaultPaymentNetworks(t *testing.T) {
+	codes := make(map[string]bool)
+	for _, network := range DefaultPaymentNetworks() {
+		assert.True(t, network.IsValid(), network.Code)
+		assert.False(t, codes[network.Code], "duplicate network %s", network.Code)
+		codes[network.Code] = true
+	}
+
+	assert.True(t, codes["RTGS"])
+	assert.True(t, codes["SKN"])
+	assert.True(t, codes["BI-FAST"])
+}
+
+func TestBankNetworkMembership_IsEffective(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	past := now.AddDate(-1, 0, 0)
+	future := now.AddDate(1, 0, 0)
+
+	tests := []struct {
+		name     string
+		status   string
+		from     *time.Time
+		until    *time.Time
+		expected bool
+	}{
+		{"open ended", MembershipStatusActive, nil, nil, true},
+		{"within period", MembershipStatusActive, &past, &future, true},
+		{"not yet effective", MembershipStatusActive, &future, nil, false},
+		{"ended", MembershipStatusActive, nil, &past, false},
+		{"ends today", MembershipStatusActive, &past, &now, false},
+		{"suspended", MembershipStatusSuspended, nil, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			membership := NewBankNetworkMembership(uuid.New(), "RTGS", "CENAIDJA")
+			membership.SetStatus(tt.status)
+			membership.SetEffectivePeriod(tt.from, tt.until)
+
+			assert.Equal(t, tt.expected, membership.IsEffective(now))
+		})
+	}
+}
+
+func TestBankNetworkMembership_IsValid(t *testing.T) {
+	membership := NewBankNetworkMembership(uuid.New(), "skn", "0140397")
+	assert.Equal(t, "SKN", membership.NetworkCode)
+	assert.Equal(t, MembershipStatusActive, membership.Status)
+	assert.Equal(t, "tm_bank_network_memberships", membership.TableName())
+	assert.True(t, membership.IsValid())
+
+	membership.SetStatus("unknown")
+	assert.False(t, membership.IsValid())
+
+	reversed := NewBankNetworkMembership(uuid.New(), "SKN", "0140397")
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := from.AddDate(0, 0, -1)
+	reversed.SetEffectivePeriod(&from, &until)
+	assert.False(t, reversed.IsValid())
+
+	assert.False(t, NewBankNetworkMembership(uuid.Nil, "SKN", "0140397").IsValid())
+	assert.False(t, NewBankNetworkMembership(uuid.New(), "SKN", "").IsValid())
+}
@@ -0,0 +1,173 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// Entity types that have translatable fields
+const (
+	TranslationEntityBank         = "bank"
+	TranslationEntityCurrency     = "currency"
+	TranslationEntityLanguage     = "language"
+	TranslationEntityGeodirectory = "geodirectory"
+)
+
+// TranslatableFields lists the fields of each entity type that can be translated
+var TranslatableFields = map[string][]string{
+	TranslationEntityBank:         {"name", "alias", "company"},
+	TranslationEntityCurrency:     {"name"},
+	TranslationEntityLanguage:     {"name"},
+	TranslationEntityGeodirectory: {"name"},
+}
+
+// TranslationListSchema is the whitelist of the fields translation lists can be filtered and sorted on
+var TranslationListSchema = valueobjects.ListSchema{
+	"entity_type":   {Type: valueobjects.FieldTypeString, Operators: []valueobjects.FilterOperator{valueobjects.FilterEq, valueobjects.FilterIn}, Sortable: true},
+	"entity_id":     {Type: valueobjects.FieldTypeUUID},
+	"field":         {Type: valueobjects.FieldTypeString, Operators: []valueobjects.FilterOperator{valueobjects.FilterEq, valueobjects.FilterIn}, Sortable: true},
+	"language_code": {Type: valueobjects.FieldTypeString, Operators: []valueobjects.FilterOperator{valueobjects.FilterEq, valueobjects.FilterIn}, Sortable: true},
+	"value":         {Type: valueobjects.FieldTypeString, Operators: []valueobjects.FilterOperator{valueobjects.FilterEq, valueobjects.FilterLike}},
+	"updated_at":    {Type: valueobjects.FieldTypeDate, Sortable: true},
+}
+
+// Translatable is implemented by entities whose fields can be localized with translations
+type Translatable interface {
+	// TranslationKey returns the entity type and ID the translations of the entity are stored under
+	TranslationKey() (entityType string, entityID uuid.UUID)
+	// ApplyTranslation replaces the value of a translatable field; unknown fields are ignored
+	ApplyTranslation(field, value string)
+}
+
+// TranslationKey identifies a translation: a field of an entity in a language
+type TranslationKey struct {
+	EntityType   string    `json:"entity_type"`
+	EntityID     uuid.UUID `json:"entity_id"`
+	Field        string    `json:"field"`
+	LanguageCode string    `json:"language_code"`
+}
+
+// Translation represents the value of a translatable field of an entity in a language of tm_languages
+type Translation struct {
+	ID           uuid.UUID `json:"id" db:"id"`
+	EntityType   string    `json:"entity_type" db:"entity_type"`
+	EntityID     uuid.UUID `json:"entity_id" db:"entity_id"`
+	Field        string    `json:"field" db:"field"`
+	LanguageCode string    `json:"language_code" db:"language_code"`
+	Value        string    `json:"value" db:"value"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// TableName returns the table name for the Translation entity
+func (t *Translation) TableName() string {
+	return "tm_translations"
+}
+
+// GenerateID generates a new UUID for the translation if not set
+func (t *Translation) GenerateID() {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+}
+
+// NewTranslation creates a new Translation instance
+func NewTranslation(entityType string, entityID uuid.UUID, field, languageCode, value string) *Translation {
+	return &Translation{
+		ID:           uuid.New(),
+		EntityType:   entityType,
+		EntityID:     entityID,
+		Field:        field,
+		LanguageCode: languageCode,
+		Value:        value,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// SetValue sets the translated value
+func (t *Translation) SetValue(value string) {
+	t.Value = value
+	t.UpdatedAt = time.Now()
+}
+
+// Key returns the key identifying the translation
+func (t *Translation) Key() TranslationKey {
+	return TranslationKey{EntityType: t.EntityType, EntityID: t.EntityID, Field: t.Field, LanguageCode: t.LanguageCode}
+}
+
+// IsValid validates the translation entity
+func (t *Translation) IsValid() bool {
+	return t.Key().IsValid() && t.Value != ""
+}
+
+// IsValid validates that the key names a translatable field of an entity and a language code
+func (k TranslationKey) IsValid() bool {
+	return IsTranslatableField(k.EntityType, k.Field) && k.EntityID != uuid.Nil &&
+		k.LanguageCode != "" && len(k.LanguageCode) <= 10
+}
+
+// IsTranslatableField checks if the field of the entity type can be translated
+func IsTranslatableField(entityType, field string) bool {
+	for _, translatable := range TranslatableFields[entityType] {
+		if translatable == field {
+			return true
+		}
+	}
+	return false
+}
+
+// TranslationKey returns the translation key of the bank
+func (b *Bank) TranslationKey() (string, uuid.UUID) {
+	return TranslationEntityBank, b.ID
+}
+
+// ApplyTranslation replaces the name, alias or company of the bank with its translation
+func (b *Bank) ApplyTranslation(field, value string) {
+	switch field {
+	case "name":
+		b.Name = value
+	case "alias":
+		b.Alias = value
+	case "company":
+		b.Company = value
+	}
+}
+
+// TranslationKey returns the translation key of the currency
+func (c *Currency) TranslationKey() (string, uuid.UUID) {
+	return TranslationEntityCurrency, c.ID
+}
+
+// ApplyTranslation replaces the name of the currency with its translation
+func (c *Currency) ApplyTranslation(field, value string) {
+	if field == "name" {
+		c.Name = value
+	}
+}
+
+// TranslationKey returns the translation key of the language
+func (l *Language) TranslationKey() (string, uuid.UUID) {
+	return TranslationEntityLanguage, l.ID
+}
+
+// ApplyTranslation replaces the name of the language with its translation
+func (l *Language) ApplyTranslation(field, value string) {
+	if field == "name" {
+		l.Name = value
+	}
+}
+
+// TranslationKey returns the translation key of the geodirectory
+func (g *Geodirectory) TranslationKey() (string, uuid.UUID) {
+	return TranslationEntityGeodirectory, g.ID
+}
+
+// ApplyTranslation replaces the name of the geodirectory with its translation
+func (g *Geodirectory) ApplyTranslation(field, value string) {
+	if field == "name" {
+		g.Name = value
+	}
+}
@@ -0,0 +1,115 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTranslation(t *testing.T) {
+	// Given
+	bankID := uuid.New()
+
+	// When
+	translation := NewTranslation(TranslationEntityBank, bankID, "name", "en", "Bank Central Asia")
+
+	// Then
+	assert.NotEqual(t, uuid.Nil, translation.ID)
+	assert.Equal(t, TranslationKey{EntityType: "bank", EntityID: bankID, Field: "name", LanguageCode: "en"}, translation.Key())
+	assert.Equal(t, "Bank Central Asia", translation.Value)
+	assert.True(t, translation.IsValid())
+}
+
+func TestTranslation_SetValue(t *testing.T) {
+	// Given
+	translation := NewTranslation(TranslationEntityCurrency, uuid.New(), "name", "id", "Dolar AS")
+	originalTime := translation.UpdatedAt
+
+	time.Sleep(1 * time.Millisecond)
+
+	// When
+	translation.SetValue("Dolar Amerika Serikat")
+
+	// Then
+	assert.Equal(t, "Dolar Amerika Serikat", translation.Value)
+	assert.True(t, translation.UpdatedAt.After(originalTime))
+}
+
+func TestTranslation_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(translation *Translation)
+		expected bool
+	}{
+		{name: "valid translation", modify: func(tr *Translation) {}, expected: true},
+		{name: "unknown entity type", modify: func(tr *Translation) { tr.EntityType = "payment_network" }, expected: false},
+		{name: "field not translatable", modify: func(tr *Translation) { tr.Field = "code" }, expected: false},
+		{name: "translatable field of another entity", modify: func(tr *Translation) { tr.EntityType = TranslationEntityCurrency; tr.Field = "alias" }, expected: false},
+		{name: "missing entity id", modify: func(tr *Translation) { tr.EntityID = uuid.Nil }, expected: false},
+		{name: "missing language code", modify: func(tr *Translation) { tr.LanguageCode = "" }, expected: false},
+		{name: "long language code", modify: func(tr *Translation) { tr.LanguageCode = "en-US-x-private" }, expected: false},
+		{name: "empty value", modify: func(tr *Translation) { tr.Value = "" }, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			translation := NewTranslation(TranslationEntityBank, uuid.New(), "alias", "en", "BCA")
+			tt.modify(translation)
+
+			// When
+			isValid := translation.IsValid()
+
+			// Then
+			assert.Equal(t, tt.expected, isValid)
+		})
+	}
+}
+
+func TestTranslatable_ApplyTranslation(t *testing.T) {
+	t.Run("bank", func(t *testing.T) {
+		bank := NewBank("Bank Central Asia", "BCA", "PT Bank Central Asia Tbk", "014")
+		entityType, entityID := bank.TranslationKey()
+
+		bank.ApplyTranslation("company", "Bank Central Asia Ltd")
+		bank.ApplyTranslation("code", "999")
+
+		assert.Equal(t, TranslationEntityBank, entityType)
+		assert.Equal(t, bank.ID, entityID)
+		assert.Equal(t, "Bank Central Asia Ltd", bank.Company)
+		assert.Equal(t, "014", bank.Code)
+	})
+
+	t.Run("currency", func(t *testing.T) {
+		currency := NewCurrency("US Dollar", "USD", 2)
+
+		currency.ApplyTranslation("name", "Dolar Amerika Serikat")
+
+		assert.Equal(t, "Dolar Amerika Serikat", currency.Name)
+	})
+
+	t.Run("language", func(t *testing.T) {
+		language := NewLanguage("German", "de")
+
+		language.ApplyTranslation("name", "Jerman")
+
+		assert.Equal(t, "Jerman", language.Name)
+	})
+
+	t.Run("geodirectory", func(t *testing.T) {
+		geodirectory := &Geodirectory{ID: uuid.New(), Name: "Germany", Type: GeoTypeCountry}
+		entityType, _ := geodirectory.TranslationKey()
+
+		geodirectory.ApplyTranslation("name", "Jerman")
+
+		assert.Equal(t, TranslationEntityGeodirectory, entityType)
+		assert.Equal(t, "Jerman", geodirectory.Name)
+	})
+}
+
+func TestTranslation_TableName(t *testing.T) {
+	translation := &Translation{}
+	assert.Equal(t, "tm_translations", translation.TableName())
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
@@ -12,8 +13,8 @@ type APIKeyRepository interface {
 	// Create creates a new API key
 	Create(ctx context.Context, apiKey *entities.APIKey) error
 
-	// GetByKey retrieves an API key by its key value
-	GetByKey(ctx context.Context, key string) (*entities.APIKey, error)
+	// GetByKeyHash retrieves an API key by the hash of its key; it returns nil when there is none
+	GetByKeyHash(ctx context.Context, keyHash string) (*entities.APIKey, error)
 
 	// GetByID retrieves an API key by its ID
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.APIKey, error)
@@ -27,6 +28,9 @@ type APIKeyRepository interface {
 	// Delete soft deletes an API key by ID
 	Delete(ctx context.Context, id uuid.UUID) error
 
+	// UpdateKeyHash replaces the stored hash of an API key, e.g. when its hashing scheme changes
+	UpdateKeyHash(ctx context.Context, id uuid.UUID, keyHash string) error
+
 	// UpdateLastUsed updates the last used timestamp for an API key
 	UpdateLastUsed(ctx context.Context, id uuid.UUID) error
 
@@ -36,12 +40,20 @@ type APIKeyRepository interface {
 	// Deactivate deactivates an API key
 	Deactivate(ctx context.Context, id uuid.UUID) error
 
+	// GetByIdentityID retrieves the API keys sharing an identity, i.e. a key and the keys rotated from or
+	// into it, oldest first
+	GetByIdentityID(ctx context.Context, identityID uuid.UUID) ([]*entities.APIKey, error)
+
+	// Rotate creates the successor of an API key and stores the link and rotation deadline of the key
+	Rotate(ctx context.Context, apiKey *entities.APIKey, successor *entities.APIKey) error
+
+	// DeactivateRotated deactivates rotated API keys whose rotation deadline is not after now and returns how
+	// many were deactivated
+	DeactivateRotated(ctx context.Context, now time.Time) (int64, error)
+
 	// Count returns the total number of API keys
 	Count(ctx context.Context) (int64, error)
 
 	// Search searches API keys
 	Search(ctx context.Context, query string, limit, offset int) ([]*entities.APIKey, error)
-
-	// ValidateKey validates an API key and returns it if valid
-	ValidateKey(ctx context.Context, key string) (*entities.APIKey, error)
 }
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// APIKeyUsageRepository defines the interface for API key usage data operations
+type APIKeyUsageRepository interface {
+	// Increment adds the requests of usage counts to the stored counts of the same API key, granularity,
+	// period, route and status class
+	Increment(ctx context.Context, usage []*entities.APIKeyUsage) error
+
+	// GetByAPIKey retrieves the usage counts of an API key at a granularity for the periods starting in
+	// [from, to), ordered by period, route and status class
+	GetByAPIKey(ctx context.Context, apiKeyID uuid.UUID, granularity string, from, to time.Time) ([]*entities.APIKeyUsage, error)
+}
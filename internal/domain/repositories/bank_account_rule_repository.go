@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// BankAccountRuleRepository defines the interface for bank account number rule data operations
+type BankAccountRuleRepository interface {
+	// Basic CRUD operations, rules are identified by their bank
+	Create(ctx context.Context, rule *entities.BankAccountRule) error
+	GetByBankID(ctx context.Context, bankID uuid.UUID) (*entities.BankAccountRule, error)
+	GetAll(ctx context.Context, limit, offset int) ([]*entities.BankAccountRule, error)
+	Update(ctx context.Context, rule *entities.BankAccountRule) error
+	DeleteByBankID(ctx context.Context, bankID uuid.UUID) error
+}
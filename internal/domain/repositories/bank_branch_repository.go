@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// BankBranchRepository defines the interface for bank branch data operations
+type BankBranchRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, branch *entities.BankBranch) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.BankBranch, error)
+	GetAll(ctx context.Context, limit, offset int) ([]*entities.BankBranch, error)
+	Update(ctx context.Context, branch *entities.BankBranch) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	CountByBank(ctx context.Context, bankID uuid.UUID) (int64, error)
+
+	// Bank and location operations
+	GetByBankAndCode(ctx context.Context, bankID uuid.UUID, code string) (*entities.BankBranch, error)
+	GetByBank(ctx context.Context, bankID uuid.UUID, limit, offset int) ([]*entities.BankBranch, error)
+	GetByBankWithinGeodirectory(ctx context.Context, bankID, geodirectoryID uuid.UUID, limit, offset int) ([]*entities.BankBranch, error)
+
+	// Validation operations
+	ExistsByBankAndCode(ctx context.Context, bankID uuid.UUID, code string) (bool, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// BankHistoryRepository defines the interface for bank history data operations
+type BankHistoryRepository interface {
+	Create(ctx context.Context, record *entities.BankHistoryRecord) error
+	GetByBank(ctx context.Context, bankID uuid.UUID) ([]*entities.BankHistoryRecord, error)
+	GetLatestByCode(ctx context.Context, code string) (*entities.BankHistoryRecord, error)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 // BankRepository defines the interface for bank data operations
@@ -14,15 +15,22 @@ type BankRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Bank, error)
 	GetAll(ctx context.Context, limit, offset int) ([]*entities.Bank, error)
 	Update(ctx context.Context, bank *entities.Bank) error
+	// UpdateWithHistory updates a bank and stores the history record of its previous state, if any,
+	// in one transaction
+	UpdateWithHistory(ctx context.Context, bank *entities.Bank, record *entities.BankHistoryRecord) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	Count(ctx context.Context) (int64, error)
 
 	// Search operations
 	Search(ctx context.Context, query string, limit, offset int) ([]*entities.Bank, error)
+	List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Bank, error)
 	GetByName(ctx context.Context, name string) (*entities.Bank, error)
 	GetByCode(ctx context.Context, code string) (*entities.Bank, error)
 	GetByAlias(ctx context.Context, alias string) (*entities.Bank, error)
 	GetByCompany(ctx context.Context, company string, limit, offset int) ([]*entities.Bank, error)
+	GetByBIC(ctx context.Context, bic string) ([]*entities.Bank, error)
+	GetByNetwork(ctx context.Context, networkCode string, limit, offset int) ([]*entities.Bank, error)
+	GetPredecessors(ctx context.Context, bankID uuid.UUID) ([]*entities.Bank, error)
 
 	// Validation operations
 	ExistsByCode(ctx context.Context, code string) (bool, error)
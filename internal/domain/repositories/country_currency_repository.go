@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// CountryCurrencyRepository defines the interface for country currency data operations
+type CountryCurrencyRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, countryCurrency *entities.CountryCurrency) error
+	GetByCountryAndCurrency(ctx context.Context, countryID, currencyID uuid.UUID) (*entities.CountryCurrency, error)
+	Update(ctx context.Context, countryCurrency *entities.CountryCurrency) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Query operations
+	GetByCountry(ctx context.Context, countryID uuid.UUID) ([]*entities.CountryCurrency, error)
+	GetByCurrency(ctx context.Context, currencyID uuid.UUID) ([]*entities.CountryCurrency, error)
+}
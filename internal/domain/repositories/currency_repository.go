@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 // CurrencyRepository defines the interface for currency data operations
@@ -19,8 +20,10 @@ type CurrencyRepository interface {
 
 	// Search operations
 	Search(ctx context.Context, query string, limit, offset int) ([]*entities.Currency, error)
+	List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Currency, error)
 	GetByName(ctx context.Context, name string) (*entities.Currency, error)
 	GetByCode(ctx context.Context, code string) (*entities.Currency, error)
+	GetByNumericCode(ctx context.Context, numericCode string) (*entities.Currency, error)
 	GetBySymbol(ctx context.Context, symbol string) ([]*entities.Currency, error)
 
 	// Status operations
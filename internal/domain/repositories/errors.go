@@ -0,0 +1,7 @@
+package repositories
+
+import "errors"
+
+// ErrNotFound is wrapped by the errors repositories return when a record does not exist,
+// so callers can tell a missing record from a failed lookup
+var ErrNotFound = errors.New("not found")
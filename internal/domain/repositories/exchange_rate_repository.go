@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// ExchangeRateRepository defines the interface for exchange rate data operations
+type ExchangeRateRepository interface {
+	// Basic CRUD operations; Upsert stores all rates or none
+	Upsert(ctx context.Context, rates []*entities.ExchangeRate) error
+
+	// Lookup operations; rates are looked up on the latest date on or before the given date
+	GetRate(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (*entities.ExchangeRate, error)
+	GetByBase(ctx context.Context, baseCurrency string, date time.Time) ([]*entities.ExchangeRate, error)
+	GetBaseCurrencies(ctx context.Context) ([]string, error)
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// GeoTypeRepository defines the interface for geo type registry operations
+type GeoTypeRepository interface {
+	GetByCode(ctx context.Context, code entities.GeoType) (*entities.GeoTypeDefinition, error)
+	GetAll(ctx context.Context) ([]*entities.GeoTypeDefinition, error)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 // GeodirectoryRepository defines the interface for geodirectory data operations
@@ -19,6 +20,7 @@ type GeodirectoryRepository interface {
 
 	// Search operations
 	Search(ctx context.Context, query string, limit, offset int) ([]*entities.Geodirectory, error)
+	List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Geodirectory, error)
 	GetByName(ctx context.Context, name string) (*entities.Geodirectory, error)
 	GetByCode(ctx context.Context, code string) (*entities.Geodirectory, error)
 	GetByPostalCode(ctx context.Context, postalCode string) ([]*entities.Geodirectory, error)
@@ -46,7 +48,7 @@ type GeodirectoryRepository interface {
 	MoveNode(ctx context.Context, nodeID, newParentID uuid.UUID) error
 
 	// Geographic operations
-	GetByCoordinates(ctx context.Context, latitude, longitude string, radius float64) ([]*entities.Geodirectory, error)
+	GetByCoordinates(ctx context.Context, coordinates *valueobjects.Coordinates, radius float64) ([]*entities.Geodirectory, error)
 	GetNearby(ctx context.Context, id uuid.UUID, radius float64, limit int) ([]*entities.Geodirectory, error)
 
 	// Country-specific operations (for backward compatibility)
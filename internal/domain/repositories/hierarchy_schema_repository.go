@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// HierarchySchemaRepository defines the interface for per-country hierarchy rule operations
+type HierarchySchemaRepository interface {
+	// GetRulesByCountry retrieves all hierarchy rules defined for a country
+	GetRulesByCountry(ctx context.Context, countryCode string) ([]*entities.HierarchyRule, error)
+
+	// GetCountryCodes retrieves the codes of all countries that define their own rules
+	GetCountryCodes(ctx context.Context) ([]string, error)
+
+	// ReplaceRules replaces all hierarchy rules of a country in a single transaction
+	ReplaceRules(ctx context.Context, countryCode string, rules []*entities.HierarchyRule) error
+
+	// DeleteByCountry removes all hierarchy rules of a country
+	DeleteByCountry(ctx context.Context, countryCode string) error
+}
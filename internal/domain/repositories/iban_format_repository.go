@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// IBANFormatRepository defines the interface for IBAN format data operations
+type IBANFormatRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, format *entities.IBANFormat) error
+	GetByCountryCode(ctx context.Context, countryCode string) (*entities.IBANFormat, error)
+	GetAll(ctx context.Context) ([]*entities.IBANFormat, error)
+	Update(ctx context.Context, format *entities.IBANFormat) error
+	Delete(ctx context.Context, countryCode string) error
+}
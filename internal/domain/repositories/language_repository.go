@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 // LanguageRepository defines the interface for language data operations
@@ -19,8 +20,10 @@ type LanguageRepository interface {
 
 	// Search operations
 	Search(ctx context.Context, query string, limit, offset int) ([]*entities.Language, error)
+	List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Language, error)
 	GetByName(ctx context.Context, name string) (*entities.Language, error)
 	GetByCode(ctx context.Context, code string) (*entities.Language, error)
+	GetByAnyCode(ctx context.Context, code string) (*entities.Language, error)
 
 	// Status operations
 	GetActive(ctx context.Context, limit, offset int) ([]*entities.Language, error)
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// LocaleRepository defines the interface for locale data operations
+type LocaleRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, locale *entities.Locale) error
+	GetByTag(ctx context.Context, tag string) (*entities.Locale, error)
+	GetAll(ctx context.Context, limit, offset int) ([]*entities.Locale, error)
+	Update(ctx context.Context, locale *entities.Locale) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Query operations
+	GetActive(ctx context.Context) ([]*entities.Locale, error)
+	GetByLanguage(ctx context.Context, languageID uuid.UUID) ([]*entities.Locale, error)
+}
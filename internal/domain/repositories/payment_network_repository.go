@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// PaymentNetworkRepository defines the interface for payment network data operations
+type PaymentNetworkRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, network *entities.PaymentNetwork) error
+	GetByCode(ctx context.Context, code string) (*entities.PaymentNetwork, error)
+	GetAll(ctx context.Context) ([]*entities.PaymentNetwork, error)
+	Update(ctx context.Context, network *entities.PaymentNetwork) error
+	Delete(ctx context.Context, code string) error
+}
+
+// BankNetworkMembershipRepository defines the interface for bank network membership data operations
+type BankNetworkMembershipRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, membership *entities.BankNetworkMembership) error
+	GetByBankAndNetwork(ctx context.Context, bankID uuid.UUID, networkCode string) (*entities.BankNetworkMembership, error)
+	Update(ctx context.Context, membership *entities.BankNetworkMembership) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Query operations
+	GetByBank(ctx context.Context, bankID uuid.UUID) ([]*entities.BankNetworkMembership, error)
+}
@@ -10,24 +10,28 @@ import (
 type SearchRepository interface {
 	// Index operations
 	IndexBank(ctx context.Context, bank *entities.Bank) error
+	IndexBankBranch(ctx context.Context, branch *entities.BankBranch) error
 	IndexCurrency(ctx context.Context, currency *entities.Currency) error
 	IndexLanguage(ctx context.Context, language *entities.Language) error
 	IndexGeodirectory(ctx context.Context, geodirectory *entities.Geodirectory) error
 
 	// Search operations
 	SearchBanks(ctx context.Context, query string, limit, offset int) ([]*entities.Bank, error)
+	SearchBankBranches(ctx context.Context, query string, limit, offset int) ([]*entities.BankBranch, error)
 	SearchCurrencies(ctx context.Context, query string, limit, offset int) ([]*entities.Currency, error)
 	SearchLanguages(ctx context.Context, query string, limit, offset int) ([]*entities.Language, error)
 	SearchGeodirectories(ctx context.Context, query string, limit, offset int) ([]*entities.Geodirectory, error)
 
 	// Bulk operations
 	IndexAllBanks(ctx context.Context, banks []*entities.Bank) error
+	IndexAllBankBranches(ctx context.Context, branches []*entities.BankBranch) error
 	IndexAllCurrencies(ctx context.Context, currencies []*entities.Currency) error
 	IndexAllLanguages(ctx context.Context, languages []*entities.Language) error
 	IndexAllGeodirectories(ctx context.Context, geodirectories []*entities.Geodirectory) error
 
 	// Delete operations
 	DeleteBankFromIndex(ctx context.Context, bankID string) error
+	DeleteBankBranchFromIndex(ctx context.Context, branchID string) error
 	DeleteCurrencyFromIndex(ctx context.Context, currencyID string) error
 	DeleteLanguageFromIndex(ctx context.Context, languageID string) error
 	DeleteGeodirectoryFromIndex(ctx context.Context, geodirectoryID string) error
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// TranslationRepository defines the interface for translation data operations
+type TranslationRepository interface {
+	// Basic CRUD operations
+	Upsert(ctx context.Context, translations []*entities.Translation) error
+	DeleteByKeys(ctx context.Context, keys []entities.TranslationKey) (int64, error)
+
+	// Query operations
+	List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Translation, error)
+	GetByEntities(ctx context.Context, entityType string, entityIDs []uuid.UUID, languageCode string) ([]*entities.Translation, error)
+}
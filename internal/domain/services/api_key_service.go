@@ -2,9 +2,12 @@ package services
 
 import (
 	"context"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,15 +18,35 @@ import (
 // APIKeyService provides business logic for API key operations
 type APIKeyService struct {
 	apiKeyRepo repositories.APIKeyRepository
+	pepper     string
 }
 
-// NewAPIKeyService creates a new APIKeyService instance
-func NewAPIKeyService(apiKeyRepo repositories.APIKeyRepository) *APIKeyService {
+// NewAPIKeyService creates a new APIKeyService instance. Keys are stored as their SHA-256 hash, or as their
+// HMAC-SHA256 with the pepper when one is configured, so that a copy of the database does not reveal them.
+func NewAPIKeyService(apiKeyRepo repositories.APIKeyRepository, pepper string) *APIKeyService {
 	return &APIKeyService{
 		apiKeyRepo: apiKeyRepo,
+		pepper:     pepper,
 	}
 }
 
+// HashAPIKey returns the hex encoded hash an API key is stored as
+func (s *APIKeyService) HashAPIKey(key string) string {
+	if s.pepper == "" {
+		return hashAPIKey(key)
+	}
+	mac := hmac.New(sha256.New, []byte(s.pepper))
+	mac.Write([]byte(key))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// hashAPIKey returns the hex encoded SHA-256 of an API key, which is how keys are stored without a pepper
+// and how the plaintext keys of migration 028 were hashed
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
 // GenerateAPIKey generates a secure random API key
 func (s *APIKeyService) GenerateAPIKey() (string, error) {
 	bytes := make([]byte, 32) // 32 bytes = 64 hex characters
@@ -33,29 +56,54 @@ func (s *APIKeyService) GenerateAPIKey() (string, error) {
 	return hex.EncodeToString(bytes), nil
 }
 
-// CreateAPIKey creates a new API key
-func (s *APIKeyService) CreateAPIKey(ctx context.Context, name, description string, expiresAt *time.Time) (*entities.APIKey, error) {
+// APIKeyOptions holds the optional settings of a new API key
+type APIKeyOptions struct {
+	Description  string
+	ExpiresAt    *time.Time
+	Scopes       []string             // entities.DefaultAPIKeyScopes when empty
+	Plan         *entities.APIKeyPlan // entities.DefaultAPIKeyPlan when nil
+	AllowedCIDRs []string             // Any address when empty
+}
+
+// CreateAPIKey creates a new API key. The returned entity is the only one carrying the key itself; only its
+// hash and visible prefix are stored.
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, name string, options APIKeyOptions) (*entities.APIKey, error) {
 	key, err := s.GenerateAPIKey()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate API key: %w", err)
 	}
 
 	// Check if key already exists (very unlikely but good to be safe)
-	existingKey, err := s.apiKeyRepo.GetByKey(ctx, key)
+	keyHash := s.HashAPIKey(key)
+	existingKey, err := s.apiKeyRepo.GetByKeyHash(ctx, keyHash)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check key existence: %w", err)
 	}
 	if existingKey != nil {
 		// Regenerate key if it already exists
-		return s.CreateAPIKey(ctx, name, description, expiresAt)
+		return s.CreateAPIKey(ctx, name, options)
 	}
 
 	apiKey := entities.NewAPIKey(name, key)
-	if description != "" {
-		apiKey.SetDescription(description)
+	apiKey.KeyHash = keyHash
+	if len(options.Scopes) == 0 {
+		apiKey.SetScopes(entities.DefaultAPIKeyScopes)
+	} else {
+		apiKey.SetScopes(options.Scopes)
+	}
+	if options.Plan != nil {
+		apiKey.Plan = *options.Plan
+	}
+	apiKey.SetAllowedCIDRs(options.AllowedCIDRs)
+	if options.Description != "" {
+		apiKey.SetDescription(options.Description)
 	}
-	if expiresAt != nil {
-		apiKey.SetExpiration(*expiresAt)
+	if options.ExpiresAt != nil {
+		apiKey.SetExpiration(*options.ExpiresAt)
+	}
+
+	if err := validateAPIKey(apiKey); err != nil {
+		return nil, err
 	}
 
 	if err := s.apiKeyRepo.Create(ctx, apiKey); err != nil {
@@ -65,9 +113,51 @@ func (s *APIKeyService) CreateAPIKey(ctx context.Context, name, description stri
 	return apiKey, nil
 }
 
-// ValidateAPIKey validates an API key and returns the associated entity
+// ValidateAPIKey validates an API key and returns the associated entity, or nil when the key is unknown,
+// inactive or expired. Keys stored without the pepper, such as keys migrated from plaintext before a pepper
+// was configured, are still accepted and rehashed with the pepper on first use.
 func (s *APIKeyService) ValidateAPIKey(ctx context.Context, key string) (*entities.APIKey, error) {
-	return s.apiKeyRepo.ValidateKey(ctx, key)
+	if key == "" {
+		return nil, nil
+	}
+
+	pepperedHash := s.HashAPIKey(key)
+	apiKey, err := s.apiKeyRepo.GetByKeyHash(ctx, pepperedHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	if apiKey == nil && s.pepper != "" {
+		apiKey, err = s.apiKeyRepo.GetByKeyHash(ctx, hashAPIKey(key))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get API key: %w", err)
+		}
+		if apiKey != nil {
+			// The key stays valid with its old hash if the upgrade fails
+			if err := s.apiKeyRepo.UpdateKeyHash(ctx, apiKey.ID, pepperedHash); err == nil {
+				apiKey.KeyHash = pepperedHash
+			}
+		}
+	}
+
+	if apiKey == nil {
+		return nil, nil
+	}
+
+	// Check if the key is valid (active, not expired and within its rotation grace period)
+	if !apiKey.IsValid() {
+		if apiKey.IsActive && apiKey.IsRotationExpired() {
+			// A failed deactivation is retried by DeactivateRotatedAPIKeys
+			_ = s.apiKeyRepo.Deactivate(ctx, apiKey.ID)
+		}
+		return nil, nil
+	}
+
+	// The key is still valid even if the last used timestamp cannot be updated
+	apiKey.UpdateLastUsed()
+	_ = s.apiKeyRepo.UpdateLastUsed(ctx, apiKey.ID)
+
+	return apiKey, nil
 }
 
 // GetAPIKeyByID retrieves an API key by its ID
@@ -90,12 +180,90 @@ func (s *APIKeyService) GetAllAPIKeys(ctx context.Context, limit, offset int) ([
 
 // UpdateAPIKey updates an existing API key
 func (s *APIKeyService) UpdateAPIKey(ctx context.Context, apiKey *entities.APIKey) error {
+	apiKey.SetScopes(apiKey.Scopes)
+	apiKey.SetAllowedCIDRs(apiKey.AllowedCIDRs)
+	if err := validateAPIKey(apiKey); err != nil {
+		return err
+	}
+
 	if err := s.apiKeyRepo.Update(ctx, apiKey); err != nil {
 		return fmt.Errorf("failed to update API key: %w", err)
 	}
 	return nil
 }
 
+// RotateAPIKey issues a successor of an API key with the same identity, name, description, scopes, plan,
+// allowed CIDR ranges and expiration. The API key stays valid for the grace period and is deactivated
+// afterwards. It returns the successor, which is the only entity carrying the new key, and the rotated key.
+func (s *APIKeyService) RotateAPIKey(ctx context.Context, id uuid.UUID, gracePeriod time.Duration) (*entities.APIKey, *entities.APIKey, error) {
+	if gracePeriod < 0 {
+		return nil, nil, fmt.Errorf("%w: grace period must not be negative", ErrInvalidInput)
+	}
+
+	apiKey, err := s.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+	if apiKey.IsRotated() {
+		return nil, nil, fmt.Errorf("%w: API key was already rotated to %s", ErrInvalidInput, apiKey.ReplacedByID)
+	}
+	if !apiKey.IsValid() {
+		return nil, nil, fmt.Errorf("%w: API key is inactive or expired and cannot be rotated", ErrInvalidInput)
+	}
+
+	var key, keyHash string
+	for {
+		key, err = s.GenerateAPIKey()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate API key: %w", err)
+		}
+
+		// Check if key already exists (very unlikely but good to be safe)
+		keyHash = s.HashAPIKey(key)
+		existingKey, err := s.apiKeyRepo.GetByKeyHash(ctx, keyHash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check key existence: %w", err)
+		}
+		if existingKey == nil {
+			break
+		}
+	}
+
+	successor := entities.NewAPIKey(apiKey.Name, key)
+	successor.KeyHash = keyHash
+	apiKey.Rotate(successor, time.Now().Add(gracePeriod))
+
+	if err := s.apiKeyRepo.Rotate(ctx, apiKey, successor); err != nil {
+		return nil, nil, fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	return successor, apiKey, nil
+}
+
+// DeactivateRotatedAPIKeys deactivates the rotated API keys whose grace period has ended and returns how many
+// were deactivated
+func (s *APIKeyService) DeactivateRotatedAPIKeys(ctx context.Context) (int64, error) {
+	deactivated, err := s.apiKeyRepo.DeactivateRotated(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to deactivate rotated API keys: %w", err)
+	}
+	return deactivated, nil
+}
+
+// GetAPIKeyHistory retrieves an API key with the keys it was rotated from and into, oldest first
+func (s *APIKeyService) GetAPIKeyHistory(ctx context.Context, id uuid.UUID) ([]*entities.APIKey, error) {
+	apiKey, err := s.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	history, err := s.apiKeyRepo.GetByIdentityID(ctx, apiKey.IdentityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key history: %w", err)
+	}
+	return history, nil
+}
+
 // ActivateAPIKey activates an API key
 func (s *APIKeyService) ActivateAPIKey(ctx context.Context, id uuid.UUID) error {
 	if err := s.apiKeyRepo.Activate(ctx, id); err != nil {
@@ -146,7 +314,7 @@ func (s *APIKeyService) ValidateAPIKeyByName(ctx context.Context, name string) (
 	}
 
 	if len(apiKeys) == 0 {
-		return nil, fmt.Errorf("API key not found")
+		return nil, fmt.Errorf("API key %w", ErrNotFound)
 	}
 
 	apiKey := apiKeys[0]
@@ -172,3 +340,31 @@ func (s *APIKeyService) GetAPIKeyStatistics(ctx context.Context) (map[string]int
 
 	return stats, nil
 }
+
+// validateAPIKey checks the scopes, allowed CIDR ranges and plan of an API key
+func validateAPIKey(apiKey *entities.APIKey) error {
+	for _, scope := range apiKey.Scopes {
+		if !entities.IsAPIKeyScope(scope) {
+			return fmt.Errorf("%w: scope '%s' is unknown; scopes are %s", ErrInvalidInput, scope, strings.Join(entities.APIKeyScopes, ", "))
+		}
+	}
+
+	for _, cidr := range apiKey.AllowedCIDRs {
+		if _, err := entities.ParseAllowedCIDR(cidr); err != nil {
+			return fmt.Errorf("%w: allowed CIDR '%s' is not a range; use an IPv4 or IPv6 range such as 203.0.113.0/24 or 2001:db8::/32", ErrInvalidInput, cidr)
+		}
+	}
+
+	plan := apiKey.Plan
+	switch {
+	case plan.Name == "":
+		return fmt.Errorf("%w: plan name is required", ErrInvalidInput)
+	case plan.RequestsPerMinute <= 0 || plan.Burst <= 0:
+		return fmt.Errorf("%w: plan requests_per_minute and burst must be positive", ErrInvalidInput)
+	case !plan.IsValid():
+		return fmt.Errorf("%w: a plan quota must be positive with a quota_period of %s or %s, and no quota has no quota_period",
+			ErrInvalidInput, entities.QuotaPeriodDay, entities.QuotaPeriodMonth)
+	}
+
+	return nil
+}
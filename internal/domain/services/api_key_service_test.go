@@ -0,0 +1,471 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// MockAPIKeyRepository is a mock implementation of APIKeyRepository
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) Create(ctx context.Context, apiKey *entities.APIKey) error {
+	args := m.Called(ctx, apiKey)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) GetByKeyHash(ctx context.Context, keyHash string) (*entities.APIKey, error) {
+	args := m.Called(ctx, keyHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.APIKey, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.APIKey, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) Update(ctx context.Context, apiKey *entities.APIKey) error {
+	args := m.Called(ctx, apiKey)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) UpdateKeyHash(ctx context.Context, id uuid.UUID, keyHash string) error {
+	args := m.Called(ctx, id, keyHash)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) Activate(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) Deactivate(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) GetByIdentityID(ctx context.Context, identityID uuid.UUID) ([]*entities.APIKey, error) {
+	args := m.Called(ctx, identityID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) Rotate(ctx context.Context, apiKey *entities.APIKey, successor *entities.APIKey) error {
+	args := m.Called(ctx, apiKey, successor)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) DeactivateRotated(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) Count(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) Search(ctx context.Context, query string, limit, offset int) ([]*entities.APIKey, error) {
+	args := m.Called(ctx, query, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.APIKey), args.Error(1)
+}
+
+// storedAPIKey returns an API key as it is read from the database, without the key itself
+func storedAPIKey(key, keyHash string) *entities.APIKey {
+	apiKey := entities.NewAPIKey("Partner", key)
+	apiKey.Key = ""
+	apiKey.KeyHash = keyHash
+	return apiKey
+}
+
+func TestAPIKeyService_HashAPIKey(t *testing.T) {
+	plain := NewAPIKeyService(new(MockAPIKeyRepository), "")
+	peppered := NewAPIKeyService(new(MockAPIKeyRepository), "pepper")
+
+	// SHA-256 of "abc"
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", plain.HashAPIKey("abc"))
+	assert.Len(t, peppered.HashAPIKey("abc"), 64)
+	assert.NotEqual(t, plain.HashAPIKey("abc"), peppered.HashAPIKey("abc"))
+	assert.Equal(t, peppered.HashAPIKey("abc"), peppered.HashAPIKey("abc"))
+}
+
+func TestAPIKeyService_CreateAPIKey(t *testing.T) {
+	// Given
+	repo := new(MockAPIKeyRepository)
+	service := NewAPIKeyService(repo, "pepper")
+	ctx := context.Background()
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	repo.On("GetByKeyHash", ctx, mock.Anything).Return(nil, nil)
+	repo.On("Create", ctx, mock.AnythingOfType("*entities.APIKey")).Return(nil)
+
+	// When
+	apiKey, err := service.CreateAPIKey(ctx, "Partner", APIKeyOptions{
+		Description: "Partner key",
+		ExpiresAt:   &expiresAt,
+		Scopes:      []string{"Banks:Write", "geo:read"},
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []string{entities.ScopeBanksWrite, entities.ScopeGeoRead}, apiKey.Scopes)
+	assert.Equal(t, entities.DefaultAPIKeyPlan(), apiKey.Plan)
+	assert.Len(t, apiKey.Key, 64)
+	assert.Equal(t, apiKey.Key[:entities.APIKeyPrefixLength], apiKey.KeyPrefix)
+	assert.Equal(t, service.HashAPIKey(apiKey.Key), apiKey.KeyHash)
+	assert.NotContains(t, apiKey.KeyHash, apiKey.Key)
+	repo.AssertCalled(t, "GetByKeyHash", ctx, apiKey.KeyHash)
+	repo.AssertExpectations(t)
+}
+
+func TestAPIKeyService_CreateAPIKey_Scopes(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("default scopes", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+		repo.On("GetByKeyHash", ctx, mock.Anything).Return(nil, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*entities.APIKey")).Return(nil)
+
+		// When
+		apiKey, err := service.CreateAPIKey(ctx, "Partner", APIKeyOptions{})
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, entities.DefaultAPIKeyScopes, apiKey.Scopes)
+	})
+
+	t.Run("unknown scope", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+		repo.On("GetByKeyHash", ctx, mock.Anything).Return(nil, nil)
+
+		// When
+		apiKey, err := service.CreateAPIKey(ctx, "Partner", APIKeyOptions{Scopes: []string{"geo:write"}})
+
+		// Then
+		assert.Nil(t, apiKey)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "scope 'geo:write' is unknown")
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAPIKeyService_CreateAPIKey_AllowedCIDRs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ranges are stored in canonical form", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+		repo.On("GetByKeyHash", ctx, mock.Anything).Return(nil, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*entities.APIKey")).Return(nil)
+
+		// When
+		apiKey, err := service.CreateAPIKey(ctx, "Partner", APIKeyOptions{AllowedCIDRs: []string{"203.0.113.7/24", "2001:DB8::1"}})
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"203.0.113.0/24", "2001:db8::1/128"}, apiKey.AllowedCIDRs)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+		repo.On("GetByKeyHash", ctx, mock.Anything).Return(nil, nil)
+
+		// When
+		apiKey, err := service.CreateAPIKey(ctx, "Partner", APIKeyOptions{AllowedCIDRs: []string{"203.0.113.0/33"}})
+
+		// Then
+		assert.Nil(t, apiKey)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "allowed CIDR '203.0.113.0/33'")
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAPIKeyService_CreateAPIKey_Plan(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		plan          entities.APIKeyPlan
+		expectedError string
+	}{
+		{name: "monthly quota", plan: entities.APIKeyPlan{Name: "partner", RequestsPerMinute: 6000, Burst: 500, Quota: 1000000, QuotaPeriod: entities.QuotaPeriodMonth}},
+		{name: "missing name", plan: entities.APIKeyPlan{RequestsPerMinute: 60, Burst: 10}, expectedError: "name is required"},
+		{name: "zero rate", plan: entities.APIKeyPlan{Name: "free", Burst: 10}, expectedError: "must be positive"},
+		{name: "quota without period", plan: entities.APIKeyPlan{Name: "free", RequestsPerMinute: 60, Burst: 10, Quota: 1000}, expectedError: "quota_period"},
+		{name: "period without quota", plan: entities.APIKeyPlan{Name: "free", RequestsPerMinute: 60, Burst: 10, QuotaPeriod: entities.QuotaPeriodDay}, expectedError: "quota_period"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			repo := new(MockAPIKeyRepository)
+			service := NewAPIKeyService(repo, "")
+			repo.On("GetByKeyHash", ctx, mock.Anything).Return(nil, nil)
+			repo.On("Create", ctx, mock.AnythingOfType("*entities.APIKey")).Return(nil)
+			plan := tt.plan
+
+			// When
+			apiKey, err := service.CreateAPIKey(ctx, "Partner", APIKeyOptions{Plan: &plan})
+
+			// Then
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.plan, apiKey.Plan)
+				return
+			}
+			assert.ErrorIs(t, err, ErrInvalidInput)
+			assert.Contains(t, err.Error(), tt.expectedError)
+			repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestAPIKeyService_UpdateAPIKey_InvalidScope(t *testing.T) {
+	// Given
+	repo := new(MockAPIKeyRepository)
+	service := NewAPIKeyService(repo, "")
+	apiKey := storedAPIKey("key", hashAPIKey("key"))
+	apiKey.Scopes = []string{entities.ScopeGeoRead, "admin:everything"}
+
+	// When
+	err := service.UpdateAPIKey(context.Background(), apiKey)
+
+	// Then
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Contains(t, err.Error(), "scope 'admin:everything' is unknown")
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestAPIKeyService_ValidateAPIKey(t *testing.T) {
+	ctx := context.Background()
+	key := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	t.Run("valid key", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+		stored := storedAPIKey(key, service.HashAPIKey(key))
+		repo.On("GetByKeyHash", ctx, service.HashAPIKey(key)).Return(stored, nil)
+		repo.On("UpdateLastUsed", ctx, stored.ID).Return(nil)
+
+		// When
+		apiKey, err := service.ValidateAPIKey(ctx, key)
+
+		// Then
+		require.NoError(t, err)
+		require.NotNil(t, apiKey)
+		assert.Equal(t, stored.ID, apiKey.ID)
+		assert.NotNil(t, apiKey.LastUsedAt)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+		repo.On("GetByKeyHash", ctx, mock.Anything).Return(nil, nil)
+
+		// When
+		apiKey, err := service.ValidateAPIKey(ctx, key)
+
+		// Then
+		require.NoError(t, err)
+		assert.Nil(t, apiKey)
+		repo.AssertNotCalled(t, "UpdateLastUsed", mock.Anything, mock.Anything)
+	})
+
+	t.Run("inactive key", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+		stored := storedAPIKey(key, service.HashAPIKey(key))
+		stored.Deactivate()
+		repo.On("GetByKeyHash", ctx, service.HashAPIKey(key)).Return(stored, nil)
+
+		// When
+		apiKey, err := service.ValidateAPIKey(ctx, key)
+
+		// Then
+		require.NoError(t, err)
+		assert.Nil(t, apiKey)
+		repo.AssertNotCalled(t, "UpdateLastUsed", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rotated key past its rotation deadline is deactivated", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+		stored := storedAPIKey(key, service.HashAPIKey(key))
+		stored.Rotate(entities.NewAPIKey("Partner", "successor"), time.Now().Add(-time.Minute))
+		repo.On("GetByKeyHash", ctx, service.HashAPIKey(key)).Return(stored, nil)
+		repo.On("Deactivate", ctx, stored.ID).Return(nil)
+
+		// When
+		apiKey, err := service.ValidateAPIKey(ctx, key)
+
+		// Then
+		require.NoError(t, err)
+		assert.Nil(t, apiKey)
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "UpdateLastUsed", mock.Anything, mock.Anything)
+	})
+
+	t.Run("migrated key is rehashed with the pepper", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "pepper")
+		stored := storedAPIKey(key, hashAPIKey(key))
+		repo.On("GetByKeyHash", ctx, service.HashAPIKey(key)).Return(nil, nil)
+		repo.On("GetByKeyHash", ctx, hashAPIKey(key)).Return(stored, nil)
+		repo.On("UpdateKeyHash", ctx, stored.ID, service.HashAPIKey(key)).Return(nil)
+		repo.On("UpdateLastUsed", ctx, stored.ID).Return(nil)
+
+		// When
+		apiKey, err := service.ValidateAPIKey(ctx, key)
+
+		// Then
+		require.NoError(t, err)
+		require.NotNil(t, apiKey)
+		assert.Equal(t, service.HashAPIKey(key), apiKey.KeyHash)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("empty key", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+
+		// When
+		apiKey, err := service.ValidateAPIKey(ctx, "")
+
+		// Then
+		require.NoError(t, err)
+		assert.Nil(t, apiKey)
+		repo.AssertNotCalled(t, "GetByKeyHash", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAPIKeyService_RotateAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("issues a successor and keeps the key valid for the grace period", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "pepper")
+		stored := storedAPIKey("old-key", service.HashAPIKey("old-key"))
+		stored.SetScopes([]string{entities.ScopeBanksWrite})
+		repo.On("GetByID", ctx, stored.ID).Return(stored, nil)
+		repo.On("GetByKeyHash", ctx, mock.Anything).Return(nil, nil)
+		repo.On("Rotate", ctx, stored, mock.AnythingOfType("*entities.APIKey")).Return(nil)
+
+		// When
+		successor, rotated, err := service.RotateAPIKey(ctx, stored.ID, time.Hour)
+
+		// Then
+		require.NoError(t, err)
+		require.NotNil(t, successor)
+		assert.Len(t, successor.Key, 64)
+		assert.Equal(t, service.HashAPIKey(successor.Key), successor.KeyHash)
+		assert.Equal(t, stored.IdentityID, successor.IdentityID)
+		assert.Equal(t, stored.ID, *successor.RotatedFromID)
+		assert.Equal(t, stored.Scopes, successor.Scopes)
+		assert.Equal(t, stored, rotated)
+		assert.Equal(t, successor.ID, *rotated.ReplacedByID)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), *rotated.RotationDeadline, time.Minute)
+		assert.True(t, rotated.IsValid())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("already rotated key", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+		stored := storedAPIKey("old-key", service.HashAPIKey("old-key"))
+		stored.Rotate(entities.NewAPIKey("Partner", "successor"), time.Now().Add(time.Hour))
+		repo.On("GetByID", ctx, stored.ID).Return(stored, nil)
+
+		// When
+		_, _, err := service.RotateAPIKey(ctx, stored.ID, time.Hour)
+
+		// Then
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "API key was already rotated")
+		repo.AssertNotCalled(t, "Rotate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("inactive key", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+		stored := storedAPIKey("old-key", service.HashAPIKey("old-key"))
+		stored.Deactivate()
+		repo.On("GetByID", ctx, stored.ID).Return(stored, nil)
+
+		// When
+		_, _, err := service.RotateAPIKey(ctx, stored.ID, time.Hour)
+
+		// Then
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "cannot be rotated")
+		repo.AssertNotCalled(t, "Rotate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("negative grace period", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(repo, "")
+
+		// When
+		_, _, err := service.RotateAPIKey(ctx, uuid.New(), -time.Hour)
+
+		// Then
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "grace period must not be negative")
+		repo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// Longest usage ranges that can be requested at once
+const (
+	maxHourlyUsageRange = 31 * 24 * time.Hour
+	maxDailyUsageRange  = 366 * 24 * time.Hour
+)
+
+// usageCounter identifies the requests of an API key to a route with a status class in an hour
+type usageCounter struct {
+	apiKeyID    uuid.UUID
+	hour        time.Time
+	route       string
+	statusClass string
+}
+
+// APIKeyUsageService counts the requests made with API keys. Requests are counted in memory and added to the
+// stored hourly and daily counts by Flush, so that counting a request does not wait for the database.
+type APIKeyUsageService struct {
+	usageRepo repositories.APIKeyUsageRepository
+
+	mu      sync.Mutex
+	pending map[usageCounter]int64
+}
+
+// NewAPIKeyUsageService creates a new APIKeyUsageService instance
+func NewAPIKeyUsageService(usageRepo repositories.APIKeyUsageRepository) *APIKeyUsageService {
+	return &APIKeyUsageService{
+		usageRepo: usageRepo,
+		pending:   make(map[usageCounter]int64),
+	}
+}
+
+// RecordRequest counts a request made with an API key to a route, e.g. /api/v1/banks/:id, with a response status
+func (s *APIKeyUsageService) RecordRequest(apiKeyID uuid.UUID, route string, status int, at time.Time) {
+	counter := usageCounter{
+		apiKeyID:    apiKeyID,
+		hour:        entities.UsagePeriodStart(at, entities.UsageGranularityHour),
+		route:       route,
+		statusClass: entities.StatusClass(status),
+	}
+
+	s.mu.Lock()
+	s.pending[counter]++
+	s.mu.Unlock()
+}
+
+// Flush adds the requests counted since the last flush to the stored hourly and daily counts and returns the
+// number of requests stored. Counts that cannot be stored are kept for the next flush.
+func (s *APIKeyUsageService) Flush(ctx context.Context) (int64, error) {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[usageCounter]int64)
+	s.mu.Unlock()
+
+	if len(pending) == 0 {
+		return 0, nil
+	}
+
+	var requests int64
+	daily := make(map[usageCounter]int64)
+	usage := make([]*entities.APIKeyUsage, 0, len(pending))
+	for counter, count := range pending {
+		requests += count
+		usage = append(usage, newAPIKeyUsage(counter, entities.UsageGranularityHour, count))
+
+		day := counter
+		day.hour = entities.UsagePeriodStart(counter.hour, entities.UsageGranularityDay)
+		daily[day] += count
+	}
+	for counter, count := range daily {
+		usage = append(usage, newAPIKeyUsage(counter, entities.UsageGranularityDay, count))
+	}
+
+	if err := s.usageRepo.Increment(ctx, usage); err != nil {
+		s.mu.Lock()
+		for counter, count := range pending {
+			s.pending[counter] += count
+		}
+		s.mu.Unlock()
+		return 0, fmt.Errorf("failed to store API key usage: %w", err)
+	}
+
+	return requests, nil
+}
+
+// newAPIKeyUsage returns the usage count of a counter at a granularity
+func newAPIKeyUsage(counter usageCounter, granularity string, requests int64) *entities.APIKeyUsage {
+	return &entities.APIKeyUsage{
+		APIKeyID:    counter.apiKeyID,
+		Granularity: granularity,
+		PeriodStart: entities.UsagePeriodStart(counter.hour, granularity),
+		Route:       counter.route,
+		StatusClass: counter.statusClass,
+		Requests:    requests,
+	}
+}
+
+// APIKeyUsageReport is the usage of an API key over a range of hours or days
+type APIKeyUsageReport struct {
+	APIKeyID      uuid.UUID               `json:"api_key_id"`
+	Granularity   string                  `json:"granularity"`
+	From          time.Time               `json:"from"`
+	To            time.Time               `json:"to"`
+	TotalRequests int64                   `json:"total_requests"`
+	ByStatusClass map[string]int64        `json:"by_status_class"`
+	ByRoute       map[string]int64        `json:"by_route"`
+	Usage         []*entities.APIKeyUsage `json:"usage"`
+}
+
+// GetUsage returns the stored usage of an API key per hour or day for the periods starting in [from, to).
+// From is rounded down to the start of its period. Without from and to it covers the last 24 hours or 30 days.
+func (s *APIKeyUsageService) GetUsage(ctx context.Context, apiKeyID uuid.UUID, granularity string, from, to time.Time) (*APIKeyUsageReport, error) {
+	if granularity == "" {
+		granularity = entities.UsageGranularityHour
+	}
+	if !entities.IsUsageGranularity(granularity) {
+		return nil, fmt.Errorf("%w: granularity '%s' must be %s or %s", ErrInvalidInput, granularity, entities.UsageGranularityHour, entities.UsageGranularityDay)
+	}
+
+	maxRange, rangeName := maxHourlyUsageRange, "hourly"
+	if granularity == entities.UsageGranularityDay {
+		maxRange, rangeName = maxDailyUsageRange, "daily"
+	}
+
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		if granularity == entities.UsageGranularityDay {
+			from = to.AddDate(0, 0, -30)
+		} else {
+			from = to.Add(-24 * time.Hour)
+		}
+	}
+	from = entities.UsagePeriodStart(from, granularity)
+	to = to.UTC()
+
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+	if to.Sub(from) > maxRange {
+		return nil, fmt.Errorf("%w: at most %d days of %s usage can be requested", ErrInvalidInput, int(maxRange.Hours()/24), rangeName)
+	}
+
+	usage, err := s.usageRepo.GetByAPIKey(ctx, apiKeyID, granularity, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key usage: %w", err)
+	}
+
+	report := &APIKeyUsageReport{
+		APIKeyID:      apiKeyID,
+		Granularity:   granularity,
+		From:          from,
+		To:            to,
+		ByStatusClass: make(map[string]int64),
+		ByRoute:       make(map[string]int64),
+		Usage:         usage,
+	}
+	for _, count := range usage {
+		report.TotalRequests += count.Requests
+		report.ByStatusClass[count.StatusClass] += count.Requests
+		report.ByRoute[count.Route] += count.Requests
+	}
+
+	return report, nil
+}
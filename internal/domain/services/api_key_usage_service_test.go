@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// MockAPIKeyUsageRepository is a mock implementation of APIKeyUsageRepository
+type MockAPIKeyUsageRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyUsageRepository) Increment(ctx context.Context, usage []*entities.APIKeyUsage) error {
+	args := m.Called(ctx, usage)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyUsageRepository) GetByAPIKey(ctx context.Context, apiKeyID uuid.UUID, granularity string, from, to time.Time) ([]*entities.APIKeyUsage, error) {
+	args := m.Called(ctx, apiKeyID, granularity, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.APIKeyUsage), args.Error(1)
+}
+
+// usageRequests returns the requests of the usage counts by granularity, period start, route and status class
+func usageRequests(usage []*entities.APIKeyUsage) map[string]int64 {
+	requests := make(map[string]int64)
+	for _, count := range usage {
+		requests[count.Granularity+" "+count.PeriodStart.Format(time.RFC3339)+" "+count.Route+" "+count.StatusClass] += count.Requests
+	}
+	return requests
+}
+
+func TestAPIKeyUsageService_Flush(t *testing.T) {
+	ctx := context.Background()
+	apiKeyID := uuid.New()
+	day := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	t.Run("requests are aggregated per hour and per day", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyUsageRepository)
+		service := NewAPIKeyUsageService(repo)
+		service.RecordRequest(apiKeyID, "/api/v1/banks/:id", 200, day.Add(10*time.Hour+5*time.Minute))
+		service.RecordRequest(apiKeyID, "/api/v1/banks/:id", 204, day.Add(10*time.Hour+50*time.Minute))
+		service.RecordRequest(apiKeyID, "/api/v1/banks/:id", 200, day.Add(11*time.Hour))
+		service.RecordRequest(apiKeyID, "/api/v1/banks/:id", 404, day.Add(11*time.Hour))
+
+		var stored []*entities.APIKeyUsage
+		repo.On("Increment", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.Get(1).([]*entities.APIKeyUsage)
+		}).Return(nil)
+
+		// When
+		requests, err := service.Flush(ctx)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), requests)
+		assert.Equal(t, map[string]int64{
+			"hour 2024-03-15T10:00:00Z /api/v1/banks/:id 2xx": 2,
+			"hour 2024-03-15T11:00:00Z /api/v1/banks/:id 2xx": 1,
+			"hour 2024-03-15T11:00:00Z /api/v1/banks/:id 4xx": 1,
+			"day 2024-03-15T00:00:00Z /api/v1/banks/:id 2xx":  3,
+			"day 2024-03-15T00:00:00Z /api/v1/banks/:id 4xx":  1,
+		}, usageRequests(stored))
+		for _, count := range stored {
+			assert.Equal(t, apiKeyID, count.APIKeyID)
+		}
+
+		// Nothing is left to flush
+		requests, err = service.Flush(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, requests)
+		repo.AssertNumberOfCalls(t, "Increment", 1)
+	})
+
+	t.Run("counts are kept when they cannot be stored", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyUsageRepository)
+		service := NewAPIKeyUsageService(repo)
+		service.RecordRequest(apiKeyID, "/api/v1/banks", 200, day)
+		repo.On("Increment", ctx, mock.Anything).Return(errors.New("connection refused")).Once()
+
+		// When
+		_, err := service.Flush(ctx)
+
+		// Then
+		require.Error(t, err)
+
+		service.RecordRequest(apiKeyID, "/api/v1/banks", 200, day)
+		var stored []*entities.APIKeyUsage
+		repo.On("Increment", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.Get(1).([]*entities.APIKeyUsage)
+		}).Return(nil).Once()
+
+		requests, err := service.Flush(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), requests)
+		assert.Equal(t, int64(2), usageRequests(stored)["hour 2024-03-15T00:00:00Z /api/v1/banks 2xx"])
+	})
+}
+
+func TestAPIKeyUsageService_GetUsage(t *testing.T) {
+	ctx := context.Background()
+	apiKeyID := uuid.New()
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	t.Run("daily usage with totals", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyUsageRepository)
+		service := NewAPIKeyUsageService(repo)
+		repo.On("GetByAPIKey", ctx, apiKeyID, entities.UsageGranularityDay, from, to).Return([]*entities.APIKeyUsage{
+			{APIKeyID: apiKeyID, Granularity: "day", PeriodStart: from, Route: "/api/v1/banks", StatusClass: "2xx", Requests: 10},
+			{APIKeyID: apiKeyID, Granularity: "day", PeriodStart: from, Route: "/api/v1/banks/:id", StatusClass: "4xx", Requests: 2},
+			{APIKeyID: apiKeyID, Granularity: "day", PeriodStart: from.AddDate(0, 0, 1), Route: "/api/v1/banks", StatusClass: "2xx", Requests: 5},
+		}, nil)
+
+		// When
+		report, err := service.GetUsage(ctx, apiKeyID, entities.UsageGranularityDay, from.Add(9*time.Hour), to)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, from, report.From)
+		assert.Equal(t, int64(17), report.TotalRequests)
+		assert.Equal(t, map[string]int64{"2xx": 15, "4xx": 2}, report.ByStatusClass)
+		assert.Equal(t, map[string]int64{"/api/v1/banks": 15, "/api/v1/banks/:id": 2}, report.ByRoute)
+		assert.Len(t, report.Usage, 3)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("hourly usage of the last 24 hours by default", func(t *testing.T) {
+		// Given
+		repo := new(MockAPIKeyUsageRepository)
+		service := NewAPIKeyUsageService(repo)
+		repo.On("GetByAPIKey", ctx, apiKeyID, entities.UsageGranularityHour, mock.Anything, mock.Anything).Return([]*entities.APIKeyUsage{}, nil)
+
+		// When
+		report, err := service.GetUsage(ctx, apiKeyID, "", time.Time{}, time.Time{})
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, entities.UsageGranularityHour, report.Granularity)
+		assert.WithinDuration(t, time.Now(), report.To, time.Minute)
+		assert.WithinDuration(t, time.Now().Add(-24*time.Hour), report.From, time.Hour)
+		assert.Zero(t, report.TotalRequests)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		repo := new(MockAPIKeyUsageRepository)
+		service := NewAPIKeyUsageService(repo)
+
+		_, err := service.GetUsage(ctx, apiKeyID, "minute", from, to)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.ErrorContains(t, err, "granularity 'minute'")
+
+		_, err = service.GetUsage(ctx, apiKeyID, entities.UsageGranularityHour, to, from)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.ErrorContains(t, err, "from must be before to")
+
+		_, err = service.GetUsage(ctx, apiKeyID, entities.UsageGranularityHour, from, from.AddDate(0, 2, 0))
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.ErrorContains(t, err, "at most 31 days of hourly usage")
+
+		repo.AssertNotCalled(t, "GetByAPIKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
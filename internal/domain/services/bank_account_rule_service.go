@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// accountNumberSeparators are stripped from account numbers before validation
+var accountNumberSeparators = strings.NewReplacer(" ", "", "-", "", ".", "")
+
+// BankAccountValidationResult holds the outcome of an account number validation
+type BankAccountValidationResult struct {
+	AccountNumber string                    `json:"account_number"`
+	Valid         bool                      `json:"valid"`
+	Errors        []string                  `json:"errors,omitempty"`
+	Bank          *entities.Bank            `json:"bank"`
+	Rule          *entities.BankAccountRule `json:"rule"`
+}
+
+// BankAccountRuleService implements business logic for bank account number rules
+type BankAccountRuleService struct {
+	ruleRepo repositories.BankAccountRuleRepository
+	bankRepo repositories.BankRepository
+}
+
+// NewBankAccountRuleService creates a new BankAccountRuleService instance
+func NewBankAccountRuleService(ruleRepo repositories.BankAccountRuleRepository, bankRepo repositories.BankRepository) *BankAccountRuleService {
+	return &BankAccountRuleService{
+		ruleRepo: ruleRepo,
+		bankRepo: bankRepo,
+	}
+}
+
+// GetRule retrieves the account number rules of the bank identified by its code
+func (s *BankAccountRuleService) GetRule(ctx context.Context, bankCode string) (*entities.BankAccountRule, error) {
+	bank, err := s.getBank(ctx, bankCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getRule(ctx, bank)
+}
+
+// GetAllRules retrieves the account number rules of all banks with pagination
+func (s *BankAccountRuleService) GetAllRules(ctx context.Context, limit, offset int) ([]*entities.BankAccountRule, error) {
+	return s.ruleRepo.GetAll(ctx, limit, offset)
+}
+
+// SaveRule creates or replaces the account number rules of a bank. It reports whether the rules were created.
+func (s *BankAccountRuleService) SaveRule(ctx context.Context, bankCode string, lengths []int, digitsOnly bool, checkDigitAlgorithm string, prefixPatterns []string) (*entities.BankAccountRule, bool, error) {
+	bank, err := s.getBank(ctx, bankCode)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := validateAccountRule(lengths, checkDigitAlgorithm, prefixPatterns); err != nil {
+		return nil, false, err
+	}
+
+	rule, err := s.ruleRepo.GetByBankID(ctx, bank.ID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return nil, false, fmt.Errorf("failed to get bank account rule: %w", err)
+	}
+
+	created := rule == nil
+	if created {
+		rule = entities.NewBankAccountRule(bank.ID, lengths, digitsOnly)
+	} else {
+		rule.SetLengths(lengths)
+		rule.SetDigitsOnly(digitsOnly)
+	}
+	rule.SetCheckDigitAlgorithm(checkDigitAlgorithm)
+	rule.SetPrefixPatterns(prefixPatterns)
+
+	if created {
+		err = s.ruleRepo.Create(ctx, rule)
+	} else {
+		err = s.ruleRepo.Update(ctx, rule)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to save bank account rule: %w", err)
+	}
+
+	return rule, created, nil
+}
+
+// DeleteRule deletes the account number rules of a bank
+func (s *BankAccountRuleService) DeleteRule(ctx context.Context, bankCode string) error {
+	bank, err := s.getBank(ctx, bankCode)
+	if err != nil {
+		return err
+	}
+
+	err = s.ruleRepo.DeleteByBankID(ctx, bank.ID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return fmt.Errorf("bank account rule %w: %s", ErrNotFound, bank.Code)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete bank account rule: %w", err)
+	}
+	return nil
+}
+
+// ValidateAccount validates an account number against the rules of a bank. Spaces, dashes and dots are
+// ignored. Validation failures are reported in the result; an error is returned when the bank or its
+// rules cannot be found.
+func (s *BankAccountRuleService) ValidateAccount(ctx context.Context, bankCode, accountNumber string) (*BankAccountValidationResult, error) {
+	bank, err := s.getBank(ctx, bankCode)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.getRule(ctx, bank)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BankAccountValidationResult{
+		AccountNumber: NormalizeAccountNumber(accountNumber),
+		Bank:          bank,
+		Rule:          rule,
+	}
+	result.Errors = rule.Validate(result.AccountNumber)
+	result.Valid = len(result.Errors) == 0
+
+	return result, nil
+}
+
+// getBank retrieves the bank the rules belong to by its code
+func (s *BankAccountRuleService) getBank(ctx context.Context, bankCode string) (*entities.Bank, error) {
+	bank, err := s.bankRepo.GetByCode(ctx, bankCode)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, fmt.Errorf("bank %w: %s", ErrNotFound, bankCode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bank: %w", err)
+	}
+	return bank, nil
+}
+
+// getRule retrieves the account number rules of a bank
+func (s *BankAccountRuleService) getRule(ctx context.Context, bank *entities.Bank) (*entities.BankAccountRule, error) {
+	rule, err := s.ruleRepo.GetByBankID(ctx, bank.ID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, fmt.Errorf("bank account rule %w: no account number rules defined for bank %s", ErrNotFound, bank.Code)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bank account rule: %w", err)
+	}
+	return rule, nil
+}
+
+// NormalizeAccountNumber removes the spaces, dashes and dots account numbers are often written with
+func NormalizeAccountNumber(accountNumber string) string {
+	return accountNumberSeparators.Replace(strings.TrimSpace(accountNumber))
+}
+
+// validateAccountRule checks the rule fields and reports the first invalid one
+func validateAccountRule(lengths []int, checkDigitAlgorithm string, prefixPatterns []string) error {
+	for _, length := range lengths {
+		if length <= 0 || length > 34 {
+			return fmt.Errorf("%w: account number length %d must be between 1 and 34", ErrInvalidInput, length)
+		}
+	}
+
+	switch strings.ToLower(checkDigitAlgorithm) {
+	case entities.CheckDigitNone, entities.CheckDigitLuhn, entities.CheckDigitMod11:
+	default:
+		return fmt.Errorf("%w: check-digit algorithm '%s' must be one of luhn, mod11", ErrInvalidInput, checkDigitAlgorithm)
+	}
+
+	for _, pattern := range prefixPatterns {
+		if pattern == "" {
+			return fmt.Errorf("%w: prefix pattern must not be empty", ErrInvalidInput)
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("%w: prefix pattern '%s': %v", ErrInvalidInput, pattern, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// MockBankAccountRuleRepository is a mock implementation of BankAccountRuleRepository
+type MockBankAccountRuleRepository struct {
+	mock.Mock
+}
+
+func (m *MockBankAccountRuleRepository) Create(ctx context.Context, rule *entities.BankAccountRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockBankAccountRuleRepository) GetByBankID(ctx context.Context, bankID uuid.UUID) (*entities.BankAccountRule, error) {
+	args := m.Called(ctx, bankID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.BankAccountRule), args.Error(1)
+}
+
+func (m *MockBankAccountRuleRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.BankAccountRule, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BankAccountRule), args.Error(1)
+}
+
+func (m *MockBankAccountRuleRepository) Update(ctx context.Context, rule *entities.BankAccountRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockBankAccountRuleRepository) DeleteByBankID(ctx context.Context, bankID uuid.UUID) error {
+	args := m.Called(ctx, bankID)
+	return args.Error(0)
+}
+
+func TestBankAccountRuleService_ValidateAccount(t *testing.T) {
+	bank := &entities.Bank{ID: uuid.New(), Name: "Bank Rakyat Indonesia", Code: "002"}
+	rule := entities.NewBankAccountRule(bank.ID, []int{15}, true)
+
+	t.Run("valid account number with separators", func(t *testing.T) {
+		// Given
+		ruleRepo := &MockBankAccountRuleRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewBankAccountRuleService(ruleRepo, bankRepo)
+		ctx := context.Background()
+
+		bankRepo.On("GetByCode", ctx, "002").Return(bank, nil)
+		ruleRepo.On("GetByBankID", ctx, bank.ID).Return(rule, nil)
+
+		// When
+		result, err := service.ValidateAccount(ctx, "002", "0123-01-000123-30-7")
+
+		// Then
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, "012301000123307", result.AccountNumber)
+		assert.Equal(t, bank, result.Bank)
+	})
+
+	t.Run("wrong length", func(t *testing.T) {
+		// Given
+		ruleRepo := &MockBankAccountRuleRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewBankAccountRuleService(ruleRepo, bankRepo)
+		ctx := context.Background()
+
+		bankRepo.On("GetByCode", ctx, "002").Return(bank, nil)
+		ruleRepo.On("GetByBankID", ctx, bank.ID).Return(rule, nil)
+
+		// When
+		result, err := service.ValidateAccount(ctx, "002", "1234567890")
+
+		// Then
+		require.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Equal(t, []string{"account number must be 15 digits long: 10 given"}, result.Errors)
+	})
+
+	t.Run("no rules for bank", func(t *testing.T) {
+		// Given
+		ruleRepo := &MockBankAccountRuleRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewBankAccountRuleService(ruleRepo, bankRepo)
+		ctx := context.Background()
+
+		bankRepo.On("GetByCode", ctx, "002").Return(bank, nil)
+		ruleRepo.On("GetByBankID", ctx, bank.ID).Return(nil, fmt.Errorf("bank account rule %w", repositories.ErrNotFound))
+
+		// When
+		result, err := service.ValidateAccount(ctx, "002", "1234567890")
+
+		// Then
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestBankAccountRuleService_SaveRule(t *testing.T) {
+	bank := &entities.Bank{ID: uuid.New(), Name: "Bank Negara Indonesia", Code: "009"}
+
+	t.Run("creates rules", func(t *testing.T) {
+		// Given
+		ruleRepo := &MockBankAccountRuleRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewBankAccountRuleService(ruleRepo, bankRepo)
+		ctx := context.Background()
+
+		bankRepo.On("GetByCode", ctx, "009").Return(bank, nil)
+		ruleRepo.On("GetByBankID", ctx, bank.ID).Return(nil, fmt.Errorf("bank account rule %w", repositories.ErrNotFound))
+		ruleRepo.On("Create", ctx, mock.AnythingOfType("*entities.BankAccountRule")).Return(nil)
+
+		// When
+		rule, created, err := service.SaveRule(ctx, "009", []int{10}, true, "luhn", []string{"0"})
+
+		// Then
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, bank.ID, rule.BankID)
+		assert.Equal(t, entities.CheckDigitLuhn, rule.CheckDigitAlgorithm)
+		assert.Equal(t, []string{"0"}, rule.PrefixPatterns)
+		ruleRepo.AssertExpectations(t)
+	})
+
+	t.Run("replaces existing rules", func(t *testing.T) {
+		// Given
+		ruleRepo := &MockBankAccountRuleRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewBankAccountRuleService(ruleRepo, bankRepo)
+		ctx := context.Background()
+		existing := entities.NewBankAccountRule(bank.ID, []int{12}, false)
+
+		bankRepo.On("GetByCode", ctx, "009").Return(bank, nil)
+		ruleRepo.On("GetByBankID", ctx, bank.ID).Return(existing, nil)
+		ruleRepo.On("Update", ctx, existing).Return(nil)
+
+		// When
+		rule, created, err := service.SaveRule(ctx, "009", []int{10}, true, "", nil)
+
+		// Then
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, existing.ID, rule.ID)
+		assert.Equal(t, []int{10}, rule.Lengths)
+		assert.True(t, rule.DigitsOnly)
+		ruleRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			lengths   []int
+			algorithm string
+			patterns  []string
+		}{
+			{"zero length", []int{0}, "", nil},
+			{"unknown algorithm", []int{10}, "crc32", nil},
+			{"bad pattern", []int{10}, "", []string{"[0-9"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// Given
+				ruleRepo := &MockBankAccountRuleRepository{}
+				bankRepo := &MockBankRepository{}
+				service := NewBankAccountRuleService(ruleRepo, bankRepo)
+				ctx := context.Background()
+
+				bankRepo.On("GetByCode", ctx, "009").Return(bank, nil)
+
+				// When
+				rule, _, err := service.SaveRule(ctx, "009", tt.lengths, true, tt.algorithm, tt.patterns)
+
+				// Then
+				assert.ErrorIs(t, err, ErrInvalidInput)
+				assert.Nil(t, rule)
+				assert.Contains(t, err.Error(), "invalid")
+				ruleRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// BankBranchService implements business logic for bank branch operations
+type BankBranchService struct {
+	branchRepo       repositories.BankBranchRepository
+	bankRepo         repositories.BankRepository
+	geodirectoryRepo repositories.GeodirectoryRepository
+}
+
+// NewBankBranchService creates a new BankBranchService instance
+func NewBankBranchService(
+	branchRepo repositories.BankBranchRepository,
+	bankRepo repositories.BankRepository,
+	geodirectoryRepo repositories.GeodirectoryRepository,
+) *BankBranchService {
+	return &BankBranchService{
+		branchRepo:       branchRepo,
+		bankRepo:         bankRepo,
+		geodirectoryRepo: geodirectoryRepo,
+	}
+}
+
+// CreateBranch creates a new branch for the bank identified by its code. The BIC and geodirectory are optional.
+func (s *BankBranchService) CreateBranch(ctx context.Context, bankCode, name, code, address, bic string, geodirectoryID *uuid.UUID) (*entities.BankBranch, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%w: branch name is required", ErrInvalidInput)
+	}
+	if code == "" {
+		return nil, fmt.Errorf("%w: branch code is required", ErrInvalidInput)
+	}
+
+	bank, err := s.getBank(ctx, bankCode)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := entities.NewBankBranch(bank.ID, name, code, address)
+
+	if bic != "" {
+		bicValue, err := valueobjects.NewBIC(bic)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		branch.SetBIC(bicValue)
+	}
+
+	if geodirectoryID != nil {
+		if err := s.validateGeodirectory(ctx, *geodirectoryID); err != nil {
+			return nil, err
+		}
+		branch.SetGeodirectory(geodirectoryID)
+	}
+
+	// Check if the bank already has a branch with the same code
+	exists, err := s.branchRepo.ExistsByBankAndCode(ctx, bank.ID, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check branch code existence: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("branch with code '%s' %w", code, ErrAlreadyExists)
+	}
+
+	if err := s.branchRepo.Create(ctx, branch); err != nil {
+		return nil, fmt.Errorf("failed to create bank branch: %w", err)
+	}
+
+	return branch, nil
+}
+
+// GetBranchesByBank retrieves the branches of a bank. When geodirectoryID is set, only branches
+// located in that geodirectory node or any of its descendants are returned.
+func (s *BankBranchService) GetBranchesByBank(ctx context.Context, bankCode string, geodirectoryID *uuid.UUID, limit, offset int) ([]*entities.BankBranch, error) {
+	bank, err := s.getBank(ctx, bankCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if geodirectoryID != nil {
+		return s.branchRepo.GetByBankWithinGeodirectory(ctx, bank.ID, *geodirectoryID, limit, offset)
+	}
+
+	return s.branchRepo.GetByBank(ctx, bank.ID, limit, offset)
+}
+
+// GetBranchByCode retrieves a branch by bank code and branch code
+func (s *BankBranchService) GetBranchByCode(ctx context.Context, bankCode, branchCode string) (*entities.BankBranch, error) {
+	bank, err := s.getBank(ctx, bankCode)
+	if err != nil {
+		return nil, err
+	}
+
+	branch, err := s.branchRepo.GetByBankAndCode(ctx, bank.ID, branchCode)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, fmt.Errorf("bank branch %w: %s", ErrNotFound, branchCode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bank branch: %w", err)
+	}
+
+	return branch, nil
+}
+
+// GetAllBranches retrieves all bank branches with pagination
+func (s *BankBranchService) GetAllBranches(ctx context.Context, limit, offset int) ([]*entities.BankBranch, error) {
+	return s.branchRepo.GetAll(ctx, limit, offset)
+}
+
+// UpdateBranch updates an existing bank branch
+func (s *BankBranchService) UpdateBranch(ctx context.Context, branch *entities.BankBranch) error {
+	if !branch.IsValid() {
+		return fmt.Errorf("%w: branch name and code are required", ErrInvalidInput)
+	}
+
+	// Normalize the BIC so that lookups by 8-character prefix keep working
+	bicValue, err := branch.GetBIC()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if bicValue != nil {
+		branch.SetBIC(bicValue)
+	}
+
+	if branch.GeodirectoryID != nil {
+		if err := s.validateGeodirectory(ctx, *branch.GeodirectoryID); err != nil {
+			return err
+		}
+	}
+
+	// Check if updating to a code that already exists (but not for the same branch)
+	existing, err := s.branchRepo.GetByBankAndCode(ctx, branch.BankID, branch.Code)
+	if err == nil && existing.ID != branch.ID {
+		return fmt.Errorf("branch with code '%s' %w", branch.Code, ErrAlreadyExists)
+	}
+
+	return s.branchRepo.Update(ctx, branch)
+}
+
+// DeleteBranch deletes a bank branch by ID
+func (s *BankBranchService) DeleteBranch(ctx context.Context, id uuid.UUID) error {
+	return s.branchRepo.Delete(ctx, id)
+}
+
+// validateGeodirectory checks that the geodirectory node a branch is located in exists
+func (s *BankBranchService) validateGeodirectory(ctx context.Context, geodirectoryID uuid.UUID) error {
+	_, err := s.geodirectoryRepo.GetByID(ctx, geodirectoryID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return fmt.Errorf("%w: geodirectory %s not found", ErrInvalidInput, geodirectoryID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get geodirectory: %w", err)
+	}
+	return nil
+}
+
+// getBank retrieves the bank a branch belongs to by its code
+func (s *BankBranchService) getBank(ctx context.Context, bankCode string) (*entities.Bank, error) {
+	bank, err := s.bankRepo.GetByCode(ctx, bankCode)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, fmt.Errorf("bank %w: %s", ErrNotFound, bankCode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bank: %w", err)
+	}
+	return bank, nil
+}
@@ -0,0 +1,308 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// MockBankBranchRepository is a mock implementation of BankBranchRepository
+type MockBankBranchRepository struct {
+	mock.Mock
+}
+
+func (m *MockBankBranchRepository) Create(ctx context.Context, branch *entities.BankBranch) error {
+	args := m.Called(ctx, branch)
+	return args.Error(0)
+}
+
+func (m *MockBankBranchRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.BankBranch, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.BankBranch), args.Error(1)
+}
+
+func (m *MockBankBranchRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.BankBranch, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BankBranch), args.Error(1)
+}
+
+func (m *MockBankBranchRepository) Update(ctx context.Context, branch *entities.BankBranch) error {
+	args := m.Called(ctx, branch)
+	return args.Error(0)
+}
+
+func (m *MockBankBranchRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockBankBranchRepository) CountByBank(ctx context.Context, bankID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, bankID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockBankBranchRepository) GetByBankAndCode(ctx context.Context, bankID uuid.UUID, code string) (*entities.BankBranch, error) {
+	args := m.Called(ctx, bankID, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.BankBranch), args.Error(1)
+}
+
+func (m *MockBankBranchRepository) GetByBank(ctx context.Context, bankID uuid.UUID, limit, offset int) ([]*entities.BankBranch, error) {
+	args := m.Called(ctx, bankID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BankBranch), args.Error(1)
+}
+
+func (m *MockBankBranchRepository) GetByBankWithinGeodirectory(ctx context.Context, bankID, geodirectoryID uuid.UUID, limit, offset int) ([]*entities.BankBranch, error) {
+	args := m.Called(ctx, bankID, geodirectoryID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BankBranch), args.Error(1)
+}
+
+func (m *MockBankBranchRepository) ExistsByBankAndCode(ctx context.Context, bankID uuid.UUID, code string) (bool, error) {
+	args := m.Called(ctx, bankID, code)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestBankBranchService_CreateBranch(t *testing.T) {
+	t.Run("successful creation", func(t *testing.T) {
+		// Given
+		branchRepo := &MockBankBranchRepository{}
+		bankRepo := &MockBankRepository{}
+		geoRepo := &MockGeodirectoryLookupRepository{}
+		service := NewBankBranchService(branchRepo, bankRepo, geoRepo)
+		ctx := context.Background()
+
+		bank := &entities.Bank{ID: uuid.New(), Name: "Bank Central Asia", Code: "014"}
+		cityID := uuid.New()
+
+		bankRepo.On("GetByCode", ctx, "014").Return(bank, nil)
+		geoRepo.On("GetByID", ctx, cityID).Return(&entities.Geodirectory{ID: cityID, Type: entities.GeoTypeCity}, nil)
+		branchRepo.On("ExistsByBankAndCode", ctx, bank.ID, "0081").Return(false, nil)
+		branchRepo.On("Create", ctx, mock.AnythingOfType("*entities.BankBranch")).Return(nil)
+
+		// When
+		branch, err := service.CreateBranch(ctx, "014", "KCU Bandung", "0081", "Jl. Asia Afrika", "cenaidja081", &cityID)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, bank.ID, branch.BankID)
+		assert.Equal(t, &cityID, branch.GeodirectoryID)
+		require.NotNil(t, branch.BIC)
+		assert.Equal(t, "CENAIDJA081", *branch.BIC)
+		branchRepo.AssertExpectations(t)
+		bankRepo.AssertExpectations(t)
+		geoRepo.AssertExpectations(t)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		// Given
+		service := NewBankBranchService(&MockBankBranchRepository{}, &MockBankRepository{}, &MockGeodirectoryLookupRepository{})
+
+		// When
+		branch, err := service.CreateBranch(context.Background(), "014", "", "0081", "", "", nil)
+
+		// Then
+		assert.Error(t, err)
+		assert.Nil(t, branch)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "branch name is required")
+	})
+
+	t.Run("unknown bank", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		service := NewBankBranchService(&MockBankBranchRepository{}, bankRepo, &MockGeodirectoryLookupRepository{})
+		ctx := context.Background()
+
+		bankRepo.On("GetByCode", ctx, "999").Return(nil, fmt.Errorf("bank %w", repositories.ErrNotFound))
+
+		// When
+		branch, err := service.CreateBranch(ctx, "999", "Branch", "0081", "", "", nil)
+
+		// Then
+		assert.Error(t, err)
+		assert.Nil(t, branch)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Contains(t, err.Error(), "bank not found")
+	})
+
+	t.Run("unknown geodirectory", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		geoRepo := &MockGeodirectoryLookupRepository{}
+		service := NewBankBranchService(&MockBankBranchRepository{}, bankRepo, geoRepo)
+		ctx := context.Background()
+		cityID := uuid.New()
+
+		bankRepo.On("GetByCode", ctx, "014").Return(&entities.Bank{ID: uuid.New(), Code: "014"}, nil)
+		geoRepo.On("GetByID", ctx, cityID).Return(nil, fmt.Errorf("geodirectory %w", repositories.ErrNotFound))
+
+		// When
+		branch, err := service.CreateBranch(ctx, "014", "Branch", "0081", "", "", &cityID)
+
+		// Then
+		assert.Error(t, err)
+		assert.Nil(t, branch)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "not found")
+	})
+
+	t.Run("duplicate code", func(t *testing.T) {
+		// Given
+		branchRepo := &MockBankBranchRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewBankBranchService(branchRepo, bankRepo, &MockGeodirectoryLookupRepository{})
+		ctx := context.Background()
+		bank := &entities.Bank{ID: uuid.New(), Code: "014"}
+
+		bankRepo.On("GetByCode", ctx, "014").Return(bank, nil)
+		branchRepo.On("ExistsByBankAndCode", ctx, bank.ID, "0081").Return(true, nil)
+
+		// When
+		branch, err := service.CreateBranch(ctx, "014", "Branch", "0081", "", "", nil)
+
+		// Then
+		assert.Nil(t, branch)
+		assert.ErrorIs(t, err, ErrAlreadyExists)
+		branchRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestBankBranchService_GetBranchesByBank(t *testing.T) {
+	t.Run("all branches of a bank", func(t *testing.T) {
+		// Given
+		branchRepo := &MockBankBranchRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewBankBranchService(branchRepo, bankRepo, &MockGeodirectoryLookupRepository{})
+		ctx := context.Background()
+		bank := &entities.Bank{ID: uuid.New(), Code: "014"}
+		expected := []*entities.BankBranch{entities.NewBankBranch(bank.ID, "KCU Bandung", "0081", "")}
+
+		bankRepo.On("GetByCode", ctx, "014").Return(bank, nil)
+		branchRepo.On("GetByBank", ctx, bank.ID, 50, 0).Return(expected, nil)
+
+		// When
+		branches, err := service.GetBranchesByBank(ctx, "014", nil, 50, 0)
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, expected, branches)
+		branchRepo.AssertExpectations(t)
+	})
+
+	t.Run("branches within a city", func(t *testing.T) {
+		// Given
+		branchRepo := &MockBankBranchRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewBankBranchService(branchRepo, bankRepo, &MockGeodirectoryLookupRepository{})
+		ctx := context.Background()
+		bank := &entities.Bank{ID: uuid.New(), Code: "014"}
+		cityID := uuid.New()
+		expected := []*entities.BankBranch{entities.NewBankBranch(bank.ID, "KCU Bandung", "0081", "")}
+
+		bankRepo.On("GetByCode", ctx, "014").Return(bank, nil)
+		branchRepo.On("GetByBankWithinGeodirectory", ctx, bank.ID, cityID, 50, 0).Return(expected, nil)
+
+		// When
+		branches, err := service.GetBranchesByBank(ctx, "014", &cityID, 50, 0)
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, expected, branches)
+		branchRepo.AssertExpectations(t)
+	})
+}
+
+func TestBankBranchService_GetBranchByCode(t *testing.T) {
+	t.Run("unknown branch", func(t *testing.T) {
+		// Given
+		branchRepo := &MockBankBranchRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewBankBranchService(branchRepo, bankRepo, &MockGeodirectoryLookupRepository{})
+		ctx := context.Background()
+		bank := &entities.Bank{ID: uuid.New(), Code: "014"}
+
+		bankRepo.On("GetByCode", ctx, "014").Return(bank, nil)
+		branchRepo.On("GetByBankAndCode", ctx, bank.ID, "9999").Return(nil, fmt.Errorf("bank branch %w", repositories.ErrNotFound))
+
+		// When
+		branch, err := service.GetBranchByCode(ctx, "014", "9999")
+
+		// Then
+		assert.Nil(t, branch)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("repository failure is not reported as not found", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		service := NewBankBranchService(&MockBankBranchRepository{}, bankRepo, &MockGeodirectoryLookupRepository{})
+		ctx := context.Background()
+
+		bankRepo.On("GetByCode", ctx, "014").Return(nil, errors.New("connection refused"))
+
+		// When
+		branch, err := service.GetBranchByCode(ctx, "014", "0081")
+
+		// Then
+		assert.Nil(t, branch)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestBankBranchService_UpdateBranch(t *testing.T) {
+	t.Run("duplicate code", func(t *testing.T) {
+		// Given
+		branchRepo := &MockBankBranchRepository{}
+		service := NewBankBranchService(branchRepo, &MockBankRepository{}, &MockGeodirectoryLookupRepository{})
+		ctx := context.Background()
+		branch := entities.NewBankBranch(uuid.New(), "Branch", "0082", "")
+		other := entities.NewBankBranch(branch.BankID, "Other", "0082", "")
+
+		branchRepo.On("GetByBankAndCode", ctx, branch.BankID, "0082").Return(other, nil)
+
+		// When
+		err := service.UpdateBranch(ctx, branch)
+
+		// Then
+		assert.ErrorIs(t, err, ErrAlreadyExists)
+		branchRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid BIC", func(t *testing.T) {
+		// Given
+		service := NewBankBranchService(&MockBankBranchRepository{}, &MockBankRepository{}, &MockGeodirectoryLookupRepository{})
+		branch := entities.NewBankBranch(uuid.New(), "Branch", "0082", "")
+		invalid := "NOTABIC"
+		branch.BIC = &invalid
+
+		// When
+		err := service.UpdateBranch(context.Background(), branch)
+
+		// Then
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "BIC")
+	})
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// maxSuccessorDepth limits how many successor links are followed when resolving a bank
+const maxSuccessorDepth = 10
+
+// BankHistory holds the lifecycle of a bank: its former identities, the banks it absorbed and its successor
+type BankHistory struct {
+	Bank         *entities.Bank                `json:"bank"`
+	Records      []*entities.BankHistoryRecord `json:"records"`
+	Predecessors []*entities.Bank              `json:"predecessors"`
+}
+
+// BankLifecycleService implements business logic for bank mergers, closures, renames and their history
+type BankLifecycleService struct {
+	bankRepo    repositories.BankRepository
+	historyRepo repositories.BankHistoryRepository
+}
+
+// NewBankLifecycleService creates a new BankLifecycleService instance
+func NewBankLifecycleService(bankRepo repositories.BankRepository, historyRepo repositories.BankHistoryRepository) *BankLifecycleService {
+	return &BankLifecycleService{
+		bankRepo:    bankRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+// ResolveBankByCode retrieves a bank by its current or any former code. Merged and closed banks are
+// returned with their successor chain attached instead of failing the lookup.
+func (s *BankLifecycleService) ResolveBankByCode(ctx context.Context, code string) (*entities.Bank, error) {
+	bank, err := s.bankRepo.GetByCode(ctx, code)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return nil, fmt.Errorf("failed to get bank: %w", err)
+		}
+
+		// The code may have been changed; resolve it through the history of former codes
+		record, err := s.historyRepo.GetLatestByCode(ctx, code)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, fmt.Errorf("bank %w: %s", ErrNotFound, code)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bank history: %w", err)
+		}
+
+		bank, err = s.bankRepo.GetByID(ctx, record.BankID)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, fmt.Errorf("bank %w: %s", ErrNotFound, code)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bank: %w", err)
+		}
+	}
+
+	if err := s.attachSuccessors(ctx, bank); err != nil {
+		return nil, err
+	}
+
+	return bank, nil
+}
+
+// GetBankHistory retrieves the lifecycle of a bank identified by its current or any former code
+func (s *BankLifecycleService) GetBankHistory(ctx context.Context, code string) (*BankHistory, error) {
+	bank, err := s.ResolveBankByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.historyRepo.GetByBank(ctx, bank.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bank history: %w", err)
+	}
+
+	predecessors, err := s.bankRepo.GetPredecessors(ctx, bank.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor banks: %w", err)
+	}
+
+	return &BankHistory{
+		Bank:         bank,
+		Records:      records,
+		Predecessors: predecessors,
+	}, nil
+}
+
+// ChangeStatus changes the lifecycle status of a bank as of the given date. A merged bank needs a
+// successor, a closed bank may have one and an active bank has none. The former state is kept in the history.
+func (s *BankLifecycleService) ChangeStatus(ctx context.Context, code, status, successorCode string, at time.Time) (*entities.Bank, error) {
+	bank, err := s.bankRepo.GetByCode(ctx, code)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, fmt.Errorf("bank %w: %s", ErrNotFound, code)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bank: %w", err)
+	}
+	previous := *bank
+
+	var successor *entities.Bank
+	if successorCode != "" {
+		successor, err = s.bankRepo.GetByCode(ctx, successorCode)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, fmt.Errorf("%w: successor bank '%s' not found", ErrInvalidInput, successorCode)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get successor bank: %w", err)
+		}
+		if err := s.validateSuccessor(ctx, bank, successor); err != nil {
+			return nil, err
+		}
+	}
+
+	switch strings.ToLower(status) {
+	case entities.BankStatusActive:
+		if successor != nil {
+			return nil, fmt.Errorf("%w: an active bank cannot have a successor", ErrInvalidInput)
+		}
+		bank.Reactivate()
+	case entities.BankStatusMerged:
+		if successor == nil {
+			return nil, fmt.Errorf("%w: a merged bank requires a successor bank", ErrInvalidInput)
+		}
+		bank.MarkMerged(successor.ID, at)
+	case entities.BankStatusClosed:
+		if successor != nil {
+			bank.MarkClosed(&successor.ID, at)
+		} else {
+			bank.MarkClosed(nil, at)
+		}
+	default:
+		return nil, fmt.Errorf("%w: status '%s' must be one of active, merged, closed", ErrInvalidInput, status)
+	}
+
+	if err := s.SaveChange(ctx, &previous, bank, at); err != nil {
+		return nil, fmt.Errorf("failed to update bank status: %w", err)
+	}
+
+	if err := s.attachSuccessors(ctx, bank); err != nil {
+		return nil, err
+	}
+
+	return bank, nil
+}
+
+// SaveChange stores a changed bank and, when its identity changed, e.g. after a rename, a code change or
+// a status change effective at the given time, its previous state in its history. Both are stored in
+// one transaction, so the former name and code stay resolvable.
+func (s *BankLifecycleService) SaveChange(ctx context.Context, previous, current *entities.Bank, at time.Time) error {
+	var record *entities.BankHistoryRecord
+	if entities.IdentityChanged(previous, current) {
+		// The previous state started where the last recorded one ended, or when the bank became effective
+		validFrom := previous.EffectiveFrom
+		records, err := s.historyRepo.GetByBank(ctx, previous.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get bank history: %w", err)
+		}
+		if len(records) > 0 {
+			validFrom = &records[0].ValidUntil
+		}
+
+		record = entities.NewBankHistoryRecord(previous, validFrom, at)
+	}
+
+	return s.bankRepo.UpdateWithHistory(ctx, current, record)
+}
+
+// validateSuccessor makes sure the successor is another bank and that following its successors never leads back
+func (s *BankLifecycleService) validateSuccessor(ctx context.Context, bank, successor *entities.Bank) error {
+	current := successor
+	for depth := 0; depth < maxSuccessorDepth; depth++ {
+		if current.ID == bank.ID {
+			return fmt.Errorf("%w: bank '%s' cannot succeed itself", ErrInvalidInput, bank.Code)
+		}
+		if current.SuccessorID == nil {
+			return nil
+		}
+
+		next, err := s.bankRepo.GetByID(ctx, *current.SuccessorID)
+		if err != nil {
+			return fmt.Errorf("failed to get successor bank: %w", err)
+		}
+		current = next
+	}
+
+	return fmt.Errorf("%w: successor chain exceeds %d banks", ErrInvalidInput, maxSuccessorDepth)
+}
+
+// attachSuccessors follows the successor links of a bank and attaches each successor to its predecessor
+func (s *BankLifecycleService) attachSuccessors(ctx context.Context, bank *entities.Bank) error {
+	visited := map[string]bool{bank.ID.String(): true}
+
+	current := bank
+	for depth := 0; depth < maxSuccessorDepth && current.SuccessorID != nil; depth++ {
+		if visited[current.SuccessorID.String()] {
+			break
+		}
+
+		successor, err := s.bankRepo.GetByID(ctx, *current.SuccessorID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				break
+			}
+			return fmt.Errorf("failed to get successor bank: %w", err)
+		}
+
+		visited[successor.ID.String()] = true
+		current.Successor = successor
+		current = successor
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// MockBankHistoryRepository is a mock implementation of BankHistoryRepository
+type MockBankHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockBankHistoryRepository) Create(ctx context.Context, record *entities.BankHistoryRecord) error {
+	args := m.Called(ctx, record)
+	return args.Error(0)
+}
+
+func (m *MockBankHistoryRepository) GetByBank(ctx context.Context, bankID uuid.UUID) ([]*entities.BankHistoryRecord, error) {
+	args := m.Called(ctx, bankID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BankHistoryRecord), args.Error(1)
+}
+
+func (m *MockBankHistoryRepository) GetLatestByCode(ctx context.Context, code string) (*entities.BankHistoryRecord, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.BankHistoryRecord), args.Error(1)
+}
+
+func TestBankLifecycleService_ResolveBankByCode(t *testing.T) {
+	t.Run("merged bank with successor", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		service := NewBankLifecycleService(bankRepo, &MockBankHistoryRepository{})
+		ctx := context.Background()
+
+		successor := entities.NewBank("Bank Syariah Indonesia", "BSI", "PT Bank Syariah Indonesia", "451")
+		merged := entities.NewBank("BNI Syariah", "BNIS", "PT Bank BNI Syariah", "427")
+		merged.MarkMerged(successor.ID, time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC))
+
+		bankRepo.On("GetByCode", ctx, "427").Return(merged, nil)
+		bankRepo.On("GetByID", ctx, successor.ID).Return(successor, nil)
+
+		// When
+		bank, err := service.ResolveBankByCode(ctx, "427")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, merged.ID, bank.ID)
+		assert.Equal(t, successor, bank.Successor)
+		bankRepo.AssertExpectations(t)
+	})
+
+	t.Run("former code", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		historyRepo := &MockBankHistoryRepository{}
+		service := NewBankLifecycleService(bankRepo, historyRepo)
+		ctx := context.Background()
+
+		bank := entities.NewBank("Bank Syariah Indonesia", "BSI", "PT Bank Syariah Indonesia", "451")
+		record := entities.NewBankHistoryRecord(bank, nil, time.Now())
+		record.Code = "451X"
+
+		bankRepo.On("GetByCode", ctx, "451X").Return(nil, fmt.Errorf("bank %w", repositories.ErrNotFound))
+		historyRepo.On("GetLatestByCode", ctx, "451X").Return(record, nil)
+		bankRepo.On("GetByID", ctx, bank.ID).Return(bank, nil)
+
+		// When
+		resolved, err := service.ResolveBankByCode(ctx, "451X")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, bank, resolved)
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		historyRepo := &MockBankHistoryRepository{}
+		service := NewBankLifecycleService(bankRepo, historyRepo)
+		ctx := context.Background()
+
+		bankRepo.On("GetByCode", ctx, "000").Return(nil, fmt.Errorf("bank %w", repositories.ErrNotFound))
+		historyRepo.On("GetLatestByCode", ctx, "000").Return(nil, fmt.Errorf("bank history %w", repositories.ErrNotFound))
+
+		// When
+		bank, err := service.ResolveBankByCode(ctx, "000")
+
+		// Then
+		assert.Nil(t, bank)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.EqualError(t, err, "bank not found: 000")
+	})
+}
+
+func TestBankLifecycleService_ChangeStatus(t *testing.T) {
+	mergedAt := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("merge into successor", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		historyRepo := &MockBankHistoryRepository{}
+		service := NewBankLifecycleService(bankRepo, historyRepo)
+		ctx := context.Background()
+
+		bank := entities.NewBank("BRI Syariah", "BRIS", "PT Bank BRIsyariah", "422")
+		successor := entities.NewBank("Bank Syariah Indonesia", "BSI", "PT Bank Syariah Indonesia", "451")
+
+		bankRepo.On("GetByCode", ctx, "422").Return(bank, nil)
+		bankRepo.On("GetByCode", ctx, "451").Return(successor, nil)
+		bankRepo.On("GetByID", ctx, successor.ID).Return(successor, nil)
+		historyRepo.On("GetByBank", ctx, bank.ID).Return([]*entities.BankHistoryRecord{}, nil)
+		bankRepo.On("UpdateWithHistory", ctx, bank, mock.MatchedBy(func(record *entities.BankHistoryRecord) bool {
+			return record.BankID == bank.ID && record.Status == entities.BankStatusActive && record.ValidUntil.Equal(mergedAt)
+		})).Return(nil)
+
+		// When
+		updated, err := service.ChangeStatus(ctx, "422", "merged", "451", mergedAt)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, entities.BankStatusMerged, updated.Status)
+		assert.Equal(t, &successor.ID, updated.SuccessorID)
+		assert.Equal(t, successor, updated.Successor)
+		bankRepo.AssertExpectations(t)
+		historyRepo.AssertExpectations(t)
+	})
+
+	t.Run("merge without successor", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		service := NewBankLifecycleService(bankRepo, &MockBankHistoryRepository{})
+		ctx := context.Background()
+
+		bankRepo.On("GetByCode", ctx, "422").Return(entities.NewBank("BRI Syariah", "BRIS", "PT Bank BRIsyariah", "422"), nil)
+
+		// When
+		bank, err := service.ChangeStatus(ctx, "422", "merged", "", mergedAt)
+
+		// Then
+		assert.Nil(t, bank)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "requires a successor")
+		bankRepo.AssertNotCalled(t, "UpdateWithHistory", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("successor cycle", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		service := NewBankLifecycleService(bankRepo, &MockBankHistoryRepository{})
+		ctx := context.Background()
+
+		bank := entities.NewBank("Bank A", "A", "PT Bank A", "001")
+		successor := entities.NewBank("Bank B", "B", "PT Bank B", "002")
+		successor.MarkMerged(bank.ID, mergedAt)
+
+		bankRepo.On("GetByCode", ctx, "001").Return(bank, nil)
+		bankRepo.On("GetByCode", ctx, "002").Return(successor, nil)
+		bankRepo.On("GetByID", ctx, bank.ID).Return(bank, nil)
+
+		// When
+		updated, err := service.ChangeStatus(ctx, "001", "merged", "002", mergedAt)
+
+		// Then
+		assert.Nil(t, updated)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "cannot succeed itself")
+		bankRepo.AssertNotCalled(t, "UpdateWithHistory", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		service := NewBankLifecycleService(bankRepo, &MockBankHistoryRepository{})
+		ctx := context.Background()
+
+		bankRepo.On("GetByCode", ctx, "001").Return(entities.NewBank("Bank A", "A", "PT Bank A", "001"), nil)
+
+		// When
+		bank, err := service.ChangeStatus(ctx, "001", "renamed", "", mergedAt)
+
+		// Then
+		assert.Nil(t, bank)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "status 'renamed'")
+	})
+}
+
+func TestBankLifecycleService_SaveChange(t *testing.T) {
+	t.Run("records the former name with the update", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		historyRepo := &MockBankHistoryRepository{}
+		service := NewBankLifecycleService(bankRepo, historyRepo)
+		ctx := context.Background()
+
+		previous := entities.NewBank("Bank Tabungan Pensiunan Nasional", "BTPN", "PT Bank BTPN", "213")
+		current := *previous
+		current.Name = "Bank SMBC Indonesia"
+		lastChange := time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC)
+		renamedAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+		historyRepo.On("GetByBank", ctx, previous.ID).Return([]*entities.BankHistoryRecord{
+			{BankID: previous.ID, ValidUntil: lastChange},
+		}, nil)
+		bankRepo.On("UpdateWithHistory", ctx, &current, mock.MatchedBy(func(record *entities.BankHistoryRecord) bool {
+			return record.Name == "Bank Tabungan Pensiunan Nasional" &&
+				record.ValidFrom != nil && record.ValidFrom.Equal(lastChange) &&
+				record.ValidUntil.Equal(renamedAt)
+		})).Return(nil)
+
+		// When
+		err := service.SaveChange(ctx, previous, &current, renamedAt)
+
+		// Then
+		assert.NoError(t, err)
+		bankRepo.AssertExpectations(t)
+		historyRepo.AssertExpectations(t)
+	})
+
+	t.Run("no identity change", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		historyRepo := &MockBankHistoryRepository{}
+		service := NewBankLifecycleService(bankRepo, historyRepo)
+		ctx := context.Background()
+		bank := entities.NewBank("Bank A", "A", "PT Bank A", "001")
+
+		bankRepo.On("UpdateWithHistory", ctx, bank, (*entities.BankHistoryRecord)(nil)).Return(nil)
+
+		// When
+		err := service.SaveChange(ctx, bank, bank, time.Now())
+
+		// Then
+		assert.NoError(t, err)
+		bankRepo.AssertExpectations(t)
+		historyRepo.AssertNotCalled(t, "GetByBank", mock.Anything, mock.Anything)
+	})
+
+	t.Run("update failure", func(t *testing.T) {
+		// Given
+		bankRepo := &MockBankRepository{}
+		historyRepo := &MockBankHistoryRepository{}
+		service := NewBankLifecycleService(bankRepo, historyRepo)
+		ctx := context.Background()
+
+		previous := entities.NewBank("Bank A", "A", "PT Bank A", "001")
+		current := *previous
+		current.Code = "002"
+
+		historyRepo.On("GetByBank", ctx, previous.ID).Return([]*entities.BankHistoryRecord{}, nil)
+		bankRepo.On("UpdateWithHistory", ctx, &current, mock.Anything).Return(errors.New("failed to record bank history"))
+
+		// When
+		err := service.SaveChange(ctx, previous, &current, time.Now())
+
+		// Then
+		assert.Error(t, err)
+	})
+}
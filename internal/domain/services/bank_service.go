@@ -3,10 +3,12 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 // BankService implements business logic for bank operations
@@ -21,14 +23,23 @@ func NewBankService(bankRepo repositories.BankRepository) *BankService {
 	}
 }
 
-// CreateBank creates a new bank with validation
-func (s *BankService) CreateBank(ctx context.Context, name, alias, company, code string) (*entities.Bank, error) {
+// CreateBank creates a new bank with validation. The BIC is optional and may be empty.
+func (s *BankService) CreateBank(ctx context.Context, name, alias, company, code, bic string) (*entities.Bank, error) {
 	// Validate required fields
 	if name == "" {
-		return nil, fmt.Errorf("bank name is required")
+		return nil, fmt.Errorf("%w: bank name is required", ErrInvalidInput)
 	}
 	if code == "" {
-		return nil, fmt.Errorf("bank code is required")
+		return nil, fmt.Errorf("%w: bank code is required", ErrInvalidInput)
+	}
+
+	var bicValue *valueobjects.BIC
+	if bic != "" {
+		parsed, err := valueobjects.NewBIC(bic)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		bicValue = parsed
 	}
 
 	// Check if bank with the same code already exists
@@ -37,7 +48,7 @@ func (s *BankService) CreateBank(ctx context.Context, name, alias, company, code
 		return nil, fmt.Errorf("failed to check bank code existence: %w", err)
 	}
 	if exists {
-		return nil, fmt.Errorf("bank with code '%s' already exists", code)
+		return nil, fmt.Errorf("bank with code '%s' %w", code, ErrAlreadyExists)
 	}
 
 	// Check if bank with the same name already exists
@@ -46,10 +57,13 @@ func (s *BankService) CreateBank(ctx context.Context, name, alias, company, code
 		return nil, fmt.Errorf("failed to check bank name existence: %w", err)
 	}
 	if exists {
-		return nil, fmt.Errorf("bank with name '%s' already exists", name)
+		return nil, fmt.Errorf("bank with name '%s' %w", name, ErrAlreadyExists)
 	}
 
 	bank := entities.NewBank(name, alias, company, code)
+	if bicValue != nil {
+		bank.SetBIC(bicValue)
+	}
 
 	if err := s.bankRepo.Create(ctx, bank); err != nil {
 		return nil, fmt.Errorf("failed to create bank: %w", err)
@@ -83,6 +97,11 @@ func (s *BankService) GetAllBanks(ctx context.Context, limit, offset int) ([]*en
 	return s.bankRepo.GetAll(ctx, limit, offset)
 }
 
+// ListBanks retrieves the banks matching the filters of a list query in the requested order
+func (s *BankService) ListBanks(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Bank, error) {
+	return s.bankRepo.List(ctx, query)
+}
+
 // SearchBanks searches banks by query
 func (s *BankService) SearchBanks(ctx context.Context, query string, limit, offset int) ([]*entities.Bank, error) {
 	return s.bankRepo.Search(ctx, query, limit, offset)
@@ -93,29 +112,72 @@ func (s *BankService) GetBanksByCompany(ctx context.Context, company string, lim
 	return s.bankRepo.GetByCompany(ctx, company, limit, offset)
 }
 
+// GetBanksByBIC retrieves banks by BIC, matching the 8-character form against 11-character BICs
+func (s *BankService) GetBanksByBIC(ctx context.Context, bic string) ([]*entities.Bank, error) {
+	bicValue, err := valueobjects.NewBIC(bic)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.bankRepo.GetByBIC(ctx, bicValue.Value())
+}
+
+// GetBanksByNetwork retrieves the banks with an active, currently effective membership in a payment network
+func (s *BankService) GetBanksByNetwork(ctx context.Context, networkCode string, limit, offset int) ([]*entities.Bank, error) {
+	return s.bankRepo.GetByNetwork(ctx, strings.ToUpper(networkCode), limit, offset)
+}
+
 // UpdateBank updates an existing bank
 func (s *BankService) UpdateBank(ctx context.Context, bank *entities.Bank) error {
+	if err := s.ValidateUpdate(ctx, bank); err != nil {
+		return err
+	}
+
+	return s.bankRepo.Update(ctx, bank)
+}
+
+// ValidateUpdate validates a changed bank before it is stored: name and code are required, the BIC is
+// normalized and code and name must stay unique
+func (s *BankService) ValidateUpdate(ctx context.Context, bank *entities.Bank) error {
 	if !bank.IsValid() {
-		return fmt.Errorf("invalid bank data")
+		return fmt.Errorf("%w: bank name and code are required", ErrInvalidInput)
+	}
+
+	// Normalize the BIC so that lookups by 8-character prefix keep working
+	bicValue, err := bank.GetBIC()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if bicValue != nil {
+		bank.SetBIC(bicValue)
 	}
 
 	// Check if updating to a code that already exists (but not for the same bank)
 	existingBank, err := s.bankRepo.GetByCode(ctx, bank.Code)
 	if err == nil && existingBank.ID != bank.ID {
-		return fmt.Errorf("bank with code '%s' already exists", bank.Code)
+		return fmt.Errorf("bank with code '%s' %w", bank.Code, ErrAlreadyExists)
 	}
 
 	// Check if updating to a name that already exists (but not for the same bank)
 	existingBank, err = s.bankRepo.GetByName(ctx, bank.Name)
 	if err == nil && existingBank.ID != bank.ID {
-		return fmt.Errorf("bank with name '%s' already exists", bank.Name)
+		return fmt.Errorf("bank with name '%s' %w", bank.Name, ErrAlreadyExists)
 	}
 
-	return s.bankRepo.Update(ctx, bank)
+	return nil
 }
 
 // DeleteBank deletes a bank by ID
 func (s *BankService) DeleteBank(ctx context.Context, id uuid.UUID) error {
+	// A merged bank must keep its successor, so a bank that succeeds other banks cannot be deleted
+	predecessors, err := s.bankRepo.GetPredecessors(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get predecessor banks: %w", err)
+	}
+	if len(predecessors) > 0 {
+		return fmt.Errorf("bank is %w as the successor of %d banks, e.g. '%s'", ErrInUse, len(predecessors), predecessors[0].Code)
+	}
+
 	return s.bankRepo.Delete(ctx, id)
 }
 
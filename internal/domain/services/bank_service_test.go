@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 // MockBankRepository is a mock implementation of BankRepository
@@ -60,6 +61,30 @@ func (m *MockBankRepository) GetByCompany(ctx context.Context, company string, l
 	return args.Get(0).([]*entities.Bank), args.Error(1)
 }
 
+func (m *MockBankRepository) GetByBIC(ctx context.Context, bic string) ([]*entities.Bank, error) {
+	args := m.Called(ctx, bic)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Bank), args.Error(1)
+}
+
+func (m *MockBankRepository) GetByNetwork(ctx context.Context, networkCode string, limit, offset int) ([]*entities.Bank, error) {
+	args := m.Called(ctx, networkCode, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Bank), args.Error(1)
+}
+
+func (m *MockBankRepository) GetPredecessors(ctx context.Context, bankID uuid.UUID) ([]*entities.Bank, error) {
+	args := m.Called(ctx, bankID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Bank), args.Error(1)
+}
+
 func (m *MockBankRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.Bank, error) {
 	args := m.Called(ctx, limit, offset)
 	if args.Get(0) == nil {
@@ -73,6 +98,11 @@ func (m *MockBankRepository) Update(ctx context.Context, bank *entities.Bank) er
 	return args.Error(0)
 }
 
+func (m *MockBankRepository) UpdateWithHistory(ctx context.Context, bank *entities.Bank, record *entities.BankHistoryRecord) error {
+	args := m.Called(ctx, bank, record)
+	return args.Error(0)
+}
+
 func (m *MockBankRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -86,6 +116,14 @@ func (m *MockBankRepository) Search(ctx context.Context, query string, limit, of
 	return args.Get(0).([]*entities.Bank), args.Error(1)
 }
 
+func (m *MockBankRepository) List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Bank, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Bank), args.Error(1)
+}
+
 func (m *MockBankRepository) ExistsByCode(ctx context.Context, code string) (bool, error) {
 	args := m.Called(ctx, code)
 	return args.Bool(0), args.Error(1)
@@ -130,7 +168,7 @@ func TestBankService_CreateBank(t *testing.T) {
 		mockRepo.On("Create", ctx, mock.AnythingOfType("*entities.Bank")).Return(nil)
 
 		// When
-		bank, err := service.CreateBank(ctx, name, alias, company, code)
+		bank, err := service.CreateBank(ctx, name, alias, company, code, "")
 
 		// Then
 		assert.NoError(t, err)
@@ -142,6 +180,42 @@ func TestBankService_CreateBank(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("successful creation with BIC", func(t *testing.T) {
+		// Given
+		mockRepo := &MockBankRepository{}
+		service := NewBankService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.On("ExistsByCode", ctx, "014").Return(false, nil)
+		mockRepo.On("ExistsByName", ctx, "Bank Central Asia").Return(false, nil)
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*entities.Bank")).Return(nil)
+
+		// When
+		bank, err := service.CreateBank(ctx, "Bank Central Asia", "BCA", "PT Bank Central Asia Tbk", "014", "cenaidja")
+
+		// Then
+		assert.NoError(t, err)
+		assert.NotNil(t, bank.BIC)
+		assert.Equal(t, "CENAIDJA", *bank.BIC)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid BIC", func(t *testing.T) {
+		// Given
+		mockRepo := &MockBankRepository{}
+		service := NewBankService(mockRepo)
+		ctx := context.Background()
+
+		// When
+		bank, err := service.CreateBank(ctx, "Test Bank", "alias", "company", "001", "CENA1DJA")
+
+		// Then
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Nil(t, bank)
+		assert.Contains(t, err.Error(), "invalid BIC format")
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
 	t.Run("empty name", func(t *testing.T) {
 		// Given
 		mockRepo := &MockBankRepository{}
@@ -149,10 +223,10 @@ func TestBankService_CreateBank(t *testing.T) {
 		ctx := context.Background()
 
 		// When
-		bank, err := service.CreateBank(ctx, "", "alias", "company", "001")
+		bank, err := service.CreateBank(ctx, "", "alias", "company", "001", "")
 
 		// Then
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidInput)
 		assert.Nil(t, bank)
 		assert.Contains(t, err.Error(), "bank name is required")
 	})
@@ -164,10 +238,10 @@ func TestBankService_CreateBank(t *testing.T) {
 		ctx := context.Background()
 
 		// When
-		bank, err := service.CreateBank(ctx, "Test Bank", "alias", "company", "")
+		bank, err := service.CreateBank(ctx, "Test Bank", "alias", "company", "", "")
 
 		// Then
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidInput)
 		assert.Nil(t, bank)
 		assert.Contains(t, err.Error(), "bank code is required")
 	})
@@ -182,12 +256,13 @@ func TestBankService_CreateBank(t *testing.T) {
 		mockRepo.On("ExistsByCode", ctx, code).Return(true, nil)
 
 		// When
-		bank, err := service.CreateBank(ctx, "Test Bank", "alias", "company", code)
+		bank, err := service.CreateBank(ctx, "Test Bank", "alias", "company", code, "")
 
 		// Then
 		assert.Error(t, err)
 		assert.Nil(t, bank)
 		assert.Contains(t, err.Error(), "already exists")
+		assert.ErrorIs(t, err, ErrAlreadyExists)
 		mockRepo.AssertExpectations(t)
 	})
 
@@ -203,12 +278,13 @@ func TestBankService_CreateBank(t *testing.T) {
 		mockRepo.On("ExistsByName", ctx, name).Return(true, nil)
 
 		// When
-		bank, err := service.CreateBank(ctx, name, "alias", "company", code)
+		bank, err := service.CreateBank(ctx, name, "alias", "company", code, "")
 
 		// Then
 		assert.Error(t, err)
 		assert.Nil(t, bank)
 		assert.Contains(t, err.Error(), "already exists")
+		assert.ErrorIs(t, err, ErrAlreadyExists)
 		mockRepo.AssertExpectations(t)
 	})
 }
@@ -387,6 +463,42 @@ func TestBankService_UpdateBank(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("duplicate code", func(t *testing.T) {
+		// Given
+		mockRepo := &MockBankRepository{}
+		service := NewBankService(mockRepo)
+		ctx := context.Background()
+		bank := &entities.Bank{
+			ID:   uuid.New(),
+			Name: "Test Bank",
+			Code: "001",
+		}
+
+		mockRepo.On("GetByCode", ctx, bank.Code).Return(&entities.Bank{ID: uuid.New(), Code: bank.Code}, nil)
+
+		// When
+		err := service.UpdateBank(ctx, bank)
+
+		// Then
+		assert.ErrorIs(t, err, ErrAlreadyExists)
+		mockRepo.AssertNotCalled(t, "Update", ctx, bank)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		// Given
+		mockRepo := &MockBankRepository{}
+		service := NewBankService(mockRepo)
+		ctx := context.Background()
+		bank := &entities.Bank{ID: uuid.New(), Code: "001"}
+
+		// When
+		err := service.UpdateBank(ctx, bank)
+
+		// Then
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		mockRepo.AssertNotCalled(t, "Update", ctx, bank)
+	})
+
 	t.Run("update error", func(t *testing.T) {
 		// Given
 		mockRepo := &MockBankRepository{}
@@ -420,6 +532,7 @@ func TestBankService_DeleteBank(t *testing.T) {
 		ctx := context.Background()
 		bankID := uuid.New()
 
+		mockRepo.On("GetPredecessors", ctx, bankID).Return([]*entities.Bank{}, nil)
 		mockRepo.On("Delete", ctx, bankID).Return(nil)
 
 		// When
@@ -437,6 +550,7 @@ func TestBankService_DeleteBank(t *testing.T) {
 		ctx := context.Background()
 		bankID := uuid.New()
 
+		mockRepo.On("GetPredecessors", ctx, bankID).Return([]*entities.Bank{}, nil)
 		mockRepo.On("Delete", ctx, bankID).Return(assert.AnError)
 
 		// When
@@ -446,6 +560,25 @@ func TestBankService_DeleteBank(t *testing.T) {
 		assert.Error(t, err)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("successor of a merged bank", func(t *testing.T) {
+		// Given
+		mockRepo := &MockBankRepository{}
+		service := NewBankService(mockRepo)
+		ctx := context.Background()
+		bankID := uuid.New()
+		merged := &entities.Bank{ID: uuid.New(), Name: "Bank Danamon Syariah", Code: "011", Status: entities.BankStatusMerged, SuccessorID: &bankID}
+
+		mockRepo.On("GetPredecessors", ctx, bankID).Return([]*entities.Bank{merged}, nil)
+
+		// When
+		err := service.DeleteBank(ctx, bankID)
+
+		// Then
+		assert.ErrorIs(t, err, ErrInUse)
+		assert.Contains(t, err.Error(), "'011'")
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
 }
 
 func TestBankService_SearchBanks(t *testing.T) {
@@ -491,3 +624,89 @@ func TestBankService_SearchBanks(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestBankService_ListBanks(t *testing.T) {
+	t.Run("passes the list query to the repository", func(t *testing.T) {
+		// Given
+		mockRepo := &MockBankRepository{}
+		service := NewBankService(mockRepo)
+		ctx := context.Background()
+		query := valueobjects.ListQuery{
+			Filters: []valueobjects.Filter{{Field: "status", Operator: valueobjects.FilterEq, Value: "active"}},
+			Sort:    []valueobjects.SortField{{Field: "name", Descending: true}},
+			Limit:   10,
+		}
+		expectedBanks := []*entities.Bank{
+			{ID: uuid.New(), Name: "Bank 2", Code: "002"},
+			{ID: uuid.New(), Name: "Bank 1", Code: "001"},
+		}
+
+		mockRepo.On("List", ctx, query).Return(expectedBanks, nil)
+
+		// When
+		banks, err := service.ListBanks(ctx, query)
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, expectedBanks, banks)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestBankService_GetBanksByBIC(t *testing.T) {
+	t.Run("normalizes BIC before lookup", func(t *testing.T) {
+		// Given
+		mockRepo := &MockBankRepository{}
+		service := NewBankService(mockRepo)
+		ctx := context.Background()
+		bic := "CENAIDJA"
+		expectedBanks := []*entities.Bank{
+			{ID: uuid.New(), Name: "Bank Central Asia", Code: "014", BIC: &bic},
+		}
+
+		mockRepo.On("GetByBIC", ctx, "CENAIDJA").Return(expectedBanks, nil)
+
+		// When
+		banks, err := service.GetBanksByBIC(ctx, " cenaidja ")
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, expectedBanks, banks)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid BIC", func(t *testing.T) {
+		// Given
+		mockRepo := &MockBankRepository{}
+		service := NewBankService(mockRepo)
+		ctx := context.Background()
+
+		// When
+		banks, err := service.GetBanksByBIC(ctx, "CENAID")
+
+		// Then
+		assert.Error(t, err)
+		assert.Nil(t, banks)
+		mockRepo.AssertNotCalled(t, "GetByBIC", mock.Anything, mock.Anything)
+	})
+}
+
+func TestBankService_GetBanksByNetwork(t *testing.T) {
+	// Given
+	mockRepo := &MockBankRepository{}
+	service := NewBankService(mockRepo)
+	ctx := context.Background()
+	expectedBanks := []*entities.Bank{
+		{ID: uuid.New(), Name: "Bank Central Asia", Code: "014"},
+	}
+
+	mockRepo.On("GetByNetwork", ctx, "BI-FAST", 50, 0).Return(expectedBanks, nil)
+
+	// When
+	banks, err := service.GetBanksByNetwork(ctx, "bi-fast", 50, 0)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBanks, banks)
+	mockRepo.AssertExpectations(t)
+}
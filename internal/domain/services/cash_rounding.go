@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+const (
+	// RoundingTypeElectronic rounds amounts to the decimal places of the currency
+	RoundingTypeElectronic = "electronic"
+	// RoundingTypeCash rounds amounts to the cash rounding increment of the currency
+	RoundingTypeCash = "cash"
+
+	// MaxRoundBatchSize limits the number of amounts rounded in one request
+	MaxRoundBatchSize = 100
+)
+
+// RoundedAmount holds an amount, its rounded value and the rounding adjustment, or the reason it could not be rounded
+type RoundedAmount struct {
+	Amount     string `json:"amount"`
+	Rounded    string `json:"rounded,omitempty"`
+	Adjustment string `json:"adjustment,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// RoundingResult holds the amounts rounded for a currency with the increment and mode that were applied
+type RoundingResult struct {
+	Currency     string                    `json:"currency"`
+	Type         string                    `json:"type"`
+	Increment    valueobjects.Decimal      `json:"increment"`
+	RoundingMode valueobjects.RoundingMode `json:"rounding_mode"`
+	Amounts      []*RoundedAmount          `json:"amounts"`
+}
+
+// RoundAmounts rounds amounts of the currency identified by its code. Electronic rounding rounds to the
+// decimal places of the currency, halves away from zero; cash rounding rounds to the cash rounding increment
+// with the cash rounding mode of the currency. Amounts that cannot be parsed carry the reason instead of
+// failing the batch.
+func (s *CurrencyService) RoundAmounts(ctx context.Context, code, roundingType string, amounts []string) (*RoundingResult, error) {
+	if len(amounts) == 0 {
+		return nil, fmt.Errorf("%w: at least one amount is required", ErrInvalidInput)
+	}
+	if len(amounts) > MaxRoundBatchSize {
+		return nil, fmt.Errorf("%w: at most %d amounts can be rounded at once", ErrInvalidInput, MaxRoundBatchSize)
+	}
+
+	roundingType = strings.ToLower(strings.TrimSpace(roundingType))
+	if roundingType == "" {
+		roundingType = RoundingTypeElectronic
+	}
+	if roundingType != RoundingTypeElectronic && roundingType != RoundingTypeCash {
+		return nil, fmt.Errorf("%w: rounding type '%s' must be %s or %s", ErrInvalidInput, roundingType, RoundingTypeElectronic, RoundingTypeCash)
+	}
+
+	currency, err := s.currencyRepo.GetByCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RoundingResult{
+		Currency:     currency.Code,
+		Type:         roundingType,
+		Increment:    currency.MinorUnit(),
+		RoundingMode: valueobjects.RoundHalfUp,
+		Amounts:      make([]*RoundedAmount, 0, len(amounts)),
+	}
+	if roundingType == RoundingTypeCash {
+		if currency.CashRoundingIncrement != nil {
+			result.Increment = *currency.CashRoundingIncrement
+		}
+		if currency.CashRoundingMode.IsValid() {
+			result.RoundingMode = currency.CashRoundingMode
+		}
+	}
+
+	for _, raw := range amounts {
+		rounded := &RoundedAmount{Amount: raw}
+		result.Amounts = append(result.Amounts, rounded)
+
+		amount, err := valueobjects.NewDecimal(raw)
+		if err != nil {
+			rounded.Error = "invalid amount: " + err.Error()
+			continue
+		}
+
+		value := currency.RoundElectronic(amount)
+		if roundingType == RoundingTypeCash {
+			value = currency.RoundCash(amount)
+		}
+
+		rounded.Amount = amount.String()
+		rounded.Rounded = value.StringFixed(currency.DecimalPlaces)
+		rounded.Adjustment = value.Sub(amount).String()
+	}
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// CountryCurrencyService implements business logic for the currencies used in countries
+type CountryCurrencyService struct {
+	countryCurrencyRepo repositories.CountryCurrencyRepository
+	geodirectoryRepo    repositories.GeodirectoryRepository
+	currencyRepo        repositories.CurrencyRepository
+}
+
+// NewCountryCurrencyService creates a new CountryCurrencyService instance
+func NewCountryCurrencyService(
+	countryCurrencyRepo repositories.CountryCurrencyRepository,
+	geodirectoryRepo repositories.GeodirectoryRepository,
+	currencyRepo repositories.CurrencyRepository,
+) *CountryCurrencyService {
+	return &CountryCurrencyService{
+		countryCurrencyRepo: countryCurrencyRepo,
+		geodirectoryRepo:    geodirectoryRepo,
+		currencyRepo:        currencyRepo,
+	}
+}
+
+// GetCountryCurrencies retrieves the currencies used in the country identified by its code. When a date is
+// given only the currencies used on that date are returned, otherwise former currencies are included.
+func (s *CountryCurrencyService) GetCountryCurrencies(ctx context.Context, countryCode string, at *time.Time) ([]*entities.CountryCurrency, error) {
+	country, err := s.geodirectoryRepo.GetCountryByCode(ctx, strings.ToUpper(strings.TrimSpace(countryCode)))
+	if err != nil {
+		return nil, err
+	}
+
+	countryCurrencies, err := s.countryCurrencyRepo.GetByCountry(ctx, country.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get country currencies: %w", err)
+	}
+
+	return filterEffectiveCountryCurrencies(countryCurrencies, at), nil
+}
+
+// GetCurrencyCountries retrieves the countries the currency identified by its code is used in. When a date
+// is given only the countries using the currency on that date are returned.
+func (s *CountryCurrencyService) GetCurrencyCountries(ctx context.Context, currencyCode string, at *time.Time) ([]*entities.CountryCurrency, error) {
+	code, err := normalizeCurrencyCode(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, err := s.currencyRepo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	countryCurrencies, err := s.countryCurrencyRepo.GetByCurrency(ctx, currency.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get currency countries: %w", err)
+	}
+
+	return filterEffectiveCountryCurrencies(countryCurrencies, at), nil
+}
+
+// filterEffectiveCountryCurrencies keeps the country currencies effective at the given time; nil keeps all
+func filterEffectiveCountryCurrencies(countryCurrencies []*entities.CountryCurrency, at *time.Time) []*entities.CountryCurrency {
+	if at == nil {
+		return countryCurrencies
+	}
+
+	effective := make([]*entities.CountryCurrency, 0, len(countryCurrencies))
+	for _, countryCurrency := range countryCurrencies {
+		if countryCurrency.IsEffective(*at) {
+			effective = append(effective, countryCurrency)
+		}
+	}
+	return effective
+}
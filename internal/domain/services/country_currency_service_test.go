@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// MockCountryCurrencyRepository is a mock implementation of CountryCurrencyRepository
+type MockCountryCurrencyRepository struct {
+	mock.Mock
+}
+
+func (m *MockCountryCurrencyRepository) Create(ctx context.Context, countryCurrency *entities.CountryCurrency) error {
+	args := m.Called(ctx, countryCurrency)
+	return args.Error(0)
+}
+
+func (m *MockCountryCurrencyRepository) GetByCountryAndCurrency(ctx context.Context, countryID, currencyID uuid.UUID) (*entities.CountryCurrency, error) {
+	args := m.Called(ctx, countryID, currencyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.CountryCurrency), args.Error(1)
+}
+
+func (m *MockCountryCurrencyRepository) Update(ctx context.Context, countryCurrency *entities.CountryCurrency) error {
+	args := m.Called(ctx, countryCurrency)
+	return args.Error(0)
+}
+
+func (m *MockCountryCurrencyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockCountryCurrencyRepository) GetByCountry(ctx context.Context, countryID uuid.UUID) ([]*entities.CountryCurrency, error) {
+	args := m.Called(ctx, countryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.CountryCurrency), args.Error(1)
+}
+
+func (m *MockCountryCurrencyRepository) GetByCurrency(ctx context.Context, currencyID uuid.UUID) ([]*entities.CountryCurrency, error) {
+	args := m.Called(ctx, currencyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.CountryCurrency), args.Error(1)
+}
+
+func TestCountryCurrencyService_GetCountryCurrencies(t *testing.T) {
+	// Given
+	countryCurrencyRepo := new(MockCountryCurrencyRepository)
+	geoRepo := new(MockGeodirectoryLookupRepository)
+	service := NewCountryCurrencyService(countryCurrencyRepo, geoRepo, new(MockCurrencyLookupRepository))
+	ctx := context.Background()
+
+	countryCode := "HR"
+	croatia := &entities.Geodirectory{ID: uuid.New(), Name: "Croatia", Code: &countryCode, Type: entities.GeoTypeCountry}
+	adoption := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	euro := entities.NewCountryCurrency(croatia.ID, uuid.New(), true, true)
+	euro.SetEffectivePeriod(&adoption, nil)
+	kuna := entities.NewCountryCurrency(croatia.ID, uuid.New(), true, true)
+	kuna.SetEffectivePeriod(nil, &adoption)
+
+	geoRepo.On("GetCountryByCode", ctx, "HR").Return(croatia, nil)
+	countryCurrencyRepo.On("GetByCountry", ctx, croatia.ID).Return([]*entities.CountryCurrency{euro, kuna}, nil)
+
+	t.Run("all currencies without a date", func(t *testing.T) {
+		// When
+		result, err := service.GetCountryCurrencies(ctx, "hr", nil)
+
+		// Then
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+
+	t.Run("currencies used on a date", func(t *testing.T) {
+		// When
+		before := adoption.AddDate(0, 0, -1)
+		result, err := service.GetCountryCurrencies(ctx, "HR", &before)
+
+		// Then
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, kuna.ID, result[0].ID)
+
+		result, err = service.GetCountryCurrencies(ctx, "HR", &adoption)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, euro.ID, result[0].ID)
+	})
+
+	t.Run("unknown country", func(t *testing.T) {
+		// Given
+		geoRepo.On("GetCountryByCode", ctx, "XX").Return(nil, fmt.Errorf("country %w", repositories.ErrNotFound))
+
+		// When
+		_, err := service.GetCountryCurrencies(ctx, "XX", nil)
+
+		// Then
+		assert.EqualError(t, err, "country not found")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestCountryCurrencyService_GetCurrencyCountries(t *testing.T) {
+	// Given
+	countryCurrencyRepo := new(MockCountryCurrencyRepository)
+	currencyRepo := new(MockCurrencyLookupRepository)
+	service := NewCountryCurrencyService(countryCurrencyRepo, new(MockGeodirectoryLookupRepository), currencyRepo)
+	ctx := context.Background()
+
+	usd := entities.NewCurrency("US Dollar", "USD", 2)
+	timorLeste := entities.NewCountryCurrency(uuid.New(), usd.ID, true, true)
+
+	currencyRepo.On("GetByCode", ctx, "USD").Return(usd, nil)
+	countryCurrencyRepo.On("GetByCurrency", ctx, usd.ID).Return([]*entities.CountryCurrency{timorLeste}, nil)
+
+	// When
+	result, err := service.GetCurrencyCountries(ctx, "usd", nil)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, timorLeste.ID, result[0].ID)
+
+	_, err = service.GetCurrencyCountries(ctx, "US", nil)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Contains(t, err.Error(), "currency code 'US'")
+}
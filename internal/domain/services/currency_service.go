@@ -3,10 +3,12 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 // CurrencyService implements business logic for currency operations
@@ -74,6 +76,17 @@ func (s *CurrencyService) GetCurrencyByCode(ctx context.Context, code string) (*
 	return s.currencyRepo.GetByCode(ctx, code)
 }
 
+// GetCurrencyByNumericCode retrieves a currency by its ISO 4217 numeric code. Codes given without
+// leading zeros (e.g. "8" for "008") are padded.
+func (s *CurrencyService) GetCurrencyByNumericCode(ctx context.Context, numericCode string) (*entities.Currency, error) {
+	numericCode = strings.TrimSpace(numericCode)
+	if numericCode == "" || len(numericCode) > 3 || strings.Trim(numericCode, "0123456789") != "" {
+		return nil, fmt.Errorf("%w: numeric code '%s' must be an ISO 4217 numeric code of up to 3 digits", ErrInvalidInput, numericCode)
+	}
+
+	return s.currencyRepo.GetByNumericCode(ctx, strings.Repeat("0", 3-len(numericCode))+numericCode)
+}
+
 // GetCurrencyByName retrieves a currency by name
 func (s *CurrencyService) GetCurrencyByName(ctx context.Context, name string) (*entities.Currency, error) {
 	return s.currencyRepo.GetByName(ctx, name)
@@ -89,6 +102,11 @@ func (s *CurrencyService) GetAllCurrencies(ctx context.Context, limit, offset in
 	return s.currencyRepo.GetAll(ctx, limit, offset)
 }
 
+// ListCurrencies retrieves the currencies matching the filters of a list query in the requested order
+func (s *CurrencyService) ListCurrencies(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Currency, error) {
+	return s.currencyRepo.List(ctx, query)
+}
+
 // GetActiveCurrencies retrieves all active currencies
 func (s *CurrencyService) GetActiveCurrencies(ctx context.Context, limit, offset int) ([]*entities.Currency, error) {
 	return s.currencyRepo.GetActive(ctx, limit, offset)
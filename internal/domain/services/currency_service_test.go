@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+func TestCurrencyService_GetCurrencyByNumericCode(t *testing.T) {
+	// Given
+	currencyRepo := new(MockCurrencyLookupRepository)
+	service := NewCurrencyService(currencyRepo)
+
+	lek := entities.NewCurrency("Lek", "ALL", 2)
+	lek.SetNumericCode("008")
+	currencyRepo.On("GetByNumericCode", mock.Anything, "008").Return(lek, nil)
+
+	// When
+	currency, err := service.GetCurrencyByNumericCode(context.Background(), "8")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "ALL", currency.Code)
+}
+
+func TestCurrencyService_GetCurrencyByNumericCode_Withdrawn(t *testing.T) {
+	// Given
+	currencyRepo := new(MockCurrencyLookupRepository)
+	service := NewCurrencyService(currencyRepo)
+
+	replacedBy := "EUR"
+	mark := entities.NewCurrency("Deutsche Mark", "DEM", 2)
+	mark.SetNumericCode("276")
+	mark.Withdraw(time.Date(2002, 3, 1, 0, 0, 0, 0, time.UTC), &replacedBy)
+	currencyRepo.On("GetByNumericCode", mock.Anything, "276").Return(mark, nil)
+
+	// When
+	currency, err := service.GetCurrencyByNumericCode(context.Background(), "276")
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, currency.IsWithdrawn())
+	assert.Equal(t, "EUR", *currency.ReplacedBy)
+}
+
+func TestCurrencyService_GetCurrencyByNumericCode_Invalid(t *testing.T) {
+	service := NewCurrencyService(new(MockCurrencyLookupRepository))
+
+	for _, numericCode := range []string{"", "1234", "8a", "-1"} {
+		_, err := service.GetCurrencyByNumericCode(context.Background(), numericCode)
+		assert.ErrorIs(t, err, ErrInvalidInput, numericCode)
+	}
+}
+
+func TestCurrencyService_RoundAmounts(t *testing.T) {
+	// Given
+	currencyRepo := new(MockCurrencyLookupRepository)
+	service := NewCurrencyService(currencyRepo)
+
+	increment, _ := valueobjects.NewDecimal("0.05")
+	chf := entities.NewCurrency("Swiss Franc", "CHF", 2)
+	chf.SetCashRounding(&increment, valueobjects.RoundHalfUp)
+	currencyRepo.On("GetByCode", mock.Anything, "CHF").Return(chf, nil)
+
+	t.Run("cash rounding", func(t *testing.T) {
+		// When
+		result, err := service.RoundAmounts(context.Background(), "chf", "cash", []string{"12.32", "12.375", "-0.03", "ten"})
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "CHF", result.Currency)
+		assert.Equal(t, RoundingTypeCash, result.Type)
+		assert.Equal(t, "0.05", result.Increment.String())
+		require.Len(t, result.Amounts, 4)
+		assert.Equal(t, "12.30", result.Amounts[0].Rounded)
+		assert.Equal(t, "-0.02", result.Amounts[0].Adjustment)
+		assert.Equal(t, "12.40", result.Amounts[1].Rounded)
+		assert.Equal(t, "-0.05", result.Amounts[2].Rounded)
+		assert.Contains(t, result.Amounts[3].Error, "invalid amount")
+	})
+
+	t.Run("electronic rounding", func(t *testing.T) {
+		// When
+		result, err := service.RoundAmounts(context.Background(), "CHF", "", []string{"12.325"})
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, RoundingTypeElectronic, result.Type)
+		assert.Equal(t, "0.01", result.Increment.String())
+		assert.Equal(t, "12.33", result.Amounts[0].Rounded)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := service.RoundAmounts(context.Background(), "CHF", "coins", []string{"1"})
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "rounding type 'coins'")
+
+		_, err = service.RoundAmounts(context.Background(), "CHF", "cash", nil)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+
+		_, err = service.RoundAmounts(context.Background(), "CHF", "cash", make([]string, MaxRoundBatchSize+1))
+		assert.ErrorIs(t, err, ErrInvalidInput)
+	})
+}
@@ -0,0 +1,24 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// ErrAlreadyExists is wrapped by errors returned when a unique field is already taken,
+// so handlers can map it to a 409 Conflict response
+var ErrAlreadyExists = errors.New("already exists")
+
+// ErrInvalidInput is wrapped by errors returned for invalid data, including references to
+// records that do not exist, so handlers can map it to a 400 Bad Request response
+var ErrInvalidInput = errors.New("invalid input")
+
+// ErrNotFound is wrapped by errors returned when the requested record does not exist,
+// so handlers can map it to a 404 Not Found response. It is repositories.ErrNotFound, so
+// missing records reported by a repository map to 404 as well.
+var ErrNotFound = repositories.ErrNotFound
+
+// ErrInUse is wrapped by errors returned when a record cannot be deleted because other records
+// refer to it, so handlers can map it to a 409 Conflict response
+var ErrInUse = errors.New("in use")
@@ -0,0 +1,435 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+const (
+	// ECBBaseCurrency is the base currency of the ECB euro foreign exchange reference rates
+	ECBBaseCurrency = "EUR"
+	// ECBSource is the default source name of rates imported from ECB files
+	ECBSource = "ECB"
+)
+
+// ecbCSVDateLayouts are the date layouts used in ECB CSV files: the historical and the daily file
+var ecbCSVDateLayouts = []string{entities.ExchangeRateDateLayout, "02 January 2006"}
+
+// CurrencyConversion holds the outcome of a currency conversion
+type CurrencyConversion struct {
+	From     string               `json:"from"`
+	To       string               `json:"to"`
+	Amount   string               `json:"amount"`
+	Result   string               `json:"result"`
+	Rate     valueobjects.Decimal `json:"rate"`
+	RateDate string               `json:"rate_date"`
+	Source   string               `json:"source,omitempty"`
+	Via      string               `json:"via,omitempty"`
+}
+
+// pairRate is a rate between two currencies resolved from a stored rate or its inverse
+type pairRate struct {
+	rate   valueobjects.Decimal
+	date   time.Time
+	source string
+}
+
+// ExchangeRateService implements business logic for exchange rates and currency conversion
+type ExchangeRateService struct {
+	rateRepo     repositories.ExchangeRateRepository
+	currencyRepo repositories.CurrencyRepository
+}
+
+// NewExchangeRateService creates a new ExchangeRateService instance
+func NewExchangeRateService(rateRepo repositories.ExchangeRateRepository, currencyRepo repositories.CurrencyRepository) *ExchangeRateService {
+	return &ExchangeRateService{
+		rateRepo:     rateRepo,
+		currencyRepo: currencyRepo,
+	}
+}
+
+// GetRates retrieves the latest rates of a base currency published on or before the given date
+func (s *ExchangeRateService) GetRates(ctx context.Context, baseCurrency string, date time.Time) ([]*entities.ExchangeRate, error) {
+	base, err := normalizeCurrencyCode(baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := s.rateRepo.GetByBase(ctx, base, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rates: %w", err)
+	}
+	return rates, nil
+}
+
+// Convert converts an amount between two currencies with the rates published on or before the given date.
+// A direct rate or the inverse of the opposite rate is used when available, otherwise the conversion is
+// triangulated through a base currency both currencies have rates against. The result is rounded to the
+// decimal places of the target currency.
+func (s *ExchangeRateService) Convert(ctx context.Context, fromCurrency, toCurrency string, amount valueobjects.Decimal, date time.Time) (*CurrencyConversion, error) {
+	from, err := normalizeCurrencyCode(fromCurrency)
+	if err != nil {
+		return nil, err
+	}
+	to, err := normalizeCurrencyCode(toCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getCurrency(ctx, from); err != nil {
+		return nil, err
+	}
+	target, err := s.getCurrency(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	conversion := &CurrencyConversion{
+		From:   from,
+		To:     to,
+		Amount: amount.String(),
+	}
+
+	rate := &pairRate{rate: valueobjects.NewDecimalFromInt(1), date: date}
+	if from != to {
+		rate, err = s.findPairRate(ctx, from, to, date)
+		if err != nil {
+			return nil, err
+		}
+		if rate == nil {
+			rate, conversion.Via, err = s.triangulate(ctx, from, to, date)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if rate == nil {
+			return nil, fmt.Errorf("exchange rate %w: no rate from %s to %s on or before %s", ErrNotFound, from, to, date.Format(entities.ExchangeRateDateLayout))
+		}
+	}
+
+	conversion.Rate = rate.rate
+	conversion.RateDate = rate.date.Format(entities.ExchangeRateDateLayout)
+	conversion.Source = rate.source
+	conversion.Result = amount.Mul(rate.rate).StringFixed(target.DecimalPlaces)
+
+	return conversion, nil
+}
+
+// ImportRates stores exchange rates, replacing the rates of the same pair, date and source, and returns
+// the number of rates imported. The rates are validated first and stored in one transaction, so an
+// invalid rate or a failure halfway leaves the stored rates unchanged.
+func (s *ExchangeRateService) ImportRates(ctx context.Context, rates []*entities.ExchangeRate) (int, error) {
+	for _, rate := range rates {
+		if !rate.IsValid() {
+			return 0, fmt.Errorf("%w: exchange rate %s/%s on %s", ErrInvalidInput, rate.BaseCurrency, rate.QuoteCurrency, rate.RateDate.Format(entities.ExchangeRateDateLayout))
+		}
+	}
+
+	if len(rates) == 0 {
+		return 0, nil
+	}
+
+	if err := s.rateRepo.Upsert(ctx, rates); err != nil {
+		return 0, fmt.Errorf("failed to import exchange rates: %w", err)
+	}
+
+	return len(rates), nil
+}
+
+// getCurrency retrieves a currency by its code
+func (s *ExchangeRateService) getCurrency(ctx context.Context, code string) (*entities.Currency, error) {
+	currency, err := s.currencyRepo.GetByCode(ctx, code)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, fmt.Errorf("currency %w: %s", ErrNotFound, code)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get currency: %w", err)
+	}
+	return currency, nil
+}
+
+// findPairRate resolves the rate from one currency to another from a stored rate or the inverse of the
+// opposite rate. It returns nil when neither is stored.
+func (s *ExchangeRateService) findPairRate(ctx context.Context, from, to string, date time.Time) (*pairRate, error) {
+	direct, err := s.rateRepo.GetRate(ctx, from, to, date)
+	if err == nil {
+		return &pairRate{rate: direct.Rate, date: direct.RateDate, source: direct.Source}, nil
+	}
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+
+	opposite, err := s.rateRepo.GetRate(ctx, to, from, date)
+	if err == nil {
+		inverse, err := valueobjects.NewDecimalFromInt(1).Div(opposite.Rate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rate %s/%s: %w", to, from, err)
+		}
+		return &pairRate{rate: inverse, date: opposite.RateDate, source: opposite.Source}, nil
+	}
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+
+	return nil, nil
+}
+
+// triangulate resolves the rate from one currency to another through the first base currency both have
+// rates against. It returns nil when there is no such base currency.
+func (s *ExchangeRateService) triangulate(ctx context.Context, from, to string, date time.Time) (*pairRate, string, error) {
+	bases, err := s.rateRepo.GetBaseCurrencies(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get base currencies: %w", err)
+	}
+
+	for _, via := range bases {
+		if via == from || via == to {
+			continue
+		}
+
+		fromLeg, err := s.findPairRate(ctx, via, from, date)
+		if err != nil {
+			return nil, "", err
+		}
+		if fromLeg == nil {
+			continue
+		}
+		toLeg, err := s.findPairRate(ctx, via, to, date)
+		if err != nil {
+			return nil, "", err
+		}
+		if toLeg == nil {
+			continue
+		}
+
+		rate, err := toLeg.rate.Div(fromLeg.rate)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid exchange rate %s/%s: %w", via, from, err)
+		}
+
+		// The cross rate is only as recent as its oldest leg
+		triangulated := &pairRate{rate: rate, date: fromLeg.date, source: fromLeg.source}
+		if toLeg.date.Before(fromLeg.date) {
+			triangulated.date = toLeg.date
+		}
+		if toLeg.source != fromLeg.source {
+			triangulated.source = fromLeg.source + "," + toLeg.source
+		}
+
+		return triangulated, via, nil
+	}
+
+	return nil, "", nil
+}
+
+// ecbEnvelope is the document of the ECB euro foreign exchange reference rates XML files
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ParseECBXML parses an ECB euro foreign exchange reference rates XML file (daily, 90 days or historical)
+// into rates with EUR as base currency
+func ParseECBXML(r io.Reader, source string) ([]*entities.ExchangeRate, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, fmt.Errorf("invalid ECB XML: %w", err)
+	}
+
+	if source == "" {
+		source = ECBSource
+	}
+
+	var rates []*entities.ExchangeRate
+	for _, day := range envelope.Days {
+		date, err := time.Parse(entities.ExchangeRateDateLayout, day.Time)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ECB XML: invalid date '%s'", day.Time)
+		}
+
+		for _, cube := range day.Rates {
+			rate, err := valueobjects.NewDecimal(cube.Rate)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ECB XML: rate of %s on %s: %w", cube.Currency, day.Time, err)
+			}
+
+			exchangeRate := entities.NewExchangeRate(ECBBaseCurrency, cube.Currency, rate, date, source)
+			if !exchangeRate.IsValid() {
+				return nil, fmt.Errorf("invalid ECB XML: invalid rate of '%s' on %s", cube.Currency, day.Time)
+			}
+			rates = append(rates, exchangeRate)
+		}
+	}
+
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("invalid ECB XML: no exchange rates found")
+	}
+
+	return rates, nil
+}
+
+// ParseExchangeRateCSV parses exchange rates from a CSV file in one of two layouts: one rate per row with
+// base, quote, rate, date and an optional source column, or the ECB layout with a Date column followed by
+// one column of EUR rates per currency. Empty and N/A values of the ECB layout are skipped.
+func ParseExchangeRateCSV(r io.Reader, source string) ([]*entities.ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid exchange rates CSV: %w", err)
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("invalid exchange rates CSV: must contain at least a header and one data row")
+	}
+
+	columns := make(map[string]int)
+	for i, column := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+
+	var rates []*entities.ExchangeRate
+	if _, ok := columns["quote"]; ok {
+		rates, err = parseRateRows(records, columns, source)
+	} else if _, ok := columns["date"]; ok {
+		rates, err = parseECBRateColumns(records, columns["date"], source)
+	} else {
+		return nil, fmt.Errorf("invalid exchange rates CSV: header must contain base, quote, rate and date or the ECB Date and currency columns")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("invalid exchange rates CSV: no exchange rates found")
+	}
+
+	return rates, nil
+}
+
+// parseRateRows parses a CSV file with one rate per row
+func parseRateRows(records [][]string, columns map[string]int, source string) ([]*entities.ExchangeRate, error) {
+	for _, required := range []string{"base", "quote", "rate", "date"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("invalid exchange rates CSV: missing the %s column", required)
+		}
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rates []*entities.ExchangeRate
+	for i, record := range records[1:] { // Skip header
+		row := i + 2
+
+		date, err := time.Parse(entities.ExchangeRateDateLayout, value(record, "date"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rates CSV: row %d: invalid date '%s'", row, value(record, "date"))
+		}
+
+		rate, err := valueobjects.NewDecimal(value(record, "rate"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rates CSV: row %d: %w", row, err)
+		}
+
+		rowSource := value(record, "source")
+		if rowSource == "" {
+			rowSource = source
+		}
+
+		exchangeRate := entities.NewExchangeRate(value(record, "base"), value(record, "quote"), rate, date, rowSource)
+		if !exchangeRate.IsValid() {
+			return nil, fmt.Errorf("invalid exchange rates CSV: row %d: invalid rate %s/%s", row, exchangeRate.BaseCurrency, exchangeRate.QuoteCurrency)
+		}
+		rates = append(rates, exchangeRate)
+	}
+
+	return rates, nil
+}
+
+// parseECBRateColumns parses a CSV file in the ECB layout: a Date column followed by one column per currency
+func parseECBRateColumns(records [][]string, dateColumn int, source string) ([]*entities.ExchangeRate, error) {
+	if source == "" {
+		source = ECBSource
+	}
+
+	header := records[0]
+
+	var rates []*entities.ExchangeRate
+	for i, record := range records[1:] { // Skip header
+		row := i + 2
+		if dateColumn >= len(record) || strings.TrimSpace(record[dateColumn]) == "" {
+			continue
+		}
+
+		date, err := parseECBDate(strings.TrimSpace(record[dateColumn]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rates CSV: row %d: %w", row, err)
+		}
+
+		for column, raw := range record {
+			if column == dateColumn || column >= len(header) {
+				continue
+			}
+
+			currency := strings.TrimSpace(header[column])
+			raw = strings.TrimSpace(raw)
+			if currency == "" || raw == "" || strings.EqualFold(raw, "N/A") {
+				continue
+			}
+
+			rate, err := valueobjects.NewDecimal(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid exchange rates CSV: row %d: rate of %s: %w", row, currency, err)
+			}
+
+			exchangeRate := entities.NewExchangeRate(ECBBaseCurrency, currency, rate, date, source)
+			if !exchangeRate.IsValid() {
+				return nil, fmt.Errorf("invalid exchange rates CSV: row %d: invalid rate of '%s'", row, currency)
+			}
+			rates = append(rates, exchangeRate)
+		}
+	}
+
+	return rates, nil
+}
+
+// parseECBDate parses the date of an ECB CSV row in any of the layouts ECB files use
+func parseECBDate(value string) (time.Time, error) {
+	for _, layout := range ecbCSVDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date '%s'", value)
+}
+
+// normalizeCurrencyCode upper-cases a currency code and checks that it is an ISO 4217 alphabetic code
+func normalizeCurrencyCode(code string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(code))
+	if len(normalized) != 3 || strings.Trim(normalized, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return "", fmt.Errorf("%w: currency code '%s' must be a 3-letter ISO 4217 code", ErrInvalidInput, code)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,322 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// MockExchangeRateRepository is a mock implementation of ExchangeRateRepository
+type MockExchangeRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRateRepository) Upsert(ctx context.Context, rates []*entities.ExchangeRate) error {
+	args := m.Called(ctx, rates)
+	return args.Error(0)
+}
+
+func (m *MockExchangeRateRepository) GetRate(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (*entities.ExchangeRate, error) {
+	args := m.Called(ctx, baseCurrency, quoteCurrency, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateRepository) GetByBase(ctx context.Context, baseCurrency string, date time.Time) ([]*entities.ExchangeRate, error) {
+	args := m.Called(ctx, baseCurrency, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateRepository) GetBaseCurrencies(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// MockCurrencyLookupRepository mocks the currency lookups used by services that only read currencies
+type MockCurrencyLookupRepository struct {
+	repositories.CurrencyRepository
+	mock.Mock
+}
+
+func (m *MockCurrencyLookupRepository) GetByCode(ctx context.Context, code string) (*entities.Currency, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Currency), args.Error(1)
+}
+
+func (m *MockCurrencyLookupRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Currency, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Currency), args.Error(1)
+}
+
+func (m *MockCurrencyLookupRepository) GetByNumericCode(ctx context.Context, numericCode string) (*entities.Currency, error) {
+	args := m.Called(ctx, numericCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Currency), args.Error(1)
+}
+
+func newTestExchangeRate(base, quote, rate string, date time.Time) *entities.ExchangeRate {
+	value, _ := valueobjects.NewDecimal(rate)
+	return entities.NewExchangeRate(base, quote, value, date, ECBSource)
+}
+
+func setupExchangeRateService() (*ExchangeRateService, *MockExchangeRateRepository, *MockCurrencyLookupRepository) {
+	rateRepo := new(MockExchangeRateRepository)
+	currencyRepo := new(MockCurrencyLookupRepository)
+	currencyRepo.On("GetByCode", mock.Anything, "EUR").Return(entities.NewCurrency("Euro", "EUR", 2), nil).Maybe()
+	currencyRepo.On("GetByCode", mock.Anything, "USD").Return(entities.NewCurrency("US Dollar", "USD", 2), nil).Maybe()
+	currencyRepo.On("GetByCode", mock.Anything, "IDR").Return(entities.NewCurrency("Rupiah", "IDR", 0), nil).Maybe()
+	currencyRepo.On("GetByCode", mock.Anything, "JPY").Return(entities.NewCurrency("Yen", "JPY", 0), nil).Maybe()
+	return NewExchangeRateService(rateRepo, currencyRepo), rateRepo, currencyRepo
+}
+
+func TestExchangeRateService_Convert_Direct(t *testing.T) {
+	// Given
+	service, rateRepo, _ := setupExchangeRateService()
+	date := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	published := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	rateRepo.On("GetRate", mock.Anything, "EUR", "USD", date).Return(newTestExchangeRate("EUR", "USD", "1.0956", published), nil)
+	amount, _ := valueobjects.NewDecimal("100.005")
+
+	// When
+	conversion, err := service.Convert(context.Background(), "eur", "usd", amount, date)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "109.57", conversion.Result)
+	assert.Equal(t, "1.0956", conversion.Rate.String())
+	assert.Equal(t, "2024-01-02", conversion.RateDate)
+	assert.Equal(t, ECBSource, conversion.Source)
+	assert.Empty(t, conversion.Via)
+}
+
+func TestExchangeRateService_Convert_Inverse(t *testing.T) {
+	// Given
+	service, rateRepo, _ := setupExchangeRateService()
+	date := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	rateRepo.On("GetRate", mock.Anything, "USD", "EUR", date).Return(nil, fmt.Errorf("exchange rate %w", repositories.ErrNotFound))
+	rateRepo.On("GetRate", mock.Anything, "EUR", "USD", date).Return(newTestExchangeRate("EUR", "USD", "1.25", date), nil)
+
+	// When
+	conversion, err := service.Convert(context.Background(), "USD", "EUR", valueobjects.NewDecimalFromInt(10), date)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "8.00", conversion.Result)
+	assert.Equal(t, "0.8", conversion.Rate.String())
+}
+
+func TestExchangeRateService_Convert_Triangulated(t *testing.T) {
+	// Given
+	service, rateRepo, _ := setupExchangeRateService()
+	date := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	older := time.Date(2023, 12, 29, 0, 0, 0, 0, time.UTC)
+	rateRepo.On("GetRate", mock.Anything, "USD", "IDR", date).Return(nil, fmt.Errorf("exchange rate %w", repositories.ErrNotFound))
+	rateRepo.On("GetRate", mock.Anything, "IDR", "USD", date).Return(nil, fmt.Errorf("exchange rate %w", repositories.ErrNotFound))
+	rateRepo.On("GetBaseCurrencies", mock.Anything).Return([]string{"EUR"}, nil)
+	rateRepo.On("GetRate", mock.Anything, "EUR", "USD", date).Return(newTestExchangeRate("EUR", "USD", "1.1", date), nil)
+	rateRepo.On("GetRate", mock.Anything, "EUR", "IDR", date).Return(newTestExchangeRate("EUR", "IDR", "17000.4", older), nil)
+
+	// When
+	conversion, err := service.Convert(context.Background(), "USD", "IDR", valueobjects.NewDecimalFromInt(3), date)
+
+	// Then
+	require.NoError(t, err)
+	// 3 * 17000.4 / 1.1 = 46364.7272..., rounded to the 0 decimal places of IDR
+	assert.Equal(t, "46365", conversion.Result)
+	assert.Equal(t, "EUR", conversion.Via)
+	assert.Equal(t, "2023-12-29", conversion.RateDate)
+}
+
+func TestExchangeRateService_Convert_NoRate(t *testing.T) {
+	// Given
+	service, rateRepo, _ := setupExchangeRateService()
+	date := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	rateRepo.On("GetRate", mock.Anything, mock.Anything, mock.Anything, date).Return(nil, fmt.Errorf("exchange rate %w", repositories.ErrNotFound))
+	rateRepo.On("GetBaseCurrencies", mock.Anything).Return([]string{"EUR"}, nil)
+
+	// When
+	conversion, err := service.Convert(context.Background(), "USD", "JPY", valueobjects.NewDecimalFromInt(1), date)
+
+	// Then
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, conversion)
+	assert.Contains(t, err.Error(), "exchange rate not found")
+}
+
+func TestExchangeRateService_Convert_SameCurrency(t *testing.T) {
+	// Given
+	service, rateRepo, _ := setupExchangeRateService()
+	amount, _ := valueobjects.NewDecimal("12.345")
+
+	// When
+	conversion, err := service.Convert(context.Background(), "USD", "USD", amount, time.Now())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "12.35", conversion.Result)
+	assert.Equal(t, "1", conversion.Rate.String())
+	rateRepo.AssertNotCalled(t, "GetRate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExchangeRateService_Convert_InvalidCurrency(t *testing.T) {
+	service, _, currencyRepo := setupExchangeRateService()
+	currencyRepo.On("GetByCode", mock.Anything, "XYZ").Return(nil, fmt.Errorf("currency %w", repositories.ErrNotFound))
+
+	_, err := service.Convert(context.Background(), "US", "EUR", valueobjects.NewDecimalFromInt(1), time.Now())
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Contains(t, err.Error(), "currency code 'US'")
+
+	_, err = service.Convert(context.Background(), "XYZ", "EUR", valueobjects.NewDecimalFromInt(1), time.Now())
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "currency not found")
+}
+
+func TestExchangeRateService_ImportRates(t *testing.T) {
+	date := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("all rates are stored at once", func(t *testing.T) {
+		// Given
+		service, rateRepo, _ := setupExchangeRateService()
+		rates := []*entities.ExchangeRate{
+			newTestExchangeRate("EUR", "USD", "1.0956", date),
+			newTestExchangeRate("EUR", "JPY", "155.86", date),
+		}
+		rateRepo.On("Upsert", mock.Anything, rates).Return(nil)
+
+		// When
+		imported, err := service.ImportRates(context.Background(), rates)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, 2, imported)
+		rateRepo.AssertNumberOfCalls(t, "Upsert", 1)
+	})
+
+	t.Run("an invalid rate stores nothing", func(t *testing.T) {
+		// Given
+		service, rateRepo, _ := setupExchangeRateService()
+		rates := []*entities.ExchangeRate{
+			newTestExchangeRate("EUR", "USD", "1.0956", date),
+			newTestExchangeRate("EUR", "EUR", "1", date),
+		}
+
+		// When
+		imported, err := service.ImportRates(context.Background(), rates)
+
+		// Then
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Zero(t, imported)
+		rateRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a failed upsert imports nothing", func(t *testing.T) {
+		// Given
+		service, rateRepo, _ := setupExchangeRateService()
+		rates := []*entities.ExchangeRate{newTestExchangeRate("EUR", "USD", "1.0956", date)}
+		rateRepo.On("Upsert", mock.Anything, rates).Return(errors.New("connection reset"))
+
+		// When
+		imported, err := service.ImportRates(context.Background(), rates)
+
+		// Then
+		assert.Error(t, err)
+		assert.Zero(t, imported)
+	})
+}
+
+func TestParseECBXML(t *testing.T) {
+	xml := `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender><gesmes:name>European Central Bank</gesmes:name></gesmes:Sender>
+	<Cube>
+		<Cube time="2024-01-03">
+			<Cube currency="USD" rate="1.0919"/>
+			<Cube currency="JPY" rate="155.52"/>
+		</Cube>
+		<Cube time="2024-01-02">
+			<Cube currency="USD" rate="1.0956"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+	rates, err := ParseECBXML(strings.NewReader(xml), "")
+
+	require.NoError(t, err)
+	require.Len(t, rates, 3)
+	assert.Equal(t, "EUR", rates[0].BaseCurrency)
+	assert.Equal(t, "USD", rates[0].QuoteCurrency)
+	assert.Equal(t, "1.0919", rates[0].Rate.String())
+	assert.Equal(t, "2024-01-03", rates[0].RateDate.Format(entities.ExchangeRateDateLayout))
+	assert.Equal(t, ECBSource, rates[0].Source)
+	assert.Equal(t, "2024-01-02", rates[2].RateDate.Format(entities.ExchangeRateDateLayout))
+
+	_, err = ParseECBXML(strings.NewReader(`<Envelope><Cube></Cube></Envelope>`), "")
+	assert.Error(t, err)
+}
+
+func TestParseExchangeRateCSV_Rows(t *testing.T) {
+	csv := "base,quote,rate,date,source\n" +
+		"USD,IDR,15500.25,2024-01-02,\n" +
+		"usd,sgd,1.3245,2024-01-02,MAS\n"
+
+	rates, err := ParseExchangeRateCSV(strings.NewReader(csv), "manual")
+
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+	assert.Equal(t, "USD", rates[0].BaseCurrency)
+	assert.Equal(t, "IDR", rates[0].QuoteCurrency)
+	assert.Equal(t, "manual", rates[0].Source)
+	assert.Equal(t, "SGD", rates[1].QuoteCurrency)
+	assert.Equal(t, "MAS", rates[1].Source)
+
+	_, err = ParseExchangeRateCSV(strings.NewReader("base,quote,rate,date\nUSD,IDR,abc,2024-01-02\n"), "")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "row 2")
+}
+
+func TestParseExchangeRateCSV_ECBLayout(t *testing.T) {
+	csv := "Date, USD, JPY, CYP, \n" +
+		"03 January 2024, 1.0919, 155.52, N/A, \n" +
+		"2024-01-02,1.0956,155.86,N/A,\n"
+
+	rates, err := ParseExchangeRateCSV(strings.NewReader(csv), "")
+
+	require.NoError(t, err)
+	require.Len(t, rates, 4)
+	assert.Equal(t, "EUR", rates[0].BaseCurrency)
+	assert.Equal(t, "USD", rates[0].QuoteCurrency)
+	assert.Equal(t, "2024-01-03", rates[0].RateDate.Format(entities.ExchangeRateDateLayout))
+	assert.Equal(t, "JPY", rates[3].QuoteCurrency)
+	assert.Equal(t, "155.86", rates[3].Rate.String())
+
+	_, err = ParseExchangeRateCSV(strings.NewReader("currency,value\nUSD,1\n"), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// GeoTypeService implements business logic for the geo types registry
+type GeoTypeService struct {
+	geoTypeRepo repositories.GeoTypeRepository
+}
+
+// NewGeoTypeService creates a new GeoTypeService instance
+func NewGeoTypeService(geoTypeRepo repositories.GeoTypeRepository) *GeoTypeService {
+	return &GeoTypeService{
+		geoTypeRepo: geoTypeRepo,
+	}
+}
+
+// LoadRegistry loads the registered geo types from the database into the in-memory registry
+// used by geodirectory type validation, depth and hierarchy level lookups
+func (s *GeoTypeService) LoadRegistry(ctx context.Context) error {
+	geoTypes, err := s.geoTypeRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load geo types: %w", err)
+	}
+
+	entities.LoadGeoTypes(geoTypes)
+	return nil
+}
+
+// GetAllGeoTypes retrieves all registered geo types ordered by hierarchy rank
+func (s *GeoTypeService) GetAllGeoTypes(ctx context.Context) ([]*entities.GeoTypeDefinition, error) {
+	return s.geoTypeRepo.GetAll(ctx)
+}
+
+// GetGeoTypeByCode retrieves a geo type by code
+func (s *GeoTypeService) GetGeoTypeByCode(ctx context.Context, code string) (*entities.GeoTypeDefinition, error) {
+	return s.geoTypeRepo.GetByCode(ctx, entities.GeoType(strings.ToUpper(code)))
+}
@@ -7,17 +7,23 @@ import (
 	"github.com/google/uuid"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 // GeodirectoryService implements business logic for geodirectory operations
 type GeodirectoryService struct {
-	geodirectoryRepo repositories.GeodirectoryRepository
+	geodirectoryRepo    repositories.GeodirectoryRepository
+	hierarchySchemaRepo repositories.HierarchySchemaRepository
 }
 
 // NewGeodirectoryService creates a new GeodirectoryService instance
-func NewGeodirectoryService(geodirectoryRepo repositories.GeodirectoryRepository) *GeodirectoryService {
+func NewGeodirectoryService(
+	geodirectoryRepo repositories.GeodirectoryRepository,
+	hierarchySchemaRepo repositories.HierarchySchemaRepository,
+) *GeodirectoryService {
 	return &GeodirectoryService{
-		geodirectoryRepo: geodirectoryRepo,
+		geodirectoryRepo:    geodirectoryRepo,
+		hierarchySchemaRepo: hierarchySchemaRepo,
 	}
 }
 
@@ -44,8 +50,8 @@ func (s *GeodirectoryService) CreateGeodirectory(
 			return nil, fmt.Errorf("parent geodirectory not found: %w", err)
 		}
 
-		if !geodirectory.CanHaveParentType(parent.Type) {
-			return nil, fmt.Errorf("geodirectory type %s cannot have parent type %s", geoType, parent.Type)
+		if err := s.validateParent(ctx, geodirectory, parent); err != nil {
+			return nil, err
 		}
 	}
 
@@ -77,6 +83,11 @@ func (s *GeodirectoryService) GetAllGeodirectories(ctx context.Context, limit, o
 	return s.geodirectoryRepo.GetAll(ctx, limit, offset)
 }
 
+// ListGeodirectories retrieves the geodirectories matching the filters of a list query in the requested order
+func (s *GeodirectoryService) ListGeodirectories(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Geodirectory, error) {
+	return s.geodirectoryRepo.List(ctx, query)
+}
+
 // SearchGeodirectories searches geodirectories by query
 func (s *GeodirectoryService) SearchGeodirectories(ctx context.Context, query string, limit, offset int) ([]*entities.Geodirectory, error) {
 	return s.geodirectoryRepo.Search(ctx, query, limit, offset)
@@ -235,8 +246,8 @@ func (s *GeodirectoryService) MoveGeodirectory(ctx context.Context, nodeID, newP
 	}
 
 	// Validate parent-child relationship
-	if !node.CanHaveParentType(parent.Type) {
-		return fmt.Errorf("geodirectory type %s cannot have parent type %s", node.Type, parent.Type)
+	if err := s.validateParent(ctx, node, parent); err != nil {
+		return err
 	}
 
 	// Check if the new parent is not a descendant of the node being moved
@@ -295,6 +306,8 @@ func (s *GeodirectoryService) ValidateHierarchy(ctx context.Context) ([]string,
 		return nil, fmt.Errorf("failed to get geodirectories: %w", err)
 	}
 
+	schemas := make(map[string]*entities.HierarchySchema)
+
 	// Validate each geodirectory
 	for _, geo := range geodirectories {
 		// Check if parent-child relationship is valid
@@ -305,8 +318,26 @@ func (s *GeodirectoryService) ValidateHierarchy(ctx context.Context) ([]string,
 				continue
 			}
 
-			if !geo.CanHaveParentType(parent.Type) {
-				errors = append(errors, fmt.Sprintf("Geodirectory %s (type %s) cannot have parent %s (type %s)", geo.Name, geo.Type, parent.Name, parent.Type))
+			countryCode := ""
+			if entities.IsCountryScoped(geo.Type) {
+				countryCode, err = s.resolveCountryCode(ctx, parent)
+				if err != nil {
+					errors = append(errors, fmt.Sprintf("Geodirectory %s: failed to resolve country: %v", geo.Name, err))
+					continue
+				}
+			}
+
+			schema, cached := schemas[countryCode]
+			if !cached {
+				schema, err = s.GetHierarchySchema(ctx, countryCode)
+				if err != nil {
+					return nil, err
+				}
+				schemas[countryCode] = schema
+			}
+
+			if !geo.CanHaveParentTypeIn(schema, parent.Type) {
+				errors = append(errors, fmt.Sprintf("Geodirectory %s (type %s) cannot have parent %s (type %s) in country %s", geo.Name, geo.Type, parent.Name, parent.Type, countryCode))
 			}
 		}
 
@@ -320,3 +351,79 @@ func (s *GeodirectoryService) ValidateHierarchy(ctx context.Context) ([]string,
 
 	return errors, nil
 }
+
+// Hierarchy schema operations
+
+// GetHierarchySchema retrieves the administrative hierarchy schema of a country.
+// Countries without rules of their own, and an empty country code, use the default schema.
+func (s *GeodirectoryService) GetHierarchySchema(ctx context.Context, countryCode string) (*entities.HierarchySchema, error) {
+	if countryCode == "" || s.hierarchySchemaRepo == nil {
+		return entities.DefaultHierarchySchema(), nil
+	}
+
+	rules, err := s.hierarchySchemaRepo.GetRulesByCountry(ctx, countryCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hierarchy rules for country %s: %w", countryCode, err)
+	}
+
+	return entities.NewHierarchySchema(countryCode, rules), nil
+}
+
+// validateParent checks the parent-child relationship against the schema of the country the node belongs to
+func (s *GeodirectoryService) validateParent(ctx context.Context, node, parent *entities.Geodirectory) error {
+	countryCode := ""
+	if entities.IsCountryScoped(node.Type) {
+		var err error
+		countryCode, err = s.resolveCountryCode(ctx, parent)
+		if err != nil {
+			return fmt.Errorf("failed to resolve country of parent geodirectory: %w", err)
+		}
+	}
+
+	schema, err := s.GetHierarchySchema(ctx, countryCode)
+	if err != nil {
+		return err
+	}
+
+	if !schema.AllowsType(node.Type) {
+		return fmt.Errorf("geodirectory type %s is not used in the hierarchy of country %s", node.Type, countryCode)
+	}
+
+	if !node.CanHaveParentTypeIn(schema, parent.Type) {
+		if countryCode != "" {
+			return fmt.Errorf("geodirectory type %s cannot have parent type %s in country %s", node.Type, parent.Type, countryCode)
+		}
+		return fmt.Errorf("geodirectory type %s cannot have parent type %s", node.Type, parent.Type)
+	}
+
+	return nil
+}
+
+// resolveCountryCode finds the code of the COUNTRY geodirectory a node (or the node itself) belongs to
+func (s *GeodirectoryService) resolveCountryCode(ctx context.Context, node *entities.Geodirectory) (string, error) {
+	if node.Type == entities.GeoTypeCountry {
+		return countryCodeOf(node), nil
+	}
+
+	ancestors, err := s.geodirectoryRepo.GetAncestors(ctx, node.ID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, ancestor := range ancestors {
+		if ancestor.Type == entities.GeoTypeCountry {
+			return countryCodeOf(ancestor), nil
+		}
+	}
+
+	// Nodes outside any country are validated against the default schema
+	return "", nil
+}
+
+// countryCodeOf returns the code of a COUNTRY geodirectory
+func countryCodeOf(country *entities.Geodirectory) string {
+	if country.Code != nil {
+		return *country.Code
+	}
+	return ""
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// IBANValidationResult holds the outcome of an IBAN validation
+type IBANValidationResult struct {
+	IBAN             string                 `json:"iban"`
+	Formatted        string                 `json:"formatted,omitempty"`
+	Valid            bool                   `json:"valid"`
+	Errors           []string               `json:"errors,omitempty"`
+	CountryCode      string                 `json:"country_code,omitempty"`
+	CheckDigits      string                 `json:"check_digits,omitempty"`
+	BBAN             string                 `json:"bban,omitempty"`
+	BankIdentifier   string                 `json:"bank_identifier,omitempty"`
+	BranchIdentifier string                 `json:"branch_identifier,omitempty"`
+	IsSEPA           bool                   `json:"is_sepa"`
+	Country          *entities.Geodirectory `json:"country,omitempty"`
+	Bank             *entities.Bank         `json:"bank,omitempty"`
+}
+
+// IBANService implements business logic for IBAN validation and IBAN formats
+type IBANService struct {
+	ibanFormatRepo   repositories.IBANFormatRepository
+	geodirectoryRepo repositories.GeodirectoryRepository
+	bankRepo         repositories.BankRepository
+}
+
+// NewIBANService creates a new IBANService instance
+func NewIBANService(
+	ibanFormatRepo repositories.IBANFormatRepository,
+	geodirectoryRepo repositories.GeodirectoryRepository,
+	bankRepo repositories.BankRepository,
+) *IBANService {
+	return &IBANService{
+		ibanFormatRepo:   ibanFormatRepo,
+		geodirectoryRepo: geodirectoryRepo,
+		bankRepo:         bankRepo,
+	}
+}
+
+// GetAllIBANFormats retrieves all IBAN formats ordered by country code
+func (s *IBANService) GetAllIBANFormats(ctx context.Context) ([]*entities.IBANFormat, error) {
+	return s.ibanFormatRepo.GetAll(ctx)
+}
+
+// GetIBANFormatByCountryCode retrieves the IBAN format of a country
+func (s *IBANService) GetIBANFormatByCountryCode(ctx context.Context, countryCode string) (*entities.IBANFormat, error) {
+	return s.ibanFormatRepo.GetByCountryCode(ctx, strings.ToUpper(countryCode))
+}
+
+// ValidateIBAN validates an IBAN against its country format and the mod-97 checksum, extracts the
+// bank and branch identifiers and resolves the COUNTRY geodirectory and the bank when possible.
+// Validation failures are reported in the result; an error is only returned when lookups fail.
+func (s *IBANService) ValidateIBAN(ctx context.Context, iban string) (*IBANValidationResult, error) {
+	result := &IBANValidationResult{IBAN: valueobjects.NormalizeIBAN(iban)}
+
+	value, err := valueobjects.NewIBAN(iban)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+		return result, nil
+	}
+
+	result.Formatted = value.Formatted()
+	result.CountryCode = value.CountryCode()
+	result.CheckDigits = value.CheckDigits()
+	result.BBAN = value.BBAN()
+
+	format, err := s.ibanFormatRepo.GetByCountryCode(ctx, value.CountryCode())
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			result.Errors = append(result.Errors, fmt.Sprintf("country '%s' does not use IBAN", value.CountryCode()))
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to get IBAN format: %w", err)
+	}
+
+	result.IsSEPA = format.IsSEPA
+
+	if len(value.Value()) != format.Length {
+		result.Errors = append(result.Errors, fmt.Sprintf("IBAN for country '%s' must be %d characters: %d given", format.CountryCode, format.Length, len(value.Value())))
+	} else if !format.MatchesBBAN(value.BBAN()) {
+		result.Errors = append(result.Errors, fmt.Sprintf("BBAN does not match the structure %s of country '%s'", format.BBANStructure, format.CountryCode))
+	}
+
+	if len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	result.Valid = true
+	result.BankIdentifier = format.BankIdentifier(value.BBAN())
+	result.BranchIdentifier = format.BranchIdentifier(value.BBAN())
+
+	// Resolving the country and bank is best effort: not every country or bank is part of the master data
+	if country, err := s.geodirectoryRepo.GetCountryByCode(ctx, value.CountryCode()); err == nil {
+		result.Country = country
+	}
+	if bank, err := s.bankRepo.GetByCode(ctx, result.BankIdentifier); err == nil {
+		result.Bank = bank
+	}
+
+	return result, nil
+}
@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// MockIBANFormatRepository is a mock implementation of IBANFormatRepository
+type MockIBANFormatRepository struct {
+	mock.Mock
+}
+
+func (m *MockIBANFormatRepository) Create(ctx context.Context, format *entities.IBANFormat) error {
+	args := m.Called(ctx, format)
+	return args.Error(0)
+}
+
+func (m *MockIBANFormatRepository) GetByCountryCode(ctx context.Context, countryCode string) (*entities.IBANFormat, error) {
+	args := m.Called(ctx, countryCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.IBANFormat), args.Error(1)
+}
+
+func (m *MockIBANFormatRepository) GetAll(ctx context.Context) ([]*entities.IBANFormat, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.IBANFormat), args.Error(1)
+}
+
+func (m *MockIBANFormatRepository) Update(ctx context.Context, format *entities.IBANFormat) error {
+	args := m.Called(ctx, format)
+	return args.Error(0)
+}
+
+func (m *MockIBANFormatRepository) Delete(ctx context.Context, countryCode string) error {
+	args := m.Called(ctx, countryCode)
+	return args.Error(0)
+}
+
+// MockGeodirectoryLookupRepository mocks the lookups of GeodirectoryRepository used by services depending
+// on geodirectories; other methods are not implemented
+type MockGeodirectoryLookupRepository struct {
+	repositories.GeodirectoryRepository
+	mock.Mock
+}
+
+func (m *MockGeodirectoryLookupRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Geodirectory, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Geodirectory), args.Error(1)
+}
+
+func (m *MockGeodirectoryLookupRepository) GetCountryByCode(ctx context.Context, code string) (*entities.Geodirectory, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Geodirectory), args.Error(1)
+}
+
+func germanIBANFormat() *entities.IBANFormat {
+	format := entities.NewIBANFormat("DE", 22, "8!n10!n", 0, 8)
+	format.SetSEPA(true)
+	return format
+}
+
+func TestIBANService_ValidateIBAN(t *testing.T) {
+	t.Run("valid IBAN resolves country and bank", func(t *testing.T) {
+		// Given
+		formatRepo := &MockIBANFormatRepository{}
+		geoRepo := &MockGeodirectoryLookupRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewIBANService(formatRepo, geoRepo, bankRepo)
+		ctx := context.Background()
+
+		countryCode := "DE"
+		country := &entities.Geodirectory{ID: uuid.New(), Name: "Germany", Code: &countryCode, Type: entities.GeoTypeCountry}
+		bank := &entities.Bank{ID: uuid.New(), Name: "Commerzbank", Code: "37040044"}
+
+		formatRepo.On("GetByCountryCode", ctx, "DE").Return(germanIBANFormat(), nil)
+		geoRepo.On("GetCountryByCode", ctx, "DE").Return(country, nil)
+		bankRepo.On("GetByCode", ctx, "37040044").Return(bank, nil)
+
+		// When
+		result, err := service.ValidateIBAN(ctx, "de89 3704 0044 0532 0130 00")
+
+		// Then
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, "DE89370400440532013000", result.IBAN)
+		assert.Equal(t, "DE89 3704 0044 0532 0130 00", result.Formatted)
+		assert.Equal(t, "DE", result.CountryCode)
+		assert.Equal(t, "89", result.CheckDigits)
+		assert.Equal(t, "37040044", result.BankIdentifier)
+		assert.Empty(t, result.BranchIdentifier)
+		assert.True(t, result.IsSEPA)
+		assert.Equal(t, country, result.Country)
+		assert.Equal(t, bank, result.Bank)
+		formatRepo.AssertExpectations(t)
+		geoRepo.AssertExpectations(t)
+		bankRepo.AssertExpectations(t)
+	})
+
+	t.Run("valid IBAN with unknown bank", func(t *testing.T) {
+		// Given
+		formatRepo := &MockIBANFormatRepository{}
+		geoRepo := &MockGeodirectoryLookupRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewIBANService(formatRepo, geoRepo, bankRepo)
+		ctx := context.Background()
+
+		formatRepo.On("GetByCountryCode", ctx, "DE").Return(germanIBANFormat(), nil)
+		geoRepo.On("GetCountryByCode", ctx, "DE").Return(nil, errors.New("country not found"))
+		bankRepo.On("GetByCode", ctx, "37040044").Return(nil, errors.New("bank not found"))
+
+		// When
+		result, err := service.ValidateIBAN(ctx, "DE89370400440532013000")
+
+		// Then
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+		assert.Nil(t, result.Country)
+		assert.Nil(t, result.Bank)
+	})
+
+	t.Run("invalid checksum", func(t *testing.T) {
+		// Given
+		formatRepo := &MockIBANFormatRepository{}
+		service := NewIBANService(formatRepo, &MockGeodirectoryLookupRepository{}, &MockBankRepository{})
+		ctx := context.Background()
+
+		// When
+		result, err := service.ValidateIBAN(ctx, "DE88370400440532013000")
+
+		// Then
+		require.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Contains(t, result.Errors[0], "invalid IBAN checksum")
+		formatRepo.AssertNotCalled(t, "GetByCountryCode", mock.Anything, mock.Anything)
+	})
+
+	t.Run("wrong length for country", func(t *testing.T) {
+		// Given
+		formatRepo := &MockIBANFormatRepository{}
+		service := NewIBANService(formatRepo, &MockGeodirectoryLookupRepository{}, &MockBankRepository{})
+		ctx := context.Background()
+
+		formatRepo.On("GetByCountryCode", ctx, "DE").Return(germanIBANFormat(), nil)
+
+		// When: a valid checksum with one digit too many
+		result, err := service.ValidateIBAN(ctx, "DE543704004405320130001")
+
+		// Then
+		require.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Contains(t, result.Errors[0], "must be 22 characters")
+	})
+
+	t.Run("country without IBAN", func(t *testing.T) {
+		// Given
+		formatRepo := &MockIBANFormatRepository{}
+		service := NewIBANService(formatRepo, &MockGeodirectoryLookupRepository{}, &MockBankRepository{})
+		ctx := context.Background()
+
+		formatRepo.On("GetByCountryCode", ctx, "US").Return(nil, fmt.Errorf("IBAN format %w", repositories.ErrNotFound))
+
+		// When
+		result, err := service.ValidateIBAN(ctx, "US64SVBKUS6S3300958879")
+
+		// Then
+		require.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Contains(t, result.Errors[0], "does not use IBAN")
+	})
+
+	t.Run("format lookup failure", func(t *testing.T) {
+		// Given
+		formatRepo := &MockIBANFormatRepository{}
+		service := NewIBANService(formatRepo, &MockGeodirectoryLookupRepository{}, &MockBankRepository{})
+		ctx := context.Background()
+
+		formatRepo.On("GetByCountryCode", ctx, "DE").Return(nil, errors.New("connection refused"))
+
+		// When
+		result, err := service.ValidateIBAN(ctx, "DE89370400440532013000")
+
+		// Then
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("lookup failure mentioning not found", func(t *testing.T) {
+		// Given
+		formatRepo := &MockIBANFormatRepository{}
+		service := NewIBANService(formatRepo, &MockGeodirectoryLookupRepository{}, &MockBankRepository{})
+		ctx := context.Background()
+
+		formatRepo.On("GetByCountryCode", ctx, "DE").Return(nil, errors.New(`relation "tm_iban_formats" not found`))
+
+		// When
+		result, err := service.ValidateIBAN(ctx, "DE89370400440532013000")
+
+		// Then
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
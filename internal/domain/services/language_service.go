@@ -3,10 +3,12 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 )
 
 // LanguageService implements business logic for language operations
@@ -71,6 +73,17 @@ func (s *LanguageService) GetLanguageByCode(ctx context.Context, code string) (*
 	return s.languageRepo.GetByCode(ctx, code)
 }
 
+// GetLanguageByAnyCode retrieves a language by its code or any of its ISO 639-1, ISO 639-2/T, ISO 639-2/B or
+// ISO 639-3 codes, e.g. "de", "deu" or "ger"
+func (s *LanguageService) GetLanguageByAnyCode(ctx context.Context, code string) (*entities.Language, error) {
+	code = strings.TrimSpace(code)
+	if code == "" || len(code) > 10 {
+		return nil, fmt.Errorf("%w: language code '%s' must be 1 to 10 characters", ErrInvalidInput, code)
+	}
+
+	return s.languageRepo.GetByAnyCode(ctx, code)
+}
+
 // GetLanguageByName retrieves a language by name
 func (s *LanguageService) GetLanguageByName(ctx context.Context, name string) (*entities.Language, error) {
 	return s.languageRepo.GetByName(ctx, name)
@@ -81,6 +94,11 @@ func (s *LanguageService) GetAllLanguages(ctx context.Context, limit, offset int
 	return s.languageRepo.GetAll(ctx, limit, offset)
 }
 
+// ListLanguages retrieves the languages matching the filters of a list query in the requested order
+func (s *LanguageService) ListLanguages(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Language, error) {
+	return s.languageRepo.List(ctx, query)
+}
+
 // GetActiveLanguages retrieves all active languages
 func (s *LanguageService) GetActiveLanguages(ctx context.Context, limit, offset int) ([]*entities.Language, error) {
 	return s.languageRepo.GetActive(ctx, limit, offset)
@@ -144,7 +162,7 @@ func (s *LanguageService) ValidateLanguage(language *entities.Language) error {
 	}
 
 	if !language.IsValid() {
-		return fmt.Errorf("language validation failed: name and code are required, code must be 10 characters or less")
+		return fmt.Errorf("language validation failed: name and code are required, code must be 10 characters or less, ISO 639 codes must be 2 or 3 lowercase letters, script a 4 letter ISO 15924 code and direction ltr or rtl")
 	}
 
 	return nil
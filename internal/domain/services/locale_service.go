@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// LocaleMatch is the locale negotiated for an Accept-Language header, with the language range it matched
+type LocaleMatch struct {
+	Locale       *entities.Locale `json:"locale"`
+	MatchedRange string           `json:"matched_range,omitempty"`
+	Quality      float64          `json:"quality,omitempty"`
+	IsDefault    bool             `json:"is_default"`
+}
+
+// LocaleService implements business logic for locale operations
+type LocaleService struct {
+	localeRepo   repositories.LocaleRepository
+	currencyRepo repositories.CurrencyRepository
+}
+
+// NewLocaleService creates a new LocaleService instance
+func NewLocaleService(localeRepo repositories.LocaleRepository, currencyRepo repositories.CurrencyRepository) *LocaleService {
+	return &LocaleService{
+		localeRepo:   localeRepo,
+		currencyRepo: currencyRepo,
+	}
+}
+
+// GetLocales retrieves all locales with pagination
+func (s *LocaleService) GetLocales(ctx context.Context, limit, offset int) ([]*entities.Locale, error) {
+	locales, err := s.localeRepo.GetAll(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	s.populateCurrencies(ctx, locales)
+	return locales, nil
+}
+
+// GetLocaleByTag retrieves a locale by its BCP 47 tag, accepting any casing and underscores such as "id_id"
+func (s *LocaleService) GetLocaleByTag(ctx context.Context, tag string) (*entities.Locale, error) {
+	normalized, err := valueobjects.NormalizeLanguageTag(tag)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	locale, err := s.localeRepo.GetByTag(ctx, normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	s.populateCurrencies(ctx, []*entities.Locale{locale})
+	return locale, nil
+}
+
+// NegotiateLocale finds the active locale that best matches an Accept-Language header. Language ranges are
+// tried from the most preferred; each range matches a locale with the same tag or, failing that, a locale whose
+// tag extends the range or a shorter form of it, so "en-NZ" falls back to the default English locale. The
+// default locale of a language is the bundled money locale of the language (e.g. en-US for en). When nothing
+// matches, DefaultMoneyLocale is returned.
+func (s *LocaleService) NegotiateLocale(ctx context.Context, acceptLanguage string) (*LocaleMatch, error) {
+	locales, err := s.localeRepo.GetActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve locales: %w", err)
+	}
+	if len(locales) == 0 {
+		return nil, fmt.Errorf("locale %w: no active locales", ErrNotFound)
+	}
+
+	var match *LocaleMatch
+	for _, languageRange := range valueobjects.ParseAcceptLanguage(acceptLanguage) {
+		if languageRange.Tag == "*" {
+			break
+		}
+		if locale := matchLocale(locales, languageRange.Tag); locale != nil {
+			match = &LocaleMatch{Locale: locale, MatchedRange: languageRange.Tag, Quality: languageRange.Quality}
+			break
+		}
+	}
+
+	if match == nil {
+		locale := findLocale(locales, DefaultMoneyLocale)
+		if locale == nil {
+			locale = locales[0]
+		}
+		match = &LocaleMatch{Locale: locale, IsDefault: true}
+	}
+
+	s.populateCurrencies(ctx, []*entities.Locale{match.Locale})
+	return match, nil
+}
+
+// matchLocale finds the locale for a language range: the locale with the same tag, or else a locale whose tag
+// extends the range or one of its truncations, preferring the default locale of the language
+func matchLocale(locales []*entities.Locale, languageRange string) *entities.Locale {
+	if locale := findLocale(locales, languageRange); locale != nil {
+		return locale
+	}
+
+	defaultTag := ""
+	if moneyLocale, err := FindMoneyLocale(valueobjects.LanguageTagLanguage(languageRange)); err == nil &&
+		valueobjects.LanguageTagLanguage(moneyLocale.Tag) == valueobjects.LanguageTagLanguage(languageRange) {
+		defaultTag = moneyLocale.Tag
+	}
+
+	for prefix := languageRange; prefix != ""; prefix = truncateLanguageRange(prefix) {
+		var candidates []*entities.Locale
+		for _, locale := range locales {
+			if strings.HasPrefix(strings.ToLower(locale.Tag), strings.ToLower(prefix)+"-") {
+				candidates = append(candidates, locale)
+			}
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		if locale := findLocale(candidates, defaultTag); locale != nil {
+			return locale
+		}
+		return candidates[0]
+	}
+
+	return nil
+}
+
+// findLocale finds the locale with the tag, ignoring case
+func findLocale(locales []*entities.Locale, tag string) *entities.Locale {
+	for _, locale := range locales {
+		if strings.EqualFold(locale.Tag, tag) {
+			return locale
+		}
+	}
+	return nil
+}
+
+// truncateLanguageRange removes the last subtag of a language range, e.g. "zh-Hant-TW" becomes "zh-Hant"
+func truncateLanguageRange(languageRange string) string {
+	i := strings.LastIndex(languageRange, "-")
+	if i < 0 {
+		return ""
+	}
+	return languageRange[:i]
+}
+
+// populateCurrencies loads the default currencies of locales; locales whose currency cannot be loaded are left without one
+func (s *LocaleService) populateCurrencies(ctx context.Context, locales []*entities.Locale) {
+	currencies := make(map[uuid.UUID]*entities.Currency)
+
+	for _, locale := range locales {
+		if locale.CurrencyID == nil {
+			continue
+		}
+
+		currency, ok := currencies[*locale.CurrencyID]
+		if !ok {
+			currency, _ = s.currencyRepo.GetByID(ctx, *locale.CurrencyID)
+			currencies[*locale.CurrencyID] = currency
+		}
+		locale.Currency = currency
+	}
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+)
+
+// MockLocaleRepository is a mock implementation of LocaleRepository
+type MockLocaleRepository struct {
+	mock.Mock
+}
+
+func (m *MockLocaleRepository) Create(ctx context.Context, locale *entities.Locale) error {
+	args := m.Called(ctx, locale)
+	return args.Error(0)
+}
+
+func (m *MockLocaleRepository) GetByTag(ctx context.Context, tag string) (*entities.Locale, error) {
+	args := m.Called(ctx, tag)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Locale), args.Error(1)
+}
+
+func (m *MockLocaleRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.Locale, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Locale), args.Error(1)
+}
+
+func (m *MockLocaleRepository) Update(ctx context.Context, locale *entities.Locale) error {
+	args := m.Called(ctx, locale)
+	return args.Error(0)
+}
+
+func (m *MockLocaleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockLocaleRepository) GetActive(ctx context.Context) ([]*entities.Locale, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Locale), args.Error(1)
+}
+
+func (m *MockLocaleRepository) GetByLanguage(ctx context.Context, languageID uuid.UUID) ([]*entities.Locale, error) {
+	args := m.Called(ctx, languageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Locale), args.Error(1)
+}
+
+func newTestLocales(tags ...string) []*entities.Locale {
+	locales := make([]*entities.Locale, 0, len(tags))
+	for _, tag := range tags {
+		locales = append(locales, entities.NewLocale(tag, uuid.New(), uuid.New(), tag))
+	}
+	return locales
+}
+
+func TestLocaleService_GetLocaleByTag(t *testing.T) {
+	// Given
+	localeRepo := new(MockLocaleRepository)
+	currencyRepo := new(MockCurrencyLookupRepository)
+	service := NewLocaleService(localeRepo, currencyRepo)
+	ctx := context.Background()
+
+	rupiah := entities.NewCurrency("Rupiah", "IDR", 0)
+	locale := newTestLocales("id-ID")[0]
+	locale.SetDefaultCurrency(&rupiah.ID)
+
+	localeRepo.On("GetByTag", ctx, "id-ID").Return(locale, nil)
+	currencyRepo.On("GetByID", ctx, rupiah.ID).Return(rupiah, nil)
+
+	t.Run("normalizes the tag and populates the currency", func(t *testing.T) {
+		// When
+		result, err := service.GetLocaleByTag(ctx, "id_id")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "id-ID", result.Tag)
+		assert.Equal(t, rupiah, result.Currency)
+	})
+
+	t.Run("invalid tag", func(t *testing.T) {
+		// When
+		result, err := service.GetLocaleByTag(ctx, "not a tag")
+
+		// Then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Contains(t, err.Error(), "invalid language tag")
+	})
+}
+
+func TestLocaleService_NegotiateLocale(t *testing.T) {
+	locales := newTestLocales("de-CH", "de-DE", "en-GB", "en-US", "id-ID", "pt-PT", "pt-BR", "zh-CN")
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expectedTag    string
+		expectedRange  string
+		expectDefault  bool
+	}{
+		{name: "exact match", acceptLanguage: "en-GB,en;q=0.9", expectedTag: "en-GB", expectedRange: "en-GB"},
+		{name: "case insensitive", acceptLanguage: "ID_id", expectedTag: "id-ID", expectedRange: "id-ID"},
+		{name: "language prefers its default locale", acceptLanguage: "de", expectedTag: "de-DE", expectedRange: "de"},
+		{name: "region falls back to the default locale", acceptLanguage: "en-NZ", expectedTag: "en-US", expectedRange: "en-NZ"},
+		{name: "language default over the first candidate", acceptLanguage: "pt", expectedTag: "pt-BR", expectedRange: "pt"},
+		{name: "script falls back to the language", acceptLanguage: "zh-Hans-SG", expectedTag: "zh-CN", expectedRange: "zh-Hans-SG"},
+		{name: "quality order", acceptLanguage: "fr;q=0.9, id;q=0.5, en;q=0.8", expectedTag: "en-US", expectedRange: "en"},
+		{name: "unknown languages use the default", acceptLanguage: "fr-FR, ja", expectedTag: "en-US", expectDefault: true},
+		{name: "wildcard uses the default", acceptLanguage: "fr, *;q=0.5, id;q=0.1", expectedTag: "en-US", expectDefault: true},
+		{name: "empty header uses the default", acceptLanguage: "", expectedTag: "en-US", expectDefault: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			localeRepo := new(MockLocaleRepository)
+			service := NewLocaleService(localeRepo, new(MockCurrencyLookupRepository))
+			ctx := context.Background()
+			localeRepo.On("GetActive", ctx).Return(locales, nil)
+
+			// When
+			match, err := service.NegotiateLocale(ctx, tt.acceptLanguage)
+
+			// Then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTag, match.Locale.Tag)
+			assert.Equal(t, tt.expectedRange, match.MatchedRange)
+			assert.Equal(t, tt.expectDefault, match.IsDefault)
+		})
+	}
+}
+
+func TestLocaleService_NegotiateLocale_Fallbacks(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("first active locale without the default locale", func(t *testing.T) {
+		// Given
+		localeRepo := new(MockLocaleRepository)
+		service := NewLocaleService(localeRepo, new(MockCurrencyLookupRepository))
+		localeRepo.On("GetActive", ctx).Return(newTestLocales("id-ID", "ms-MY"), nil)
+
+		// When
+		match, err := service.NegotiateLocale(ctx, "ja-JP")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "id-ID", match.Locale.Tag)
+		assert.True(t, match.IsDefault)
+	})
+
+	t.Run("no active locales", func(t *testing.T) {
+		// Given
+		localeRepo := new(MockLocaleRepository)
+		service := NewLocaleService(localeRepo, new(MockCurrencyLookupRepository))
+		localeRepo.On("GetActive", ctx).Return([]*entities.Locale{}, nil)
+
+		// When
+		match, err := service.NegotiateLocale(ctx, "en")
+
+		// Then
+		assert.Nil(t, match)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		// Given
+		localeRepo := new(MockLocaleRepository)
+		service := NewLocaleService(localeRepo, new(MockCurrencyLookupRepository))
+		localeRepo.On("GetActive", ctx).Return(nil, errors.New("connection refused"))
+
+		// When
+		match, err := service.NegotiateLocale(ctx, "en")
+
+		// Then
+		assert.Nil(t, match)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+const (
+	// DefaultMoneyLocale is the locale amounts are formatted in when none is requested
+	DefaultMoneyLocale = "en-US"
+	// MaxFormatBatchSize limits the number of amounts formatted in one batch
+	MaxFormatBatchSize = 100
+
+	// SymbolPositionBefore and SymbolPositionAfter place the currency symbol before or after the number
+	SymbolPositionBefore = "before"
+	SymbolPositionAfter  = "after"
+
+	// symbolSpace is the non-breaking space between the currency symbol and the number when a locale spaces them
+	symbolSpace = "\u00a0"
+)
+
+// moneyLocalesJSON holds the bundled money formatting conventions of the supported locales
+//
+//go:embed money_locales.json
+var moneyLocalesJSON []byte
+
+// MoneyLocale holds the money formatting conventions of a locale
+type MoneyLocale struct {
+	Tag              string            `json:"tag"`
+	DecimalSeparator string            `json:"decimal_separator"`
+	GroupSeparator   string            `json:"group_separator"`
+	Grouping         []int             `json:"grouping"`
+	SymbolPosition   string            `json:"symbol_position"`
+	SymbolSpacing    bool              `json:"symbol_spacing"`
+	Symbols          map[string]string `json:"symbols,omitempty"`
+}
+
+// moneyLocaleData is the document of the bundled locale file: the locales and the default locale of each language
+type moneyLocaleData struct {
+	Locales   []*MoneyLocale    `json:"locales"`
+	Languages map[string]string `json:"languages"`
+}
+
+// moneyLocales indexes the bundled locales by lower-case tag and language
+var moneyLocales = loadMoneyLocales()
+
+// loadMoneyLocales parses the bundled locale file; it panics on invalid data since the file is part of the binary
+func loadMoneyLocales() map[string]*MoneyLocale {
+	var data moneyLocaleData
+	if err := json.Unmarshal(moneyLocalesJSON, &data); err != nil {
+		panic(fmt.Sprintf("invalid bundled money locales: %v", err))
+	}
+
+	locales := make(map[string]*MoneyLocale)
+	for _, locale := range data.Locales {
+		locales[strings.ToLower(locale.Tag)] = locale
+	}
+	for language, tag := range data.Languages {
+		locale, ok := locales[strings.ToLower(tag)]
+		if !ok {
+			panic(fmt.Sprintf("invalid bundled money locales: unknown default locale %s of language %s", tag, language))
+		}
+		locales[strings.ToLower(language)] = locale
+	}
+
+	return locales
+}
+
+// FindMoneyLocale finds the formatting conventions of a locale such as "de-DE" or "de_DE", falling back
+// to the default locale of its language. An empty tag selects DefaultMoneyLocale.
+func FindMoneyLocale(tag string) (*MoneyLocale, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	if normalized == "" {
+		normalized = strings.ToLower(DefaultMoneyLocale)
+	}
+
+	if locale, ok := moneyLocales[normalized]; ok {
+		return locale, nil
+	}
+
+	language, _, _ := strings.Cut(normalized, "-")
+	if locale, ok := moneyLocales[language]; ok {
+		return locale, nil
+	}
+
+	return nil, fmt.Errorf("%w: locale '%s' is not supported; supported locales are %s", ErrInvalidInput, tag, strings.Join(SupportedMoneyLocales(), ", "))
+}
+
+// SupportedMoneyLocales returns the tags of the bundled locales in alphabetical order
+func SupportedMoneyLocales() []string {
+	seen := make(map[string]bool)
+	var tags []string
+	for _, locale := range moneyLocales {
+		if !seen[locale.Tag] {
+			seen[locale.Tag] = true
+			tags = append(tags, locale.Tag)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// FormatMoney formats an amount of a currency following the conventions of a locale: the amount is rounded
+// to the decimal places of the currency, grouped, and the symbol is placed where the locale puts it.
+// The locale's own symbol of the currency is preferred over the currency symbol, which is preferred over the code.
+func FormatMoney(amount valueobjects.Decimal, currency *entities.Currency, locale *MoneyLocale) string {
+	number := amount.Abs().StringFixed(currency.DecimalPlaces)
+	integer, fraction, _ := strings.Cut(number, ".")
+
+	formatted := groupDigits(integer, locale.GroupSeparator, locale.Grouping)
+	if fraction != "" {
+		formatted += locale.DecimalSeparator + fraction
+	}
+
+	symbol := currency.Code
+	if currency.Symbol != nil && *currency.Symbol != "" {
+		symbol = *currency.Symbol
+	}
+	if localSymbol, ok := locale.Symbols[currency.Code]; ok {
+		symbol = localSymbol
+	}
+
+	spacing := ""
+	if locale.SymbolSpacing {
+		spacing = symbolSpace
+	}
+
+	if locale.SymbolPosition == SymbolPositionAfter {
+		formatted = formatted + spacing + symbol
+	} else {
+		formatted = symbol + spacing + formatted
+	}
+
+	// A rounded zero is never shown as negative
+	if amount.Sign() < 0 && strings.Trim(number, "0.") != "" {
+		formatted = "-" + formatted
+	}
+
+	return formatted
+}
+
+// groupDigits inserts the group separator into the integer digits. The first size of the grouping applies
+// to the rightmost group and the last size to all further groups, e.g. [3, 2] for 12,34,567.
+func groupDigits(digits, separator string, grouping []int) string {
+	if len(grouping) == 0 || grouping[0] <= 0 {
+		return digits
+	}
+
+	var groups []string
+	size := grouping[0]
+	for i := 1; len(digits) > size; i++ {
+		groups = append([]string{digits[len(digits)-size:]}, groups...)
+		digits = digits[:len(digits)-size]
+		if i < len(grouping) && grouping[i] > 0 {
+			size = grouping[i]
+		}
+	}
+	groups = append([]string{digits}, groups...)
+
+	return strings.Join(groups, separator)
+}
+
+// FormattedAmount holds an amount formatted for a locale, or the reason it could not be formatted
+type FormattedAmount struct {
+	Currency  string `json:"currency"`
+	Amount    string `json:"amount"`
+	Locale    string `json:"locale"`
+	Formatted string `json:"formatted,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// MoneyFormatItem is an amount of a currency to format in a batch
+type MoneyFormatItem struct {
+	Currency string `json:"currency"`
+	Amount   string `json:"amount"`
+	Locale   string `json:"locale,omitempty"`
+}
+
+// FormatAmount formats an amount of the currency identified by its code for a locale
+func (s *CurrencyService) FormatAmount(ctx context.Context, code string, amount valueobjects.Decimal, localeTag string) (*FormattedAmount, error) {
+	locale, err := FindMoneyLocale(localeTag)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, err := s.currencyRepo.GetByCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &FormattedAmount{
+		Currency:  currency.Code,
+		Amount:    amount.String(),
+		Locale:    locale.Tag,
+		Formatted: FormatMoney(amount, currency, locale),
+	}, nil
+}
+
+// FormatAmounts formats a batch of amounts. Items without a locale use the default locale of the batch.
+// Items that cannot be formatted carry the reason instead of failing the batch.
+func (s *CurrencyService) FormatAmounts(ctx context.Context, items []MoneyFormatItem, defaultLocale string) ([]*FormattedAmount, error) {
+	if len(items) > MaxFormatBatchSize {
+		return nil, fmt.Errorf("%w: at most %d amounts can be formatted at once", ErrInvalidInput, MaxFormatBatchSize)
+	}
+
+	currencies := make(map[string]*entities.Currency)
+	results := make([]*FormattedAmount, 0, len(items))
+
+	for _, item := range items {
+		result := &FormattedAmount{
+			Currency: strings.ToUpper(strings.TrimSpace(item.Currency)),
+			Amount:   item.Amount,
+			Locale:   item.Locale,
+		}
+		results = append(results, result)
+
+		if result.Locale == "" {
+			result.Locale = defaultLocale
+		}
+		locale, err := FindMoneyLocale(result.Locale)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		result.Locale = locale.Tag
+
+		amount, err := valueobjects.NewDecimal(item.Amount)
+		if err != nil {
+			result.Error = "invalid amount: " + err.Error()
+			continue
+		}
+
+		currency, ok := currencies[result.Currency]
+		if !ok {
+			currency, err = s.currencyRepo.GetByCode(ctx, result.Currency)
+			if err != nil {
+				if !errors.Is(err, repositories.ErrNotFound) {
+					return nil, fmt.Errorf("failed to get currency: %w", err)
+				}
+				result.Error = fmt.Sprintf("currency not found: %s", result.Currency)
+				continue
+			}
+			currencies[result.Currency] = currency
+		}
+
+		result.Amount = amount.String()
+		result.Formatted = FormatMoney(amount, currency, locale)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+func newTestCurrency(name, code, symbol string, decimalPlaces int) *entities.Currency {
+	currency := entities.NewCurrency(name, code, decimalPlaces)
+	if symbol != "" {
+		currency.SetSymbol(symbol)
+	}
+	return currency
+}
+
+func TestFormatMoney(t *testing.T) {
+	usd := newTestCurrency("US Dollar", "USD", "$", 2)
+	idr := newTestCurrency("Rupiah", "IDR", "Rp", 0)
+	eur := newTestCurrency("Euro", "EUR", "€", 2)
+	inr := newTestCurrency("Indian Rupee", "INR", "₹", 2)
+	xau := newTestCurrency("Gold", "XAU", "", 0)
+
+	tests := []struct {
+		name     string
+		amount   string
+		currency *entities.Currency
+		locale   string
+		expected string
+	}{
+		{name: "en-US dollars", amount: "1234567.891", currency: usd, locale: "en-US", expected: "$1,234,567.89"},
+		{name: "rupiah without decimals", amount: "1500000.75", currency: idr, locale: "id-ID", expected: "Rp1.500.001"},
+		{name: "euro after the number", amount: "1234.5", currency: eur, locale: "de-DE", expected: "1.234,50\u00a0€"},
+		{name: "euro before the number", amount: "1234.5", currency: eur, locale: "nl-NL", expected: "€\u00a01.234,50"},
+		{name: "french narrow space grouping", amount: "1234567", currency: eur, locale: "fr-FR", expected: "1\u202f234\u202f567,00\u00a0€"},
+		{name: "indian grouping", amount: "12345678.9", currency: inr, locale: "en-IN", expected: "₹1,23,45,678.90"},
+		{name: "locale symbol override", amount: "10", currency: usd, locale: "en-GB", expected: "US$10.00"},
+		{name: "code without symbol", amount: "3", currency: xau, locale: "en-US", expected: "XAU3"},
+		{name: "negative amount", amount: "-1234.5", currency: usd, locale: "en-US", expected: "-$1,234.50"},
+		{name: "negative rounding to zero", amount: "-0.001", currency: usd, locale: "en-US", expected: "$0.00"},
+		{name: "small amount", amount: "0.5", currency: usd, locale: "en-US", expected: "$0.50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, err := valueobjects.NewDecimal(tt.amount)
+			require.NoError(t, err)
+			locale, err := FindMoneyLocale(tt.locale)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, FormatMoney(amount, tt.currency, locale))
+		})
+	}
+}
+
+func TestFindMoneyLocale(t *testing.T) {
+	locale, err := FindMoneyLocale("de_de")
+	require.NoError(t, err)
+	assert.Equal(t, "de-DE", locale.Tag)
+
+	locale, err = FindMoneyLocale("de-AT")
+	require.NoError(t, err)
+	assert.Equal(t, "de-DE", locale.Tag, "falls back to the default locale of the language")
+
+	locale, err = FindMoneyLocale("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMoneyLocale, locale.Tag)
+
+	_, err = FindMoneyLocale("xx-YY")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Contains(t, err.Error(), "locale 'xx-YY' is not supported")
+
+	assert.Contains(t, SupportedMoneyLocales(), "id-ID")
+}
+
+func TestCurrencyService_FormatAmount(t *testing.T) {
+	// Given
+	currencyRepo := new(MockCurrencyLookupRepository)
+	service := NewCurrencyService(currencyRepo)
+	currencyRepo.On("GetByCode", mock.Anything, "IDR").Return(newTestCurrency("Rupiah", "IDR", "Rp", 0), nil)
+	amount, _ := valueobjects.NewDecimal("25000")
+
+	// When
+	result, err := service.FormatAmount(context.Background(), "idr", amount, "id")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "IDR", result.Currency)
+	assert.Equal(t, "id-ID", result.Locale)
+	assert.Equal(t, "Rp25.000", result.Formatted)
+}
+
+func TestCurrencyService_FormatAmounts(t *testing.T) {
+	// Given
+	currencyRepo := new(MockCurrencyLookupRepository)
+	service := NewCurrencyService(currencyRepo)
+	currencyRepo.On("GetByCode", mock.Anything, "USD").Return(newTestCurrency("US Dollar", "USD", "$", 2), nil).Once()
+	currencyRepo.On("GetByCode", mock.Anything, "ABC").Return(nil, fmt.Errorf("currency %w", repositories.ErrNotFound))
+
+	items := []MoneyFormatItem{
+		{Currency: "usd", Amount: "1000"},
+		{Currency: "USD", Amount: "1000", Locale: "de-DE"},
+		{Currency: "USD", Amount: "ten"},
+		{Currency: "ABC", Amount: "1"},
+		{Currency: "USD", Amount: "1", Locale: "xx"},
+	}
+
+	// When
+	results, err := service.FormatAmounts(context.Background(), items, "en-US")
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+	assert.Equal(t, "$1,000.00", results[0].Formatted)
+	assert.Equal(t, "1.000,00\u00a0$", results[1].Formatted)
+	assert.Contains(t, results[2].Error, "invalid amount")
+	assert.Contains(t, results[3].Error, "currency not found")
+	assert.Contains(t, results[4].Error, "locale 'xx' is not supported")
+	currencyRepo.AssertNumberOfCalls(t, "GetByCode", 2)
+}
+
+func TestCurrencyService_FormatAmounts_TooMany(t *testing.T) {
+	service := NewCurrencyService(new(MockCurrencyLookupRepository))
+
+	_, err := service.FormatAmounts(context.Background(), make([]MoneyFormatItem, MaxFormatBatchSize+1), "")
+
+	assert.Error(t, err)
+}
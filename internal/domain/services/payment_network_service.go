@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// PaymentNetworkService implements business logic for payment networks and bank network memberships
+type PaymentNetworkService struct {
+	networkRepo    repositories.PaymentNetworkRepository
+	membershipRepo repositories.BankNetworkMembershipRepository
+	bankRepo       repositories.BankRepository
+}
+
+// NewPaymentNetworkService creates a new PaymentNetworkService instance
+func NewPaymentNetworkService(
+	networkRepo repositories.PaymentNetworkRepository,
+	membershipRepo repositories.BankNetworkMembershipRepository,
+	bankRepo repositories.BankRepository,
+) *PaymentNetworkService {
+	return &PaymentNetworkService{
+		networkRepo:    networkRepo,
+		membershipRepo: membershipRepo,
+		bankRepo:       bankRepo,
+	}
+}
+
+// GetAllNetworks retrieves all payment networks
+func (s *PaymentNetworkService) GetAllNetworks(ctx context.Context) ([]*entities.PaymentNetwork, error) {
+	return s.networkRepo.GetAll(ctx)
+}
+
+// GetNetworkByCode retrieves a payment network by its code
+func (s *PaymentNetworkService) GetNetworkByCode(ctx context.Context, code string) (*entities.PaymentNetwork, error) {
+	return s.networkRepo.GetByCode(ctx, strings.ToUpper(code))
+}
+
+// GetBankMemberships retrieves the network memberships of the bank identified by its code
+func (s *PaymentNetworkService) GetBankMemberships(ctx context.Context, bankCode string) ([]*entities.BankNetworkMembership, error) {
+	bank, err := s.bankRepo.GetByCode(ctx, bankCode)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, fmt.Errorf("bank %w: %s", ErrNotFound, bankCode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bank: %w", err)
+	}
+
+	return s.membershipRepo.GetByBank(ctx, bank.ID)
+}
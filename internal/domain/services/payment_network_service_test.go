@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+)
+
+// MockPaymentNetworkRepository is a mock implementation of PaymentNetworkRepository
+type MockPaymentNetworkRepository struct {
+	mock.Mock
+}
+
+func (m *MockPaymentNetworkRepository) Create(ctx context.Context, network *entities.PaymentNetwork) error {
+	args := m.Called(ctx, network)
+	return args.Error(0)
+}
+
+func (m *MockPaymentNetworkRepository) GetByCode(ctx context.Context, code string) (*entities.PaymentNetwork, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PaymentNetwork), args.Error(1)
+}
+
+func (m *MockPaymentNetworkRepository) GetAll(ctx context.Context) ([]*entities.PaymentNetwork, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PaymentNetwork), args.Error(1)
+}
+
+func (m *MockPaymentNetworkRepository) Update(ctx context.Context, network *entities.PaymentNetwork) error {
+	args := m.Called(ctx, network)
+	return args.Error(0)
+}
+
+func (m *MockPaymentNetworkRepository) Delete(ctx context.Context, code string) error {
+	args := m.Called(ctx, code)
+	return args.Error(0)
+}
+
+// MockBankNetworkMembershipRepository is a mock implementation of BankNetworkMembershipRepository
+type MockBankNetworkMembershipRepository struct {
+	mock.Mock
+}
+
+func (m *MockBankNetworkMembershipRepository) Create(ctx context.Context, membership *entities.BankNetworkMembership) error {
+	args := m.Called(ctx, membership)
+	return args.Error(0)
+}
+
+func (m *MockBankNetworkMembershipRepository) GetByBankAndNetwork(ctx context.Context, bankID uuid.UUID, networkCode string) (*entities.BankNetworkMembership, error) {
+	args := m.Called(ctx, bankID, networkCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.BankNetworkMembership), args.Error(1)
+}
+
+func (m *MockBankNetworkMembershipRepository) Update(ctx context.Context, membership *entities.BankNetworkMembership) error {
+	args := m.Called(ctx, membership)
+	return args.Error(0)
+}
+
+func (m *MockBankNetworkMembershipRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockBankNetworkMembershipRepository) GetByBank(ctx context.Context, bankID uuid.UUID) ([]*entities.BankNetworkMembership, error) {
+	args := m.Called(ctx, bankID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BankNetworkMembership), args.Error(1)
+}
+
+func TestPaymentNetworkService_GetNetworkByCode(t *testing.T) {
+	// Given
+	networkRepo := &MockPaymentNetworkRepository{}
+	service := NewPaymentNetworkService(networkRepo, &MockBankNetworkMembershipRepository{}, &MockBankRepository{})
+	ctx := context.Background()
+	expected := entities.NewPaymentNetwork("BI-FAST", "Bank Indonesia Fast Payment", entities.PaymentNetworkTypeInstant, "ID", "Bank Indonesia")
+
+	networkRepo.On("GetByCode", ctx, "BI-FAST").Return(expected, nil)
+
+	// When
+	network, err := service.GetNetworkByCode(ctx, "bi-fast")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, network)
+	networkRepo.AssertExpectations(t)
+}
+
+func TestPaymentNetworkService_GetBankMemberships(t *testing.T) {
+	t.Run("memberships of a bank", func(t *testing.T) {
+		// Given
+		membershipRepo := &MockBankNetworkMembershipRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewPaymentNetworkService(&MockPaymentNetworkRepository{}, membershipRepo, bankRepo)
+		ctx := context.Background()
+		bank := &entities.Bank{ID: uuid.New(), Name: "Bank Central Asia", Code: "014"}
+		expected := []*entities.BankNetworkMembership{
+			entities.NewBankNetworkMembership(bank.ID, "RTGS", "CENAIDJA"),
+			entities.NewBankNetworkMembership(bank.ID, "SKN", "0140397"),
+		}
+
+		bankRepo.On("GetByCode", ctx, "014").Return(bank, nil)
+		membershipRepo.On("GetByBank", ctx, bank.ID).Return(expected, nil)
+
+		// When
+		memberships, err := service.GetBankMemberships(ctx, "014")
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, expected, memberships)
+		membershipRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown bank", func(t *testing.T) {
+		// Given
+		membershipRepo := &MockBankNetworkMembershipRepository{}
+		bankRepo := &MockBankRepository{}
+		service := NewPaymentNetworkService(&MockPaymentNetworkRepository{}, membershipRepo, bankRepo)
+		ctx := context.Background()
+
+		bankRepo.On("GetByCode", ctx, "999").Return(nil, fmt.Errorf("bank %w", repositories.ErrNotFound))
+
+		// When
+		memberships, err := service.GetBankMemberships(ctx, "999")
+
+		// Then
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Nil(t, memberships)
+		membershipRepo.AssertNotCalled(t, "GetByBank", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// MaxTranslationBatch is the maximum number of translations saved or deleted in one request
+const MaxTranslationBatch = 1000
+
+// TranslationService implements business logic for translations of master data
+type TranslationService struct {
+	translationRepo repositories.TranslationRepository
+	languageRepo    repositories.LanguageRepository
+}
+
+// NewTranslationService creates a new TranslationService instance
+func NewTranslationService(translationRepo repositories.TranslationRepository, languageRepo repositories.LanguageRepository) *TranslationService {
+	return &TranslationService{
+		translationRepo: translationRepo,
+		languageRepo:    languageRepo,
+	}
+}
+
+// ListTranslations retrieves translations matching the filters and sort order of a list query
+func (s *TranslationService) ListTranslations(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Translation, error) {
+	return s.translationRepo.List(ctx, query)
+}
+
+// SaveTranslations creates or updates translations in bulk. Language codes may be any code of a language in
+// tm_languages and are stored as its code, so "deu" and "de" store the same translation. Nothing is saved
+// unless every translation is valid.
+func (s *TranslationService) SaveTranslations(ctx context.Context, translations []*entities.Translation) error {
+	if err := validateTranslationBatch(len(translations)); err != nil {
+		return err
+	}
+
+	languageCodes := make(map[string]string)
+	for i, translation := range translations {
+		code, err := s.languageCode(ctx, languageCodes, translation.LanguageCode)
+		if err != nil {
+			return fmt.Errorf("translation %d: %w", i, err)
+		}
+
+		translation.LanguageCode = code
+		if !translation.IsValid() {
+			return fmt.Errorf("translation %d: %w: %s", i, ErrInvalidInput, describeTranslationKey(translation.Key(), translation.Value == ""))
+		}
+	}
+
+	return s.translationRepo.Upsert(ctx, translations)
+}
+
+// DeleteTranslations deletes translations in bulk and returns the number deleted. Nothing is deleted unless
+// every key is valid; keys without a translation are ignored.
+func (s *TranslationService) DeleteTranslations(ctx context.Context, keys []entities.TranslationKey) (int64, error) {
+	if err := validateTranslationBatch(len(keys)); err != nil {
+		return 0, err
+	}
+
+	languageCodes := make(map[string]string)
+	for i := range keys {
+		code, err := s.languageCode(ctx, languageCodes, keys[i].LanguageCode)
+		if err != nil {
+			return 0, fmt.Errorf("translation key %d: %w", i, err)
+		}
+
+		keys[i].LanguageCode = code
+		if !keys[i].IsValid() {
+			return 0, fmt.Errorf("translation key %d: %w: %s", i, ErrInvalidInput, describeTranslationKey(keys[i], false))
+		}
+	}
+
+	return s.translationRepo.DeleteByKeys(ctx, keys)
+}
+
+// ResolveLanguage finds the active language responses are localized to: the language of lang, which must be
+// an active language of tm_languages, or else the most preferred active language of an Accept-Language
+// header. Language tags match a language by the whole tag or its primary language subtag, so "pt-BR" matches
+// pt. Only the first MaxAcceptLanguageRanges ranges are tried and each code is looked up once. It returns nil
+// when no language was requested or none of the requested languages is known.
+func (s *TranslationService) ResolveLanguage(ctx context.Context, lang, acceptLanguage string) (*entities.Language, error) {
+	if lang = strings.TrimSpace(lang); lang != "" {
+		language, err := s.findActiveLanguage(ctx, lang, make(map[string]bool))
+		if err != nil {
+			return nil, err
+		}
+		if language == nil {
+			return nil, fmt.Errorf("%w: language '%s' is not an active language", ErrInvalidInput, lang)
+		}
+		return language, nil
+	}
+
+	tried := make(map[string]bool)
+	for _, languageRange := range valueobjects.ParseAcceptLanguage(acceptLanguage) {
+		if languageRange.Tag == "*" {
+			continue
+		}
+
+		language, err := s.findActiveLanguage(ctx, languageRange.Tag, tried)
+		if err != nil {
+			return nil, err
+		}
+		if language != nil {
+			return language, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Localize replaces the translatable fields of the items with their translations in the language; fields
+// without a translation keep their value
+func (s *TranslationService) Localize(ctx context.Context, languageCode string, items ...entities.Translatable) error {
+	// Group the items by entity type so that each type needs one query
+	entityIDs := make(map[string][]uuid.UUID)
+	itemsByKey := make(map[string]map[uuid.UUID][]entities.Translatable)
+	for _, item := range items {
+		entityType, entityID := item.TranslationKey()
+		if itemsByKey[entityType] == nil {
+			itemsByKey[entityType] = make(map[uuid.UUID][]entities.Translatable)
+		}
+		if _, ok := itemsByKey[entityType][entityID]; !ok {
+			entityIDs[entityType] = append(entityIDs[entityType], entityID)
+		}
+		itemsByKey[entityType][entityID] = append(itemsByKey[entityType][entityID], item)
+	}
+
+	for entityType, ids := range entityIDs {
+		translations, err := s.translationRepo.GetByEntities(ctx, entityType, ids, languageCode)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve translations: %w", err)
+		}
+
+		for _, translation := range translations {
+			for _, item := range itemsByKey[entityType][translation.EntityID] {
+				item.ApplyTranslation(translation.Field, translation.Value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// findActiveLanguage finds the active language of a language tag by the whole tag, then by its primary
+// language subtag; it returns nil when there is none. Codes in tried are not looked up again.
+func (s *TranslationService) findActiveLanguage(ctx context.Context, tag string, tried map[string]bool) (*entities.Language, error) {
+	candidates := []string{tag}
+	if language := valueobjects.LanguageTagLanguage(tag); !strings.EqualFold(language, tag) {
+		candidates = append(candidates, language)
+	}
+
+	for _, code := range candidates {
+		code = strings.ToLower(code)
+		if code == "" || len(code) > 10 || tried[code] {
+			continue
+		}
+		tried[code] = true
+
+		language, err := s.languageRepo.GetByAnyCode(ctx, code)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to retrieve language: %w", err)
+		}
+		if language.IsActive {
+			return language, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// languageCode resolves any code of a language in tm_languages to its code, caching the codes of a batch
+func (s *TranslationService) languageCode(ctx context.Context, codes map[string]string, code string) (string, error) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if resolved, ok := codes[code]; ok {
+		return resolved, nil
+	}
+	if code == "" || len(code) > 10 {
+		return "", fmt.Errorf("%w: language code '%s' must be 1 to 10 characters", ErrInvalidInput, code)
+	}
+
+	language, err := s.languageRepo.GetByAnyCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return "", fmt.Errorf("%w: language code '%s' is not a language in tm_languages", ErrInvalidInput, code)
+		}
+		return "", fmt.Errorf("failed to retrieve language: %w", err)
+	}
+
+	codes[code] = language.Code
+	return language.Code, nil
+}
+
+// validateTranslationBatch checks the number of translations of a bulk request
+func validateTranslationBatch(size int) error {
+	if size == 0 {
+		return fmt.Errorf("%w: at least one translation is required", ErrInvalidInput)
+	}
+	if size > MaxTranslationBatch {
+		return fmt.Errorf("%w: at most %d translations per request", ErrInvalidInput, MaxTranslationBatch)
+	}
+	return nil
+}
+
+// describeTranslationKey explains why a translation key, or its value, is invalid
+func describeTranslationKey(key entities.TranslationKey, emptyValue bool) string {
+	fields, ok := entities.TranslatableFields[key.EntityType]
+	switch {
+	case !ok:
+		return fmt.Sprintf("unknown entity type '%s'", key.EntityType)
+	case !entities.IsTranslatableField(key.EntityType, key.Field):
+		return fmt.Sprintf("field '%s' of %s cannot be translated, translatable fields are %s", key.Field, key.EntityType, strings.Join(fields, ", "))
+	case key.EntityID == uuid.Nil:
+		return "entity_id is required"
+	case emptyValue:
+		return "value is required"
+	default:
+		return fmt.Sprintf("language code '%s' is invalid", key.LanguageCode)
+	}
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/repositories"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+)
+
+// MockTranslationRepository is a mock implementation of TranslationRepository
+type MockTranslationRepository struct {
+	mock.Mock
+}
+
+func (m *MockTranslationRepository) Upsert(ctx context.Context, translations []*entities.Translation) error {
+	args := m.Called(ctx, translations)
+	return args.Error(0)
+}
+
+func (m *MockTranslationRepository) DeleteByKeys(ctx context.Context, keys []entities.TranslationKey) (int64, error) {
+	args := m.Called(ctx, keys)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTranslationRepository) List(ctx context.Context, query valueobjects.ListQuery) ([]*entities.Translation, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Translation), args.Error(1)
+}
+
+func (m *MockTranslationRepository) GetByEntities(ctx context.Context, entityType string, entityIDs []uuid.UUID, languageCode string) ([]*entities.Translation, error) {
+	args := m.Called(ctx, entityType, entityIDs, languageCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Translation), args.Error(1)
+}
+
+// MockLanguageLookupRepository mocks the language lookups used by services that only read languages
+type MockLanguageLookupRepository struct {
+	repositories.LanguageRepository
+	mock.Mock
+}
+
+func (m *MockLanguageLookupRepository) GetByAnyCode(ctx context.Context, code string) (*entities.Language, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Language), args.Error(1)
+}
+
+func setupTranslationService() (*TranslationService, *MockTranslationRepository, *MockLanguageLookupRepository) {
+	translationRepo := new(MockTranslationRepository)
+	languageRepo := new(MockLanguageLookupRepository)
+
+	german := entities.NewLanguage("German", "de")
+	indonesian := entities.NewLanguage("Indonesian", "id")
+	malay := entities.NewLanguage("Malay", "ms")
+	malay.Deactivate()
+
+	languageRepo.On("GetByAnyCode", mock.Anything, "de").Return(german, nil).Maybe()
+	languageRepo.On("GetByAnyCode", mock.Anything, "deu").Return(german, nil).Maybe()
+	languageRepo.On("GetByAnyCode", mock.Anything, "id").Return(indonesian, nil).Maybe()
+	languageRepo.On("GetByAnyCode", mock.Anything, "ms").Return(malay, nil).Maybe()
+	languageRepo.On("GetByAnyCode", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("language %w", repositories.ErrNotFound)).Maybe()
+
+	return NewTranslationService(translationRepo, languageRepo), translationRepo, languageRepo
+}
+
+func TestTranslationService_SaveTranslations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores language codes as the code of the language", func(t *testing.T) {
+		// Given
+		service, translationRepo, _ := setupTranslationService()
+		translations := []*entities.Translation{
+			entities.NewTranslation(entities.TranslationEntityCurrency, uuid.New(), "name", "deu", "US-Dollar"),
+			entities.NewTranslation(entities.TranslationEntityBank, uuid.New(), "alias", "ID", "BCA"),
+		}
+		translationRepo.On("Upsert", ctx, translations).Return(nil)
+
+		// When
+		err := service.SaveTranslations(ctx, translations)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "de", translations[0].LanguageCode)
+		assert.Equal(t, "id", translations[1].LanguageCode)
+		translationRepo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name          string
+		translations  []*entities.Translation
+		expectedError string
+	}{
+		{
+			name:          "empty batch",
+			expectedError: "at least one translation",
+		},
+		{
+			name:          "unknown language",
+			translations:  []*entities.Translation{entities.NewTranslation(entities.TranslationEntityBank, uuid.New(), "name", "xx", "Bank")},
+			expectedError: "translation 0: invalid input: language code 'xx' is not a language in tm_languages",
+		},
+		{
+			name: "field not translatable",
+			translations: []*entities.Translation{
+				entities.NewTranslation(entities.TranslationEntityBank, uuid.New(), "name", "de", "Bank"),
+				entities.NewTranslation(entities.TranslationEntityBank, uuid.New(), "code", "de", "014"),
+			},
+			expectedError: "translation 1: invalid input: field 'code' of bank cannot be translated",
+		},
+		{
+			name:          "unknown entity type",
+			translations:  []*entities.Translation{entities.NewTranslation("payment_network", uuid.New(), "name", "de", "Netz")},
+			expectedError: "unknown entity type 'payment_network'",
+		},
+		{
+			name:          "empty value",
+			translations:  []*entities.Translation{entities.NewTranslation(entities.TranslationEntityLanguage, uuid.New(), "name", "de", "")},
+			expectedError: "value is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			service, translationRepo, _ := setupTranslationService()
+
+			// When
+			err := service.SaveTranslations(ctx, tt.translations)
+
+			// Then
+			assert.ErrorIs(t, err, ErrInvalidInput)
+			assert.Contains(t, err.Error(), tt.expectedError)
+			translationRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestTranslationService_DeleteTranslations(t *testing.T) {
+	// Given
+	service, translationRepo, _ := setupTranslationService()
+	ctx := context.Background()
+	bankID := uuid.New()
+	keys := []entities.TranslationKey{{EntityType: entities.TranslationEntityBank, EntityID: bankID, Field: "name", LanguageCode: "deu"}}
+	translationRepo.On("DeleteByKeys", ctx, []entities.TranslationKey{
+		{EntityType: entities.TranslationEntityBank, EntityID: bankID, Field: "name", LanguageCode: "de"},
+	}).Return(int64(1), nil)
+
+	// When
+	deleted, err := service.DeleteTranslations(ctx, keys)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	translationRepo.AssertExpectations(t)
+}
+
+func TestTranslationService_ResolveLanguage(t *testing.T) {
+	tests := []struct {
+		name           string
+		lang           string
+		acceptLanguage string
+		expectedCode   string
+		expectError    bool
+	}{
+		{name: "lang parameter", lang: "deu", acceptLanguage: "id", expectedCode: "de"},
+		{name: "lang parameter with region", lang: "de-AT", expectedCode: "de"},
+		{name: "unknown lang parameter", lang: "xx", expectError: true},
+		{name: "inactive lang parameter", lang: "ms", expectError: true},
+		{name: "accept language by quality", acceptLanguage: "de;q=0.5, id-ID", expectedCode: "id"},
+		{name: "accept language skips unknown and inactive languages", acceptLanguage: "fr-FR, ms, *;q=0.8, de;q=0.1", expectedCode: "de"},
+		{name: "no known language", acceptLanguage: "fr, ja"},
+		{name: "nothing requested"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			service, _, _ := setupTranslationService()
+
+			// When
+			language, err := service.ResolveLanguage(context.Background(), tt.lang, tt.acceptLanguage)
+
+			// Then
+			if tt.expectError {
+				assert.Nil(t, language)
+				assert.ErrorIs(t, err, ErrInvalidInput)
+				assert.Contains(t, err.Error(), "is not an active language")
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.expectedCode == "" {
+				assert.Nil(t, language)
+			} else {
+				require.NotNil(t, language)
+				assert.Equal(t, tt.expectedCode, language.Code)
+			}
+		})
+	}
+}
+
+func TestTranslationService_ResolveLanguage_BoundsLookups(t *testing.T) {
+	// Given
+	service, _, languageRepo := setupTranslationService()
+	header := "fr-FR, fr-CA, fr-BE, fr-CH, fr-LU, fr-MC, fr-CM, fr-SN, fr-CI, fr-ML, fr-NE, fr-TG, de;q=0.1"
+
+	// When
+	language, err := service.ResolveLanguage(context.Background(), "", header)
+
+	// Then
+	require.NoError(t, err)
+	assert.Nil(t, language, "de is beyond the ranges that are considered")
+	// Ten ranges each look up their tag, and their shared language subtag is looked up once
+	languageRepo.AssertNumberOfCalls(t, "GetByAnyCode", valueobjects.MaxAcceptLanguageRanges+1)
+}
+
+func TestTranslationService_Localize(t *testing.T) {
+	// Given
+	service, translationRepo, _ := setupTranslationService()
+	ctx := context.Background()
+
+	bank := entities.NewBank("Bank Negara Indonesia", "BNI", "PT Bank Negara Indonesia (Persero) Tbk", "009")
+	dollar := entities.NewCurrency("US Dollar", "USD", 2)
+	euro := entities.NewCurrency("Euro", "EUR", 2)
+
+	translationRepo.On("GetByEntities", ctx, entities.TranslationEntityBank, []uuid.UUID{bank.ID}, "de").Return([]*entities.Translation{
+		entities.NewTranslation(entities.TranslationEntityBank, bank.ID, "company", "de", "Bank Negara Indonesia AG"),
+	}, nil)
+	translationRepo.On("GetByEntities", ctx, entities.TranslationEntityCurrency, []uuid.UUID{dollar.ID, euro.ID}, "de").Return([]*entities.Translation{
+		entities.NewTranslation(entities.TranslationEntityCurrency, dollar.ID, "name", "de", "US-Dollar"),
+	}, nil)
+
+	// When
+	err := service.Localize(ctx, "de", bank, dollar, euro)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "Bank Negara Indonesia", bank.Name)
+	assert.Equal(t, "Bank Negara Indonesia AG", bank.Company)
+	assert.Equal(t, "US-Dollar", dollar.Name)
+	assert.Equal(t, "Euro", euro.Name)
+	translationRepo.AssertExpectations(t)
+}
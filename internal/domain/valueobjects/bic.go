@@ -0,0 +1,98 @@
+package valueobjects
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// primaryOfficeBranchCode is the branch code implied by 8-character BICs
+const primaryOfficeBranchCode = "XXX"
+
+// bicPattern matches the ISO 9362 structure: institution (4 letters), country (2 letters),
+// location (2 alphanumerics) and an optional branch (3 alphanumerics)
+var bicPattern = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// BIC represents an ISO 9362 Business Identifier Code (SWIFT code) value object
+type BIC struct {
+	value string
+}
+
+// NewBIC creates a new BIC value object, normalizing case and surrounding whitespace
+func NewBIC(bic string) (*BIC, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(bic), " ", ""))
+	if err := validateBIC(normalized); err != nil {
+		return nil, err
+	}
+	return &BIC{value: normalized}, nil
+}
+
+// Value returns the BIC string value
+func (b *BIC) Value() string {
+	return b.value
+}
+
+// String implements the Stringer interface
+func (b *BIC) String() string {
+	return b.value
+}
+
+// InstitutionCode returns the 4-letter institution (bank) code
+func (b *BIC) InstitutionCode() string {
+	return b.value[0:4]
+}
+
+// CountryCode returns the ISO 3166-1 alpha-2 country code
+func (b *BIC) CountryCode() string {
+	return b.value[4:6]
+}
+
+// LocationCode returns the 2-character location code
+func (b *BIC) LocationCode() string {
+	return b.value[6:8]
+}
+
+// BranchCode returns the 3-character branch code, "XXX" for the primary office
+func (b *BIC) BranchCode() string {
+	if len(b.value) == 8 {
+		return primaryOfficeBranchCode
+	}
+	return b.value[8:11]
+}
+
+// BIC8 returns the 8-character form identifying the institution at its location
+func (b *BIC) BIC8() string {
+	return b.value[0:8]
+}
+
+// BIC11 returns the 11-character form, using "XXX" as branch for the primary office
+func (b *BIC) BIC11() string {
+	return b.BIC8() + b.BranchCode()
+}
+
+// IsPrimaryOffice checks if the BIC identifies the primary office of the institution
+func (b *BIC) IsPrimaryOffice() bool {
+	return b.BranchCode() == primaryOfficeBranchCode
+}
+
+// Matches checks if two BICs identify the same office, treating the 8-character form as equal to "XXX"
+func (b *BIC) Matches(other *BIC) bool {
+	return other != nil && b.BIC11() == other.BIC11()
+}
+
+// validateBIC validates the BIC structure
+func validateBIC(bic string) error {
+	if bic == "" {
+		return fmt.Errorf("BIC cannot be empty")
+	}
+
+	if len(bic) != 8 && len(bic) != 11 {
+		return fmt.Errorf("BIC must be 8 or 11 characters: %s", bic)
+	}
+
+	if !bicPattern.MatchString(bic) {
+		return fmt.Errorf("invalid BIC format: %s", bic)
+	}
+
+	return nil
+}
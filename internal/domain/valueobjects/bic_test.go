@@ -0,0 +1,73 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBIC(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		expectError bool
+		errorMsg    string
+	}{
+		{name: "valid 8-character BIC", input: "CENAIDJA", expected: "CENAIDJA"},
+		{name: "valid 11-character BIC", input: "DEUTDEFF500", expected: "DEUTDEFF500"},
+		{name: "normalizes case and spaces", input: " deut de ff 500 ", expected: "DEUTDEFF500"},
+		{name: "numeric location code", input: "BNINIDJ1", expected: "BNINIDJ1"},
+		{name: "empty", input: "", expectError: true, errorMsg: "cannot be empty"},
+		{name: "wrong length", input: "DEUTDEFF5", expectError: true, errorMsg: "must be 8 or 11 characters"},
+		{name: "digit in institution code", input: "DEU1DEFF", expectError: true, errorMsg: "invalid BIC format"},
+		{name: "digit in country code", input: "DEUTD3FF", expectError: true, errorMsg: "invalid BIC format"},
+		{name: "invalid character in branch", input: "DEUTDEFF5-0", expectError: true, errorMsg: "invalid BIC format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bic, err := NewBIC(tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, bic)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, bic.Value())
+				assert.Equal(t, tt.expected, bic.String())
+			}
+		})
+	}
+}
+
+func TestBIC_Parts(t *testing.T) {
+	bic, err := NewBIC("DEUTDEFF500")
+	require.NoError(t, err)
+
+	assert.Equal(t, "DEUT", bic.InstitutionCode())
+	assert.Equal(t, "DE", bic.CountryCode())
+	assert.Equal(t, "FF", bic.LocationCode())
+	assert.Equal(t, "500", bic.BranchCode())
+	assert.Equal(t, "DEUTDEFF", bic.BIC8())
+	assert.Equal(t, "DEUTDEFF500", bic.BIC11())
+	assert.False(t, bic.IsPrimaryOffice())
+}
+
+func TestBIC_PrimaryOffice(t *testing.T) {
+	bic8, err := NewBIC("CENAIDJA")
+	require.NoError(t, err)
+	bic11, err := NewBIC("CENAIDJAXXX")
+	require.NoError(t, err)
+	branch, err := NewBIC("CENAIDJA123")
+	require.NoError(t, err)
+
+	assert.Equal(t, "XXX", bic8.BranchCode())
+	assert.Equal(t, "CENAIDJAXXX", bic8.BIC11())
+	assert.True(t, bic8.IsPrimaryOffice())
+	assert.True(t, bic8.Matches(bic11))
+	assert.False(t, bic8.Matches(branch))
+	assert.False(t, bic8.Matches(nil))
+}
@@ -0,0 +1,117 @@
+package valueobjects
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// CoordinatePrecision is the number of decimal places kept for coordinates (about 1 cm at the equator)
+const CoordinatePrecision = 7
+
+// Coordinates represents a validated geographic coordinate pair in decimal degrees
+type Coordinates struct {
+	latitude  float64
+	longitude float64
+}
+
+// NewCoordinates creates a new coordinates value object
+func NewCoordinates(latitude, longitude float64) (*Coordinates, error) {
+	if err := validateCoordinates(latitude, longitude); err != nil {
+		return nil, err
+	}
+	return &Coordinates{
+		latitude:  roundCoordinate(latitude),
+		longitude: roundCoordinate(longitude),
+	}, nil
+}
+
+// ParseCoordinates creates a coordinates value object from decimal degree strings.
+// A comma is accepted as decimal separator when the value contains no dot.
+func ParseCoordinates(latitude, longitude string) (*Coordinates, error) {
+	lat, err := parseCoordinate("latitude", latitude)
+	if err != nil {
+		return nil, err
+	}
+
+	lon, err := parseCoordinate("longitude", longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCoordinates(lat, lon)
+}
+
+// Latitude returns the latitude in decimal degrees
+func (c *Coordinates) Latitude() float64 {
+	return c.latitude
+}
+
+// Longitude returns the longitude in decimal degrees
+func (c *Coordinates) Longitude() float64 {
+	return c.longitude
+}
+
+// Equals checks if two coordinates are the same
+func (c *Coordinates) Equals(other *Coordinates) bool {
+	return other != nil && c.latitude == other.latitude && c.longitude == other.longitude
+}
+
+// String implements the Stringer interface
+func (c *Coordinates) String() string {
+	return FormatCoordinate(c.latitude) + "," + FormatCoordinate(c.longitude)
+}
+
+// FormatCoordinate formats a coordinate in plain decimal notation without trailing zeros
+func FormatCoordinate(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// parseCoordinate parses a single coordinate string
+func parseCoordinate(field, value string) (float64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("%s cannot be empty", field)
+	}
+
+	if !strings.Contains(value, ".") && strings.Count(value, ",") == 1 {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %s", field, value)
+	}
+
+	return parsed, nil
+}
+
+// validateCoordinates validates the coordinate ranges
+func validateCoordinates(latitude, longitude float64) error {
+	if math.IsNaN(latitude) || math.IsInf(latitude, 0) {
+		return fmt.Errorf("invalid latitude: %v", latitude)
+	}
+	if math.IsNaN(longitude) || math.IsInf(longitude, 0) {
+		return fmt.Errorf("invalid longitude: %v", longitude)
+	}
+
+	if latitude < -90 || latitude > 90 {
+		if longitude >= -90 && longitude <= 90 && latitude >= -180 && latitude <= 180 {
+			return fmt.Errorf("latitude %v is out of range, latitude and longitude appear to be swapped", latitude)
+		}
+		return fmt.Errorf("latitude must be between -90 and 90: %v", latitude)
+	}
+
+	if longitude < -180 || longitude > 180 {
+		return fmt.Errorf("longitude must be between -180 and 180: %v", longitude)
+	}
+
+	return nil
+}
+
+// roundCoordinate rounds a coordinate to CoordinatePrecision decimal places
+func roundCoordinate(value float64) float64 {
+	factor := math.Pow(10, CoordinatePrecision)
+	return math.Round(value*factor) / factor
+}
@@ -0,0 +1,126 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCoordinates(t *testing.T) {
+	tests := []struct {
+		name        string
+		latitude    float64
+		longitude   float64
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:      "valid coordinates",
+			latitude:  -6.2088,
+			longitude: 106.8456,
+		},
+		{
+			name:      "boundary values",
+			latitude:  90,
+			longitude: -180,
+		},
+		{
+			name:        "latitude out of range",
+			latitude:    95,
+			longitude:   200,
+			expectError: true,
+			errorMsg:    "latitude must be between -90 and 90",
+		},
+		{
+			name:        "swapped axes",
+			latitude:    106.8456,
+			longitude:   -6.2088,
+			expectError: true,
+			errorMsg:    "appear to be swapped",
+		},
+		{
+			name:        "longitude out of range",
+			latitude:    10,
+			longitude:   181,
+			expectError: true,
+			errorMsg:    "longitude must be between -180 and 180",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coordinates, err := NewCoordinates(tt.latitude, tt.longitude)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, coordinates)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.latitude, coordinates.Latitude())
+				assert.Equal(t, tt.longitude, coordinates.Longitude())
+			}
+		})
+	}
+}
+
+func TestNewCoordinates_RoundsToPrecision(t *testing.T) {
+	coordinates, err := NewCoordinates(-6.208812345678, 106.845612345678)
+
+	require.NoError(t, err)
+	assert.Equal(t, -6.2088123, coordinates.Latitude())
+	assert.Equal(t, 106.8456123, coordinates.Longitude())
+}
+
+func TestParseCoordinates(t *testing.T) {
+	tests := []struct {
+		name              string
+		latitude          string
+		longitude         string
+		expectedLatitude  float64
+		expectedLongitude float64
+		expectError       bool
+	}{
+		{"decimal point", "-6.2088", "106.8456", -6.2088, 106.8456, false},
+		{"surrounding whitespace", " -6.2088 ", " 106.8456 ", -6.2088, 106.8456, false},
+		{"comma decimal separator", "-6,2088", "106,8456", -6.2088, 106.8456, false},
+		{"integer degrees", "10", "20", 10, 20, false},
+		{"empty latitude", "", "106.8456", 0, 0, true},
+		{"not a number", "abc", "106.8456", 0, 0, true},
+		{"thousands separators", "1,234.5", "106.8456", 0, 0, true},
+		{"swapped axes", "106.8456", "-6.2088", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coordinates, err := ParseCoordinates(tt.latitude, tt.longitude)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, coordinates)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedLatitude, coordinates.Latitude())
+				assert.Equal(t, tt.expectedLongitude, coordinates.Longitude())
+			}
+		})
+	}
+}
+
+func TestCoordinates_String(t *testing.T) {
+	coordinates, err := NewCoordinates(-6.2, 106.8456)
+	require.NoError(t, err)
+
+	assert.Equal(t, "-6.2,106.8456", coordinates.String())
+}
+
+func TestCoordinates_Equals(t *testing.T) {
+	a, _ := NewCoordinates(1.5, 2.5)
+	b, _ := NewCoordinates(1.5, 2.5)
+	c, _ := NewCoordinates(1.5, 2.6)
+
+	assert.True(t, a.Equals(b))
+	assert.False(t, a.Equals(c))
+	assert.False(t, a.Equals(nil))
+}
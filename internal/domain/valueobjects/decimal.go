@@ -0,0 +1,303 @@
+package valueobjects
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// DecimalMaxScale is the number of decimal places kept when a result has no finite decimal representation
+const DecimalMaxScale = 18
+
+// RoundingMode selects how a decimal is rounded when it lies between two representable values
+type RoundingMode string
+
+// Rounding modes
+const (
+	RoundHalfUp   RoundingMode = "half_up"   // Nearest value, halves away from zero
+	RoundHalfEven RoundingMode = "half_even" // Nearest value, halves to the even neighbour (banker's rounding)
+	RoundHalfDown RoundingMode = "half_down" // Nearest value, halves towards zero
+	RoundUp       RoundingMode = "up"        // Away from zero
+	RoundDown     RoundingMode = "down"      // Towards zero (truncation)
+	RoundCeiling  RoundingMode = "ceiling"   // Towards positive infinity
+	RoundFloor    RoundingMode = "floor"     // Towards negative infinity
+)
+
+// roundingModes lists the supported rounding modes
+var roundingModes = []RoundingMode{RoundHalfUp, RoundHalfEven, RoundHalfDown, RoundUp, RoundDown, RoundCeiling, RoundFloor}
+
+// ParseRoundingMode parses a rounding mode name such as "half_even"; an empty name selects RoundHalfUp
+func ParseRoundingMode(value string) (RoundingMode, error) {
+	normalized := RoundingMode(strings.ToLower(strings.TrimSpace(value)))
+	if normalized == "" {
+		return RoundHalfUp, nil
+	}
+	if normalized.IsValid() {
+		return normalized, nil
+	}
+
+	names := make([]string, len(roundingModes))
+	for i, mode := range roundingModes {
+		names[i] = string(mode)
+	}
+	return "", fmt.Errorf("invalid rounding mode '%s': supported modes are %s", value, strings.Join(names, ", "))
+}
+
+// IsValid checks if the rounding mode is supported
+func (m RoundingMode) IsValid() bool {
+	for _, mode := range roundingModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// decimalPattern matches plain decimal notation: an optional sign, digits and an optional fraction
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`)
+
+// Decimal represents an exact decimal number such as a money amount or an exchange rate.
+// It is backed by a rational number so that arithmetic never loses precision; rounding only
+// happens when explicitly requested.
+type Decimal struct {
+	value *big.Rat
+}
+
+// NewDecimal parses a decimal in plain notation, e.g. "1234.5678" or "-0.5"
+func NewDecimal(value string) (Decimal, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return Decimal{}, fmt.Errorf("decimal cannot be empty")
+	}
+	if !decimalPattern.MatchString(trimmed) {
+		return Decimal{}, fmt.Errorf("invalid decimal '%s'", value)
+	}
+
+	r, ok := new(big.Rat).SetString(trimmed)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal '%s'", value)
+	}
+	return Decimal{value: r}, nil
+}
+
+// NewDecimalFromInt creates a decimal from an integer
+func NewDecimalFromInt(value int64) Decimal {
+	return Decimal{value: new(big.Rat).SetInt64(value)}
+}
+
+// rat returns the underlying rational, treating the zero Decimal as 0
+func (d Decimal) rat() *big.Rat {
+	if d.value == nil {
+		return new(big.Rat)
+	}
+	return d.value
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the decimal
+func (d Decimal) Sign() int {
+	return d.rat().Sign()
+}
+
+// Cmp compares two decimals and returns -1, 0 or +1
+func (d Decimal) Cmp(other Decimal) int {
+	return d.rat().Cmp(other.rat())
+}
+
+// Abs returns the absolute value of the decimal
+func (d Decimal) Abs() Decimal {
+	return Decimal{value: new(big.Rat).Abs(d.rat())}
+}
+
+// Add returns d + other
+func (d Decimal) Add(other Decimal) Decimal {
+	return Decimal{value: new(big.Rat).Add(d.rat(), other.rat())}
+}
+
+// Sub returns d - other
+func (d Decimal) Sub(other Decimal) Decimal {
+	return Decimal{value: new(big.Rat).Sub(d.rat(), other.rat())}
+}
+
+// Mul returns d * other
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{value: new(big.Rat).Mul(d.rat(), other.rat())}
+}
+
+// Div returns d / other
+func (d Decimal) Div(other Decimal) (Decimal, error) {
+	if other.Sign() == 0 {
+		return Decimal{}, fmt.Errorf("division by zero")
+	}
+	return Decimal{value: new(big.Rat).Quo(d.rat(), other.rat())}, nil
+}
+
+// Round rounds the decimal to the given number of decimal places, halves away from zero
+func (d Decimal) Round(places int) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	rounded, _ := new(big.Rat).SetString(d.rat().FloatString(places))
+	return Decimal{value: rounded}
+}
+
+// RoundWithMode rounds the decimal to the given number of decimal places with the given rounding mode
+func (d Decimal) RoundWithMode(places int, mode RoundingMode) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	increment := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil))
+	return Decimal{value: roundToIncrement(d.rat(), increment, mode)}
+}
+
+// RoundToIncrement rounds the decimal to a multiple of the increment with the given rounding mode,
+// e.g. to 0.05 for Swiss franc cash payments. The increment must be positive.
+func (d Decimal) RoundToIncrement(increment Decimal, mode RoundingMode) (Decimal, error) {
+	if increment.Sign() <= 0 {
+		return Decimal{}, fmt.Errorf("rounding increment must be positive")
+	}
+	if !mode.IsValid() {
+		return Decimal{}, fmt.Errorf("invalid rounding mode '%s'", mode)
+	}
+	return Decimal{value: roundToIncrement(d.rat(), increment.rat(), mode)}, nil
+}
+
+// roundToIncrement rounds x to a multiple of a positive increment. The quotient x / increment is split into
+// its integer part, truncated towards zero, and a remainder that decides whether to move one step away from zero.
+func roundToIncrement(x, increment *big.Rat, mode RoundingMode) *big.Rat {
+	quotient := new(big.Rat).Quo(x, increment)
+	numerator, denominator := quotient.Num(), quotient.Denom()
+
+	steps, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() != 0 {
+		sign := numerator.Sign()
+
+		// Compare the remainder with half a step: -1 below, 0 exactly half, +1 above
+		half := new(big.Int).Lsh(new(big.Int).Abs(remainder), 1).Cmp(denominator)
+
+		var awayFromZero bool
+		switch mode {
+		case RoundUp:
+			awayFromZero = true
+		case RoundDown:
+			awayFromZero = false
+		case RoundCeiling:
+			awayFromZero = sign > 0
+		case RoundFloor:
+			awayFromZero = sign < 0
+		case RoundHalfDown:
+			awayFromZero = half > 0
+		case RoundHalfEven:
+			awayFromZero = half > 0 || (half == 0 && steps.Bit(0) == 1)
+		default:
+			awayFromZero = half >= 0
+		}
+
+		if awayFromZero {
+			steps.Add(steps, big.NewInt(int64(sign)))
+		}
+	}
+
+	return new(big.Rat).Mul(new(big.Rat).SetInt(steps), increment)
+}
+
+// StringFixed formats the decimal with exactly the given number of decimal places, halves away from zero
+func (d Decimal) StringFixed(places int) string {
+	if places < 0 {
+		places = 0
+	}
+	return d.rat().FloatString(places)
+}
+
+// String formats the decimal without trailing zeros. Results without a finite decimal
+// representation (e.g. 1/3) are rounded to DecimalMaxScale places.
+func (d Decimal) String() string {
+	formatted := d.rat().FloatString(d.scale())
+	if strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+	}
+	if formatted == "-0" {
+		return "0"
+	}
+	return formatted
+}
+
+// scale returns the number of decimal places needed to represent the decimal exactly,
+// capped at DecimalMaxScale
+func (d Decimal) scale() int {
+	denominator := new(big.Int).Set(d.rat().Denom())
+	two, five := big.NewInt(2), big.NewInt(5)
+	remainder := new(big.Int)
+
+	twos, fives := 0, 0
+	for {
+		quotient, mod := new(big.Int).QuoRem(denominator, two, remainder)
+		if mod.Sign() != 0 {
+			break
+		}
+		denominator = quotient
+		twos++
+	}
+	for {
+		quotient, mod := new(big.Int).QuoRem(denominator, five, remainder)
+		if mod.Sign() != 0 {
+			break
+		}
+		denominator = quotient
+		fives++
+	}
+
+	if denominator.Cmp(big.NewInt(1)) != 0 {
+		return DecimalMaxScale
+	}
+
+	scale := twos
+	if fives > scale {
+		scale = fives
+	}
+	if scale > DecimalMaxScale {
+		return DecimalMaxScale
+	}
+	return scale
+}
+
+// MarshalJSON encodes the decimal as a JSON string so that no precision is lost in clients
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON decodes a decimal from a JSON string or number
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	parsed, err := NewDecimal(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Scan implements sql.Scanner so that decimals can be read from NUMERIC columns selected as text
+func (d *Decimal) Scan(src interface{}) error {
+	var value string
+	switch v := src.(type) {
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into a decimal", src)
+	}
+
+	parsed, err := NewDecimal(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, encoding the decimal as text without loss of precision
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
@@ -0,0 +1,175 @@
+package valueobjects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecimal(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		expectError bool
+		errorMsg    string
+	}{
+		{name: "integer", input: "100", expected: "100"},
+		{name: "fraction", input: "1.0856", expected: "1.0856"},
+		{name: "trailing zeros are dropped", input: "1.500000", expected: "1.5"},
+		{name: "negative", input: "-0.25", expected: "-0.25"},
+		{name: "leading dot", input: ".5", expected: "0.5"},
+		{name: "whitespace", input: " 42 ", expected: "42"},
+		{name: "empty", input: "", expectError: true, errorMsg: "cannot be empty"},
+		{name: "exponent", input: "1e3", expectError: true, errorMsg: "invalid decimal"},
+		{name: "fraction notation", input: "1/3", expectError: true, errorMsg: "invalid decimal"},
+		{name: "thousands separator", input: "1,000", expectError: true, errorMsg: "invalid decimal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDecimal(tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, d.String())
+			}
+		})
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a, _ := NewDecimal("0.1")
+	b, _ := NewDecimal("0.2")
+
+	assert.Equal(t, "0.3", a.Add(b).String())
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	assert.Equal(t, "0.02", a.Mul(b).String())
+
+	quotient, err := a.Div(b)
+	require.NoError(t, err)
+	assert.Equal(t, "0.5", quotient.String())
+
+	_, err = a.Div(NewDecimalFromInt(0))
+	assert.Error(t, err)
+
+	third, err := NewDecimalFromInt(1).Div(NewDecimalFromInt(3))
+	require.NoError(t, err)
+	assert.Equal(t, "0.333333333333333333", third.String())
+}
+
+func TestDecimal_Round(t *testing.T) {
+	tests := []struct {
+		input    string
+		places   int
+		expected string
+	}{
+		{"1.005", 2, "1.01"},
+		{"1.004", 2, "1.00"},
+		{"-1.005", 2, "-1.01"},
+		{"15234.5", 0, "15235"},
+		{"0.125", 1, "0.1"},
+	}
+
+	for _, tt := range tests {
+		d, err := NewDecimal(tt.input)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, d.Round(tt.places).StringFixed(tt.places), tt.input)
+	}
+}
+
+func TestDecimal_RoundWithMode(t *testing.T) {
+	tests := []struct {
+		input    string
+		mode     RoundingMode
+		expected string
+	}{
+		{"2.345", RoundHalfUp, "2.35"},
+		{"-2.345", RoundHalfUp, "-2.35"},
+		{"2.345", RoundHalfEven, "2.34"},
+		{"2.355", RoundHalfEven, "2.36"},
+		{"-2.345", RoundHalfEven, "-2.34"},
+		{"2.345", RoundHalfDown, "2.34"},
+		{"2.3451", RoundHalfDown, "2.35"},
+		{"2.341", RoundUp, "2.35"},
+		{"-2.341", RoundUp, "-2.35"},
+		{"2.349", RoundDown, "2.34"},
+		{"-2.349", RoundDown, "-2.34"},
+		{"-2.341", RoundCeiling, "-2.34"},
+		{"2.341", RoundCeiling, "2.35"},
+		{"-2.341", RoundFloor, "-2.35"},
+		{"2.349", RoundFloor, "2.34"},
+		{"2.34", RoundUp, "2.34"},
+	}
+
+	for _, tt := range tests {
+		d, err := NewDecimal(tt.input)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, d.RoundWithMode(2, tt.mode).StringFixed(2), "%s %s", tt.input, tt.mode)
+	}
+}
+
+func TestDecimal_RoundToIncrement(t *testing.T) {
+	tests := []struct {
+		input     string
+		increment string
+		mode      RoundingMode
+		expected  string
+	}{
+		{"12.32", "0.05", RoundHalfUp, "12.3"},
+		{"12.325", "0.05", RoundHalfUp, "12.35"},
+		{"12.375", "0.05", RoundHalfEven, "12.4"},
+		{"12.325", "0.05", RoundHalfEven, "12.3"},
+		{"-12.33", "0.05", RoundHalfUp, "-12.35"},
+		{"15250", "100", RoundHalfUp, "15300"},
+		{"15250", "100", RoundHalfEven, "15200"},
+		{"15201", "100", RoundUp, "15300"},
+		{"0.1", "0.1", RoundHalfUp, "0.1"},
+	}
+
+	for _, tt := range tests {
+		d, _ := NewDecimal(tt.input)
+		increment, _ := NewDecimal(tt.increment)
+
+		rounded, err := d.RoundToIncrement(increment, tt.mode)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, rounded.String(), "%s to %s %s", tt.input, tt.increment, tt.mode)
+	}
+
+	_, err := NewDecimalFromInt(1).RoundToIncrement(NewDecimalFromInt(0), RoundHalfUp)
+	assert.Error(t, err)
+}
+
+func TestParseRoundingMode(t *testing.T) {
+	mode, err := ParseRoundingMode(" HALF_EVEN ")
+	require.NoError(t, err)
+	assert.Equal(t, RoundHalfEven, mode)
+
+	mode, err = ParseRoundingMode("")
+	require.NoError(t, err)
+	assert.Equal(t, RoundHalfUp, mode)
+
+	_, err = ParseRoundingMode("nearest")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid rounding mode")
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	d, _ := NewDecimal("1.0856")
+
+	data, err := json.Marshal(d)
+	require.NoError(t, err)
+	assert.Equal(t, `"1.0856"`, string(data))
+
+	var decoded Decimal
+	require.NoError(t, json.Unmarshal([]byte(`"2.5"`), &decoded))
+	assert.Equal(t, "2.5", decoded.String())
+
+	require.NoError(t, json.Unmarshal([]byte(`3.75`), &decoded))
+	assert.Equal(t, "3.75", decoded.String())
+}
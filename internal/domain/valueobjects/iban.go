@@ -0,0 +1,124 @@
+package valueobjects
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// IBANMinLength is the shortest IBAN length in use
+	IBANMinLength = 15
+	// IBANMaxLength is the longest IBAN length allowed by ISO 13616
+	IBANMaxLength = 34
+)
+
+// ibanPattern matches the generic ISO 13616 structure: country code, check digits and alphanumeric BBAN
+var ibanPattern = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`)
+
+// IBAN represents an ISO 13616 International Bank Account Number value object.
+// Only the generic structure and the mod-97 checksum are validated here; the
+// country specific length and BBAN structure are master data (see entities.IBANFormat).
+type IBAN struct {
+	value string
+}
+
+// NewIBAN creates a new IBAN value object, removing spaces and normalizing case
+func NewIBAN(iban string) (*IBAN, error) {
+	normalized := NormalizeIBAN(iban)
+	if err := validateIBAN(normalized); err != nil {
+		return nil, err
+	}
+	return &IBAN{value: normalized}, nil
+}
+
+// NormalizeIBAN removes whitespace and dashes and converts the IBAN to upper case
+func NormalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.Join(strings.FieldsFunc(iban, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '-'
+	}), ""))
+}
+
+// Value returns the IBAN in electronic format
+func (i *IBAN) Value() string {
+	return i.value
+}
+
+// String implements the Stringer interface
+func (i *IBAN) String() string {
+	return i.value
+}
+
+// CountryCode returns the ISO 3166-1 alpha-2 country code
+func (i *IBAN) CountryCode() string {
+	return i.value[0:2]
+}
+
+// CheckDigits returns the two check digits
+func (i *IBAN) CheckDigits() string {
+	return i.value[2:4]
+}
+
+// BBAN returns the country specific Basic Bank Account Number
+func (i *IBAN) BBAN() string {
+	return i.value[4:]
+}
+
+// Formatted returns the IBAN in print format, grouped in blocks of four characters
+func (i *IBAN) Formatted() string {
+	var groups []string
+	for start := 0; start < len(i.value); start += 4 {
+		end := start + 4
+		if end > len(i.value) {
+			end = len(i.value)
+		}
+		groups = append(groups, i.value[start:end])
+	}
+	return strings.Join(groups, " ")
+}
+
+// IBANChecksumValid checks the ISO 7064 mod-97 checksum of a normalized IBAN
+func IBANChecksumValid(iban string) bool {
+	if len(iban) < 4 {
+		return false
+	}
+	return ibanMod97(iban[4:]+iban[0:4]) == 1
+}
+
+// ibanMod97 computes the remainder of the numeric representation of the value divided by 97,
+// converting letters to numbers (A = 10 ... Z = 35) and processing digit by digit to avoid overflow
+func ibanMod97(value string) int {
+	remainder := 0
+	for _, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			remainder = (remainder*10 + int(r-'0')) % 97
+		case r >= 'A' && r <= 'Z':
+			remainder = (remainder*100 + int(r-'A') + 10) % 97
+		default:
+			return -1
+		}
+	}
+	return remainder
+}
+
+// validateIBAN validates the generic IBAN structure and checksum
+func validateIBAN(iban string) error {
+	if iban == "" {
+		return fmt.Errorf("IBAN cannot be empty")
+	}
+
+	if len(iban) < IBANMinLength || len(iban) > IBANMaxLength {
+		return fmt.Errorf("IBAN must be between %d and %d characters: %d given", IBANMinLength, IBANMaxLength, len(iban))
+	}
+
+	if !ibanPattern.MatchString(iban) {
+		return fmt.Errorf("invalid IBAN format: %s", iban)
+	}
+
+	if !IBANChecksumValid(iban) {
+		return fmt.Errorf("invalid IBAN checksum: %s", iban)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIBAN(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		expectError bool
+		errorMsg    string
+	}{
+		{name: "valid German IBAN", input: "DE89370400440532013000", expected: "DE89370400440532013000"},
+		{name: "print format with spaces", input: "GB29 NWBK 6016 1331 9268 19", expected: "GB29NWBK60161331926819"},
+		{name: "lower case with dashes", input: "nl91-abna-0417-1643-00", expected: "NL91ABNA0417164300"},
+		{name: "empty", input: "  ", expectError: true, errorMsg: "cannot be empty"},
+		{name: "too short", input: "DE8937040044", expectError: true, errorMsg: "must be between"},
+		{name: "letters as check digits", input: "DEXX370400440532013000", expectError: true, errorMsg: "invalid IBAN format"},
+		{name: "invalid character", input: "DE89370400440532013.00", expectError: true, errorMsg: "invalid IBAN format"},
+		{name: "wrong checksum", input: "DE88370400440532013000", expectError: true, errorMsg: "invalid IBAN checksum"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iban, err := NewIBAN(tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, iban)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, iban.Value())
+				assert.Equal(t, tt.expected, iban.String())
+			}
+		})
+	}
+}
+
+func TestIBAN_Parts(t *testing.T) {
+	iban, err := NewIBAN("GB29NWBK60161331926819")
+	require.NoError(t, err)
+
+	assert.Equal(t, "GB", iban.CountryCode())
+	assert.Equal(t, "29", iban.CheckDigits())
+	assert.Equal(t, "NWBK60161331926819", iban.BBAN())
+	assert.Equal(t, "GB29 NWBK 6016 1331 9268 19", iban.Formatted())
+}
+
+func TestIBANChecksumValid(t *testing.T) {
+	assert.True(t, IBANChecksumValid("FR1420041010050500013M02606"))
+	assert.True(t, IBANChecksumValid("NO9386011117947"))
+	assert.False(t, IBANChecksumValid("FR1420041010050500013M02607"))
+	assert.False(t, IBANChecksumValid("FR"))
+}
@@ -0,0 +1,131 @@
+package valueobjects
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// subtagPattern matches a subtag of a BCP 47 language tag
+var subtagPattern = regexp.MustCompile(`^[A-Za-z0-9]{1,8}$`)
+
+// languageSubtagPattern matches the primary language subtag of a BCP 47 language tag
+var languageSubtagPattern = regexp.MustCompile(`^[A-Za-z]{2,8}$`)
+
+// NormalizeLanguageTag normalizes a BCP 47 language tag such as "id_id" or "zh-hant-tw" to its conventional
+// casing, "id-ID" or "zh-Hant-TW": the language in lower case, the script in title case and the region in upper case
+func NormalizeLanguageTag(tag string) (string, error) {
+	tag = strings.TrimSpace(strings.ReplaceAll(tag, "_", "-"))
+	if tag == "" {
+		return "", fmt.Errorf("invalid language tag: cannot be empty")
+	}
+
+	subtags := strings.Split(tag, "-")
+	if !languageSubtagPattern.MatchString(subtags[0]) {
+		return "", fmt.Errorf("invalid language tag '%s': must start with a language subtag of 2 to 8 letters", tag)
+	}
+	subtags[0] = strings.ToLower(subtags[0])
+
+	for i := 1; i < len(subtags); i++ {
+		subtag := subtags[i]
+		if !subtagPattern.MatchString(subtag) {
+			return "", fmt.Errorf("invalid language tag '%s': subtag '%s' must be 1 to 8 letters or digits", tag, subtag)
+		}
+
+		// Subtags after a singleton belong to an extension or private use and keep lower case
+		if len(subtags[i-1]) == 1 {
+			subtags[i] = strings.ToLower(subtag)
+			continue
+		}
+
+		switch {
+		case len(subtag) == 4 && isAlpha(subtag):
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case len(subtag) == 2 && isAlpha(subtag):
+			subtags[i] = strings.ToUpper(subtag)
+		default:
+			subtags[i] = strings.ToLower(subtag)
+		}
+	}
+
+	return strings.Join(subtags, "-"), nil
+}
+
+// LanguageTagLanguage returns the primary language subtag of a language tag in lower case, e.g. "en" for "en-GB"
+func LanguageTagLanguage(tag string) string {
+	language, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+	return strings.ToLower(strings.TrimSpace(language))
+}
+
+// MaxAcceptLanguageRanges is the number of the most preferred ranges of an Accept-Language header that are
+// considered; the rest are ignored so that a long header cannot cause unbounded lookups
+const MaxAcceptLanguageRanges = 10
+
+// LanguageRange is a language range of an Accept-Language header with its quality weight
+type LanguageRange struct {
+	Tag     string
+	Quality float64
+}
+
+// ParseAcceptLanguage parses an Accept-Language header such as "id-ID,id;q=0.9,en;q=0.8,*;q=0.1" into its
+// language ranges, most preferred first. Ranges are normalized with NormalizeLanguageTag; invalid ranges and
+// ranges with a quality of 0 are skipped, and at most MaxAcceptLanguageRanges ranges are returned.
+func ParseAcceptLanguage(header string) []LanguageRange {
+	var ranges []LanguageRange
+
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.ToLower(strings.TrimSpace(name)) != "q" {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || parsed < 0 || parsed > 1 {
+				quality = 0
+			} else {
+				quality = parsed
+			}
+		}
+		if quality == 0 {
+			continue
+		}
+
+		if tag != "*" {
+			normalized, err := NormalizeLanguageTag(tag)
+			if err != nil {
+				continue
+			}
+			tag = normalized
+		}
+
+		ranges = append(ranges, LanguageRange{Tag: tag, Quality: quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].Quality > ranges[j].Quality
+	})
+	if len(ranges) > MaxAcceptLanguageRanges {
+		ranges = ranges[:MaxAcceptLanguageRanges]
+	}
+
+	return ranges
+}
+
+// isAlpha checks if the string consists of ASCII letters only
+func isAlpha(value string) bool {
+	for _, r := range value {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
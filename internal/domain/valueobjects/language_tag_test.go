@@ -0,0 +1,101 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLanguageTag(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		expectError bool
+	}{
+		{name: "language and region", input: "id-ID", expected: "id-ID"},
+		{name: "lower case region", input: "en-gb", expected: "en-GB"},
+		{name: "underscore", input: "pt_br", expected: "pt-BR"},
+		{name: "script", input: "ZH-HANT-tw", expected: "zh-Hant-TW"},
+		{name: "numeric region", input: "es-419", expected: "es-419"},
+		{name: "language only", input: "DE", expected: "de"},
+		{name: "private use", input: "en-US-x-TWAIN", expected: "en-US-x-twain"},
+		{name: "empty", input: "", expectError: true},
+		{name: "numeric language", input: "12-US", expectError: true},
+		{name: "empty subtag", input: "en--US", expectError: true},
+		{name: "long subtag", input: "en-abcdefghi", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tag, err := NormalizeLanguageTag(tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid language tag")
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, tag)
+			}
+		})
+	}
+}
+
+func TestLanguageTagLanguage(t *testing.T) {
+	assert.Equal(t, "en", LanguageTagLanguage("en-GB"))
+	assert.Equal(t, "pt", LanguageTagLanguage("PT_br"))
+	assert.Equal(t, "id", LanguageTagLanguage("id"))
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected []LanguageRange
+	}{
+		{
+			name:   "ordered by quality",
+			header: "en;q=0.8, id-id, id;q=0.9, *;q=0.1",
+			expected: []LanguageRange{
+				{Tag: "id-ID", Quality: 1},
+				{Tag: "id", Quality: 0.9},
+				{Tag: "en", Quality: 0.8},
+				{Tag: "*", Quality: 0.1},
+			},
+		},
+		{
+			name:   "equal qualities keep header order",
+			header: "fr-CH, fr;q=0.9, en;q=0.9",
+			expected: []LanguageRange{
+				{Tag: "fr-CH", Quality: 1},
+				{Tag: "fr", Quality: 0.9},
+				{Tag: "en", Quality: 0.9},
+			},
+		},
+		{
+			name:     "invalid and excluded ranges are skipped",
+			header:   "de;q=0, 1x, en;q=abc, nl",
+			expected: []LanguageRange{{Tag: "nl", Quality: 1}},
+		},
+		{
+			name:   "empty header",
+			header: "",
+		},
+		{
+			name:   "only the most preferred ranges are kept",
+			header: "aa;q=0.1, ab, ae, af, ak, am, an, ar, as, av, ay, az",
+			expected: []LanguageRange{
+				{Tag: "ab", Quality: 1}, {Tag: "ae", Quality: 1}, {Tag: "af", Quality: 1}, {Tag: "ak", Quality: 1},
+				{Tag: "am", Quality: 1}, {Tag: "an", Quality: 1}, {Tag: "ar", Quality: 1}, {Tag: "as", Quality: 1},
+				{Tag: "av", Quality: 1}, {Tag: "ay", Quality: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseAcceptLanguage(tt.header))
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FilterOperator is a comparison operator of a list filter
+type FilterOperator string
+
+// Filter operators
+const (
+	FilterEq   FilterOperator = "eq"   // Equal to the value
+	FilterNe   FilterOperator = "ne"   // Not equal to the value
+	FilterLt   FilterOperator = "lt"   // Less than the value
+	FilterLte  FilterOperator = "lte"  // Less than or equal to the value
+	FilterGt   FilterOperator = "gt"   // Greater than the value
+	FilterGte  FilterOperator = "gte"  // Greater than or equal to the value
+	FilterLike FilterOperator = "like" // Contains the value, case insensitive
+	FilterIn   FilterOperator = "in"   // Equal to one of the comma separated values
+	FilterNull FilterOperator = "null" // Is null (true) or is not null (false)
+)
+
+// FieldType is the type of a filterable field; filter values are converted to it
+type FieldType string
+
+// Field types
+const (
+	FieldTypeString FieldType = "string"
+	FieldTypeInt    FieldType = "int"
+	FieldTypeBool   FieldType = "bool"
+	FieldTypeDate   FieldType = "date"
+	FieldTypeUUID   FieldType = "uuid"
+)
+
+const (
+	// MaxListFilters limits the number of filters of a list query
+	MaxListFilters = 20
+	// MaxListFilterValues limits the number of values of a FilterIn filter
+	MaxListFilterValues = 100
+)
+
+// ErrInvalidFilter and ErrInvalidSort are wrapped by the errors returned for filters and sort fields that
+// cannot be applied, so that callers can answer them as bad requests
+var (
+	ErrInvalidFilter = errors.New("invalid filter")
+	ErrInvalidSort   = errors.New("invalid sort field")
+)
+
+// defaultFieldOperators are the operators allowed on a field of each type unless the field lists its own
+var defaultFieldOperators = map[FieldType][]FilterOperator{
+	FieldTypeString: {FilterEq, FilterNe, FilterLike, FilterIn, FilterNull},
+	FieldTypeInt:    {FilterEq, FilterNe, FilterLt, FilterLte, FilterGt, FilterGte, FilterIn, FilterNull},
+	FieldTypeBool:   {FilterEq, FilterNe},
+	FieldTypeDate:   {FilterEq, FilterNe, FilterLt, FilterLte, FilterGt, FilterGte, FilterNull},
+	FieldTypeUUID:   {FilterEq, FilterNe, FilterIn, FilterNull},
+}
+
+// ListField describes a field of an entity that list queries may filter or sort on
+type ListField struct {
+	Type      FieldType
+	Operators []FilterOperator // Allowed operators; the defaults of the type when empty
+	Sortable  bool
+}
+
+// allows checks if the field may be filtered with the operator
+func (f ListField) allows(operator FilterOperator) bool {
+	operators := f.Operators
+	if len(operators) == 0 {
+		operators = defaultFieldOperators[f.Type]
+	}
+	for _, allowed := range operators {
+		if allowed == operator {
+			return true
+		}
+	}
+	return false
+}
+
+// ListSchema is the whitelist of the fields of an entity list queries may use, keyed by field name
+type ListSchema map[string]ListField
+
+// Filter is a condition of a list query. Value holds the converted value: a string, int64, bool,
+// time.Time or uuid.UUID, a slice of those for FilterIn and a bool for FilterNull.
+type Filter struct {
+	Field    string
+	Operator FilterOperator
+	Value    interface{}
+}
+
+// SortField is a field a list query is ordered by
+type SortField struct {
+	Field      string
+	Descending bool
+}
+
+// ListQuery holds the validated filters, sort order and page of a list request
+type ListQuery struct {
+	Filters []Filter
+	Sort    []SortField
+	Limit   int
+	Offset  int
+}
+
+// IsEmpty reports whether the query has neither filters nor a sort order
+func (q ListQuery) IsEmpty() bool {
+	return len(q.Filters) == 0 && len(q.Sort) == 0
+}
+
+// ParseListQuery parses the filter and sort parameters of a list request against the whitelist of an entity.
+// Filters are given as filter[field][op]=value, or filter[field]=value for FilterEq, and the sort order as
+// sort=-name,code where a leading "-" sorts descending. Other parameters are ignored.
+func ParseListQuery(schema ListSchema, params map[string]string) (ListQuery, error) {
+	var query ListQuery
+
+	// Sort the keys so that filters are applied, and errors reported, in a stable order
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !strings.HasPrefix(key, "filter[") {
+			continue
+		}
+
+		field, operator, err := parseFilterKey(key)
+		if err != nil {
+			return ListQuery{}, err
+		}
+
+		definition, ok := schema[field]
+		if !ok {
+			return ListQuery{}, fmt.Errorf("%w: unknown field '%s', filterable fields are %s", ErrInvalidFilter, field, strings.Join(schema.fieldNames(false), ", "))
+		}
+		if !definition.allows(operator) {
+			return ListQuery{}, fmt.Errorf("%w: operator '%s' is not allowed on field '%s'", ErrInvalidFilter, operator, field)
+		}
+
+		value, err := convertFilterValue(definition.Type, operator, params[key])
+		if err != nil {
+			return ListQuery{}, fmt.Errorf("%w: value for '%s': %v", ErrInvalidFilter, field, err)
+		}
+
+		query.Filters = append(query.Filters, Filter{Field: field, Operator: operator, Value: value})
+	}
+
+	if len(query.Filters) > MaxListFilters {
+		return ListQuery{}, fmt.Errorf("%w: at most %d filters are allowed", ErrInvalidFilter, MaxListFilters)
+	}
+
+	if raw := strings.TrimSpace(params["sort"]); raw != "" {
+		seen := make(map[string]bool)
+		for _, part := range strings.Split(raw, ",") {
+			part = strings.TrimSpace(part)
+			descending := strings.HasPrefix(part, "-")
+			field := strings.TrimPrefix(strings.TrimPrefix(part, "-"), "+")
+
+			definition, ok := schema[field]
+			if !ok || !definition.Sortable {
+				return ListQuery{}, fmt.Errorf("%w '%s': sortable fields are %s", ErrInvalidSort, field, strings.Join(schema.fieldNames(true), ", "))
+			}
+			if seen[field] {
+				continue
+			}
+			seen[field] = true
+
+			query.Sort = append(query.Sort, SortField{Field: field, Descending: descending})
+		}
+	}
+
+	return query, nil
+}
+
+// parseFilterKey splits a filter[field][op] or filter[field] parameter name into the field and the operator
+func parseFilterKey(key string) (string, FilterOperator, error) {
+	rest := strings.TrimPrefix(key, "filter[")
+	field, rest, ok := strings.Cut(rest, "]")
+	if !ok || field == "" {
+		return "", "", fmt.Errorf("%w parameter '%s': expected filter[field][operator]", ErrInvalidFilter, key)
+	}
+
+	if rest == "" {
+		return field, FilterEq, nil
+	}
+
+	if !strings.HasPrefix(rest, "[") || !strings.HasSuffix(rest, "]") || len(rest) < 3 {
+		return "", "", fmt.Errorf("%w parameter '%s': expected filter[field][operator]", ErrInvalidFilter, key)
+	}
+	operator := FilterOperator(strings.ToLower(rest[1 : len(rest)-1]))
+
+	return field, operator, nil
+}
+
+// convertFilterValue converts the raw value of a filter to the type of its field
+func convertFilterValue(fieldType FieldType, operator FilterOperator, raw string) (interface{}, error) {
+	raw = strings.TrimSpace(raw)
+
+	switch operator {
+	case FilterNull:
+		isNull, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("expected true or false")
+		}
+		return isNull, nil
+	case FilterIn:
+		parts := strings.Split(raw, ",")
+		if len(parts) > MaxListFilterValues {
+			return nil, fmt.Errorf("at most %d values are allowed", MaxListFilterValues)
+		}
+
+		values := make([]interface{}, 0, len(parts))
+		for _, part := range parts {
+			value, err := convertScalar(fieldType, strings.TrimSpace(part))
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case FilterLike:
+		if raw == "" {
+			return nil, fmt.Errorf("value cannot be empty")
+		}
+		return raw, nil
+	default:
+		return convertScalar(fieldType, raw)
+	}
+}
+
+// convertScalar converts a single raw value to the given field type
+func convertScalar(fieldType FieldType, raw string) (interface{}, error) {
+	switch fieldType {
+	case FieldTypeInt:
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not an integer", raw)
+		}
+		return value, nil
+	case FieldTypeBool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not true or false", raw)
+		}
+		return value, nil
+	case FieldTypeDate:
+		if value, err := time.Parse(time.RFC3339, raw); err == nil {
+			return value, nil
+		}
+		value, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a date (YYYY-MM-DD or RFC 3339)", raw)
+		}
+		return value, nil
+	case FieldTypeUUID:
+		value, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a UUID", raw)
+		}
+		return value, nil
+	default:
+		return raw, nil
+	}
+}
+
+// fieldNames returns the names of the fields of the schema in alphabetical order, only the sortable ones if requested
+func (s ListSchema) fieldNames(sortableOnly bool) []string {
+	var names []string
+	for name, field := range s {
+		if !sortableOnly || field.Sortable {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,111 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testListSchema = ListSchema{
+	"name":           {Type: FieldTypeString, Sortable: true},
+	"code":           {Type: FieldTypeString, Sortable: true},
+	"status":         {Type: FieldTypeString, Operators: []FilterOperator{FilterEq, FilterIn}},
+	"decimal_places": {Type: FieldTypeInt, Sortable: true},
+	"is_active":      {Type: FieldTypeBool},
+	"created_at":     {Type: FieldTypeDate, Sortable: true},
+	"parent_id":      {Type: FieldTypeUUID},
+}
+
+func TestParseListQuery(t *testing.T) {
+	parentID := uuid.New()
+
+	tests := []struct {
+		name        string
+		params      map[string]string
+		expected    ListQuery
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:     "no filters",
+			params:   map[string]string{"limit": "10", "q": "bank"},
+			expected: ListQuery{},
+		},
+		{
+			name:   "operator defaults to eq",
+			params: map[string]string{"filter[code]": "IDR"},
+			expected: ListQuery{Filters: []Filter{
+				{Field: "code", Operator: FilterEq, Value: "IDR"},
+			}},
+		},
+		{
+			name: "typed values",
+			params: map[string]string{
+				"filter[decimal_places][gte]": "2",
+				"filter[is_active][eq]":       "false",
+				"filter[created_at][lt]":      "2024-01-31",
+				"filter[parent_id][eq]":       parentID.String(),
+			},
+			expected: ListQuery{Filters: []Filter{
+				{Field: "created_at", Operator: FilterLt, Value: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+				{Field: "decimal_places", Operator: FilterGte, Value: int64(2)},
+				{Field: "is_active", Operator: FilterEq, Value: false},
+				{Field: "parent_id", Operator: FilterEq, Value: parentID},
+			}},
+		},
+		{
+			name:   "in, like and null",
+			params: map[string]string{"filter[status][in]": "merged, closed", "filter[name][like]": "central", "filter[code][null]": "false"},
+			expected: ListQuery{Filters: []Filter{
+				{Field: "code", Operator: FilterNull, Value: false},
+				{Field: "name", Operator: FilterLike, Value: "central"},
+				{Field: "status", Operator: FilterIn, Value: []interface{}{"merged", "closed"}},
+			}},
+		},
+		{
+			name:   "sort",
+			params: map[string]string{"sort": "-name, code,name"},
+			expected: ListQuery{Sort: []SortField{
+				{Field: "name", Descending: true},
+				{Field: "code"},
+			}},
+		},
+		{name: "unknown field", params: map[string]string{"filter[secret][eq]": "x"}, expectError: true, errorMsg: "unknown field 'secret'"},
+		{name: "operator not allowed", params: map[string]string{"filter[status][like]": "act"}, expectError: true, errorMsg: "operator 'like' is not allowed"},
+		{name: "unknown operator", params: map[string]string{"filter[name][regex]": ".*"}, expectError: true, errorMsg: "operator 'regex' is not allowed"},
+		{name: "malformed key", params: map[string]string{"filter[name": "x"}, expectError: true, errorMsg: "invalid filter parameter"},
+		{name: "too many in values", params: map[string]string{"filter[status][in]": strings.Repeat("active,", MaxListFilterValues) + "closed"}, expectError: true, errorMsg: "at most 100 values are allowed"},
+		{name: "invalid integer", params: map[string]string{"filter[decimal_places][gt]": "two"}, expectError: true, errorMsg: "is not an integer"},
+		{name: "invalid date", params: map[string]string{"filter[created_at][gt]": "31/01/2024"}, expectError: true, errorMsg: "is not a date"},
+		{name: "invalid uuid", params: map[string]string{"filter[parent_id]": "1"}, expectError: true, errorMsg: "is not a UUID"},
+		{name: "invalid null value", params: map[string]string{"filter[code][null]": "maybe"}, expectError: true, errorMsg: "expected true or false"},
+		{name: "field not sortable", params: map[string]string{"sort": "status"}, expectError: true, errorMsg: "invalid sort field 'status'"},
+		{name: "empty sort field", params: map[string]string{"sort": "name,"}, expectError: true, errorMsg: "invalid sort field ''"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := ParseListQuery(testListSchema, tt.params)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, ErrInvalidFilter) || errors.Is(err, ErrInvalidSort), err.Error())
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, query)
+			}
+		})
+	}
+}
+
+func TestListQuery_IsEmpty(t *testing.T) {
+	assert.True(t, ListQuery{Limit: 50}.IsEmpty())
+	assert.False(t, ListQuery{Sort: []SortField{{Field: "name"}}}.IsEmpty())
+	assert.False(t, ListQuery{Filters: []Filter{{Field: "code", Operator: FilterEq, Value: "IDR"}}}.IsEmpty())
+}
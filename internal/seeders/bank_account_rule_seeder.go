@@ -0,0 +1,185 @@
+package seeders
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database/pgx"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/pkg/logger"
+)
+
+// BankAccountRuleSeeder handles seeding bank account number rules
+type BankAccountRuleSeeder struct {
+	repo     *pgx.BankAccountRuleRepository
+	bankRepo *pgx.BankRepository
+	logger   *logger.Logger
+}
+
+// NewBankAccountRuleSeeder creates a new bank account rule seeder
+func NewBankAccountRuleSeeder(repo *pgx.BankAccountRuleRepository, bankRepo *pgx.BankRepository, logger *logger.Logger) *BankAccountRuleSeeder {
+	return &BankAccountRuleSeeder{
+		repo:     repo,
+		bankRepo: bankRepo,
+		logger:   logger,
+	}
+}
+
+// Name returns the seeder name
+func (bs *BankAccountRuleSeeder) Name() string {
+	return "bank-account-rules"
+}
+
+// Seed seeds account number rules from the optional tm_bank_account_rules.csv file next to tm_banks.csv.
+// Columns are located by header name: bank_code, lengths, digits_only, check_digit_algorithm and
+// prefix_patterns. Lengths and prefix patterns hold several values separated by "|". Banks must be seeded first.
+func (bs *BankAccountRuleSeeder) Seed(ctx context.Context, dataDir string) error {
+	csvFile := filepath.Join(dataDir, "tm_bank_account_rules.csv")
+	bs.logger.WithField("file", csvFile).Info("Starting bank account rules seeding")
+
+	file, err := os.Open(csvFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			bs.logger.Info("Bank account rules file not found, skipping bank account rules seeding")
+			return nil
+		}
+		return fmt.Errorf("failed to open bank account rules CSV file: %w", err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return fmt.Errorf("failed to read bank account rules CSV: %w", err)
+	}
+
+	if len(records) < 2 {
+		return fmt.Errorf("bank account rules CSV file must contain at least a header and one data row")
+	}
+
+	columns := make(map[string]int)
+	for i, column := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	if _, ok := columns["bank_code"]; !ok {
+		return fmt.Errorf("bank account rules CSV file is missing the bank_code column")
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	successCount := 0
+	errorCount := 0
+
+	fmt.Printf("🔢 Processing %d bank account rule records...\n", len(records)-1)
+
+	for i, record := range records[1:] { // Skip header
+		bankCode := value(record, "bank_code")
+		if bankCode == "" {
+			bs.logger.WithField("row", i+2).Warn("Bank account rule record is missing the bank code")
+			errorCount++
+			continue
+		}
+
+		bank, err := bs.bankRepo.GetByCode(ctx, bankCode)
+		if err != nil {
+			bs.logger.WithError(err).WithFields(map[string]interface{}{
+				"row":       i + 2,
+				"bank_code": bankCode,
+			}).Warn("Bank of account rule not found")
+			errorCount++
+			continue
+		}
+
+		var lengths []int
+		validLengths := true
+		for _, part := range splitList(value(record, "lengths")) {
+			length, err := strconv.Atoi(part)
+			if err != nil {
+				validLengths = false
+				break
+			}
+			lengths = append(lengths, length)
+		}
+
+		// Account numbers are digits only unless the file says otherwise
+		digitsOnly := true
+		if raw := value(record, "digits_only"); raw != "" {
+			digitsOnly, _ = strconv.ParseBool(raw)
+		}
+
+		rule := entities.NewBankAccountRule(bank.ID, lengths, digitsOnly)
+		rule.SetCheckDigitAlgorithm(value(record, "check_digit_algorithm"))
+		rule.SetPrefixPatterns(splitList(value(record, "prefix_patterns")))
+
+		if !validLengths || !rule.IsValid() {
+			bs.logger.WithFields(map[string]interface{}{
+				"row":       i + 2,
+				"bank_code": bankCode,
+			}).Warn("Invalid bank account rule")
+			errorCount++
+			continue
+		}
+
+		// Check if the bank already has rules
+		existing, err := bs.repo.GetByBankID(ctx, bank.ID)
+		if err == nil && existing != nil {
+			rule.ID = existing.ID
+			err = bs.repo.Update(ctx, rule)
+		} else {
+			err = bs.repo.Create(ctx, rule)
+		}
+
+		if err != nil {
+			bs.logger.WithError(err).WithFields(map[string]interface{}{
+				"row":       i + 2,
+				"bank_code": bankCode,
+			}).Warn("Failed to save bank account rule")
+			errorCount++
+			continue
+		}
+
+		successCount++
+	}
+
+	bs.logger.WithFields(map[string]interface{}{
+		"total_processed": len(records) - 1,
+		"successful":      successCount,
+		"errors":          errorCount,
+	}).Info("Bank account rules seeding completed")
+
+	fmt.Printf("✅ Bank account rules seeding completed: %d successful, %d errors\n", successCount, errorCount)
+	return nil
+}
+
+// Clear removes all bank account rule data
+func (bs *BankAccountRuleSeeder) Clear(ctx context.Context) error {
+	bs.logger.Info("Clearing bank account rule data using TRUNCATE")
+
+	if err := bs.repo.Truncate(ctx); err != nil {
+		return fmt.Errorf("failed to truncate bank account rules table: %w", err)
+	}
+
+	bs.logger.Info("Bank account rules table truncated successfully")
+	return nil
+}
+
+// splitList splits a "|" separated CSV cell into its trimmed, non-empty values
+func splitList(value string) []string {
+	var values []string
+	for _, part := range strings.Split(value, "|") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
@@ -0,0 +1,198 @@
+package seeders
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database/pgx"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+	"github.com/turahe/master-data-rest-api/pkg/logger"
+)
+
+// BankBranchSeeder handles seeding bank branch data
+type BankBranchSeeder struct {
+	repo             *pgx.BankBranchRepository
+	bankRepo         *pgx.BankRepository
+	geodirectoryRepo *pgx.GeodirectoryRepository
+	logger           *logger.Logger
+}
+
+// NewBankBranchSeeder creates a new bank branch seeder
+func NewBankBranchSeeder(
+	repo *pgx.BankBranchRepository,
+	bankRepo *pgx.BankRepository,
+	geodirectoryRepo *pgx.GeodirectoryRepository,
+	logger *logger.Logger,
+) *BankBranchSeeder {
+	return &BankBranchSeeder{
+		repo:             repo,
+		bankRepo:         bankRepo,
+		geodirectoryRepo: geodirectoryRepo,
+		logger:           logger,
+	}
+}
+
+// Name returns the seeder name
+func (bs *BankBranchSeeder) Name() string {
+	return "bank-branches"
+}
+
+// Seed seeds bank branches from the optional tm_bank_branches.csv file. Columns are located by header name:
+// bank_code, code, name, address and the optional geodirectory_code and bic.
+// Banks and geodirectories must be seeded first.
+func (bs *BankBranchSeeder) Seed(ctx context.Context, dataDir string) error {
+	csvFile := filepath.Join(dataDir, "tm_bank_branches.csv")
+	bs.logger.WithField("file", csvFile).Info("Starting bank branches seeding")
+
+	file, err := os.Open(csvFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			bs.logger.Info("Bank branches file not found, skipping bank branches seeding")
+			return nil
+		}
+		return fmt.Errorf("failed to open bank branches CSV file: %w", err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return fmt.Errorf("failed to read bank branches CSV: %w", err)
+	}
+
+	if len(records) < 2 {
+		return fmt.Errorf("bank branches CSV file must contain at least a header and one data row")
+	}
+
+	columns := make(map[string]int)
+	for i, column := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, required := range []string{"bank_code", "code", "name"} {
+		if _, ok := columns[required]; !ok {
+			return fmt.Errorf("bank branches CSV file is missing the %s column", required)
+		}
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	successCount := 0
+	errorCount := 0
+	banks := make(map[string]*entities.Bank)
+
+	fmt.Printf("🏢 Processing %d bank branch records...\n", len(records)-1)
+
+	for i, record := range records[1:] { // Skip header
+		bankCode := value(record, "bank_code")
+		code := value(record, "code")
+		name := value(record, "name")
+
+		if bankCode == "" || code == "" || name == "" {
+			bs.logger.WithField("row", i+2).Warn("Bank branch record is missing bank code, code or name")
+			errorCount++
+			continue
+		}
+
+		bank, ok := banks[bankCode]
+		if !ok {
+			bank, err = bs.bankRepo.GetByCode(ctx, bankCode)
+			if err != nil {
+				bs.logger.WithError(err).WithFields(map[string]interface{}{
+					"row":       i + 2,
+					"bank_code": bankCode,
+				}).Warn("Bank of branch not found")
+				errorCount++
+				continue
+			}
+			banks[bankCode] = bank
+		}
+
+		branch := entities.NewBankBranch(bank.ID, name, code, value(record, "address"))
+
+		if geoCode := value(record, "geodirectory_code"); geoCode != "" {
+			geodirectory, err := bs.geodirectoryRepo.GetByCode(ctx, geoCode)
+			if err != nil {
+				bs.logger.WithError(err).WithFields(map[string]interface{}{
+					"row":               i + 2,
+					"geodirectory_code": geoCode,
+				}).Warn("Geodirectory of branch not found, branch is stored without location")
+			} else {
+				branch.SetGeodirectory(&geodirectory.ID)
+			}
+		}
+
+		if bicValue := value(record, "bic"); bicValue != "" {
+			bic, err := valueobjects.NewBIC(bicValue)
+			if err != nil {
+				bs.logger.WithError(err).WithFields(map[string]interface{}{
+					"row": i + 2,
+					"bic": bicValue,
+				}).Warn("Ignoring invalid bank branch BIC")
+			} else {
+				branch.SetBIC(bic)
+			}
+		}
+
+		// Check if the branch already exists by bank and code
+		existing, err := bs.repo.GetByBankAndCode(ctx, bank.ID, code)
+		if err == nil && existing != nil {
+			branch.ID = existing.ID
+			err = bs.repo.Update(ctx, branch)
+		} else {
+			err = bs.repo.Create(ctx, branch)
+		}
+
+		if err != nil {
+			bs.logger.WithError(err).WithFields(map[string]interface{}{
+				"row":       i + 2,
+				"bank_code": bankCode,
+				"code":      code,
+			}).Warn("Failed to save bank branch")
+			errorCount++
+			continue
+		}
+
+		successCount++
+
+		// Log progress every 500 records
+		if (i+1)%500 == 0 {
+			bs.logger.WithFields(map[string]interface{}{
+				"processed": i + 1,
+				"total":     len(records) - 1,
+				"success":   successCount,
+				"errors":    errorCount,
+			}).Info("Bank branches seeding progress")
+		}
+	}
+
+	bs.logger.WithFields(map[string]interface{}{
+		"total_processed": len(records) - 1,
+		"successful":      successCount,
+		"errors":          errorCount,
+	}).Info("Bank branches seeding completed")
+
+	fmt.Printf("✅ Bank branches seeding completed: %d successful, %d errors\n", successCount, errorCount)
+	return nil
+}
+
+// Clear removes all bank branch data
+func (bs *BankBranchSeeder) Clear(ctx context.Context) error {
+	bs.logger.Info("Clearing bank branch data using TRUNCATE")
+
+	if err := bs.repo.Truncate(ctx); err != nil {
+		return fmt.Errorf("failed to truncate bank branches table: %w", err)
+	}
+
+	bs.logger.Info("Bank branches table truncated successfully")
+	return nil
+}
@@ -7,23 +7,35 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database/pgx"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 	"github.com/turahe/master-data-rest-api/pkg/logger"
 )
 
-// BankSeeder handles seeding bank data
+// BankSeeder handles seeding bank data together with payment networks and bank network memberships
 type BankSeeder struct {
-	repo   *pgx.BankRepository
-	logger *logger.Logger
+	repo           *pgx.BankRepository
+	networkRepo    *pgx.PaymentNetworkRepository
+	membershipRepo *pgx.BankNetworkMembershipRepository
+	logger         *logger.Logger
 }
 
 // NewBankSeeder creates a new bank seeder
-func NewBankSeeder(repo *pgx.BankRepository, logger *logger.Logger) *BankSeeder {
+func NewBankSeeder(
+	repo *pgx.BankRepository,
+	networkRepo *pgx.PaymentNetworkRepository,
+	membershipRepo *pgx.BankNetworkMembershipRepository,
+	logger *logger.Logger,
+) *BankSeeder {
 	return &BankSeeder{
-		repo:   repo,
-		logger: logger,
+		repo:           repo,
+		networkRepo:    networkRepo,
+		membershipRepo: membershipRepo,
+		logger:         logger,
 	}
 }
 
@@ -32,7 +44,7 @@ func (bs *BankSeeder) Name() string {
 	return "banks"
 }
 
-// Seed seeds bank data from CSV file
+// Seed seeds bank data from CSV file, followed by payment networks and bank network memberships
 func (bs *BankSeeder) Seed(ctx context.Context, dataDir string) error {
 	csvFile := filepath.Join(dataDir, "tm_banks.csv")
 	bs.logger.WithField("file", csvFile).Info("Starting banks seeding")
@@ -55,6 +67,19 @@ func (bs *BankSeeder) Seed(ctx context.Context, dataDir string) error {
 		return fmt.Errorf("banks CSV file must contain at least a header and one data row")
 	}
 
+	// The BIC and lifecycle columns are optional and located by their header name
+	bicColumn := -1
+	lifecycleColumns := make(map[string]int)
+	for i, column := range records[0] {
+		name := strings.ToLower(strings.TrimSpace(column))
+		switch name {
+		case "bic":
+			bicColumn = i
+		case "status", "successor_code", "effective_from", "effective_until":
+			lifecycleColumns[name] = i
+		}
+	}
+
 	// Skip header and process records
 	successCount := 0
 	errorCount := 0
@@ -73,8 +98,24 @@ func (bs *BankSeeder) Seed(ctx context.Context, dataDir string) error {
 		company := strings.TrimSpace(record[2])
 		code := strings.TrimSpace(record[3])
 
+		var bic *valueobjects.BIC
+		bicValid := true
+		if bicColumn >= 0 && bicColumn < len(record) && strings.TrimSpace(record[bicColumn]) != "" {
+			bic, err = valueobjects.NewBIC(record[bicColumn])
+			if err != nil {
+				bs.logger.WithError(err).WithFields(map[string]interface{}{
+					"row":  i + 2,
+					"code": code,
+					"bic":  record[bicColumn],
+				}).Warn("Ignoring invalid bank BIC")
+				bic = nil
+				bicValid = false
+			}
+		}
+
 		// Create bank entity
 		bank := entities.NewBank(name, alias, company, code)
+		bank.SetBIC(bic)
 
 		// Check if bank already exists by code
 		existing, err := bs.repo.GetByCode(ctx, code)
@@ -83,6 +124,9 @@ func (bs *BankSeeder) Seed(ctx context.Context, dataDir string) error {
 			existing.SetName(name)
 			existing.SetAlias(alias)
 			existing.SetCompany(company)
+			if bicColumn >= 0 && bicValid {
+				existing.SetBIC(bic)
+			}
 
 			err = bs.repo.Update(ctx, existing)
 			if err != nil {
@@ -128,6 +172,276 @@ func (bs *BankSeeder) Seed(ctx context.Context, dataDir string) error {
 	}).Info("Banks seeding completed")
 
 	fmt.Printf("✅ Banks seeding completed: %d successful, %d errors\n", successCount, errorCount)
+
+	// Successors are resolved once every bank exists
+	if len(lifecycleColumns) > 0 {
+		bs.seedLifecycle(ctx, records, lifecycleColumns)
+	}
+
+	if err := bs.seedPaymentNetworks(ctx, dataDir); err != nil {
+		return err
+	}
+
+	return bs.seedNetworkMemberships(ctx, dataDir)
+}
+
+// seedLifecycle applies the optional status, successor_code, effective_from and effective_until (YYYY-MM-DD)
+// columns of tm_banks.csv, e.g. to mark banks merged into a successor
+func (bs *BankSeeder) seedLifecycle(ctx context.Context, records [][]string, columns map[string]int) {
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	date := func(raw string) *time.Time {
+		parsed, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return nil
+		}
+		return &parsed
+	}
+
+	for i, record := range records[1:] { // Skip header
+		if len(record) < 4 {
+			continue
+		}
+
+		status := strings.ToLower(value(record, "status"))
+		from := date(value(record, "effective_from"))
+		if (status == "" || status == entities.BankStatusActive) && from == nil {
+			continue
+		}
+
+		bank, err := bs.repo.GetByCode(ctx, strings.TrimSpace(record[3]))
+		if err != nil {
+			continue
+		}
+
+		bank.SetEffectiveFrom(from)
+
+		until := date(value(record, "effective_until"))
+		if until == nil {
+			now := time.Now()
+			until = &now
+		}
+
+		var successorID *uuid.UUID
+		if successorCode := value(record, "successor_code"); successorCode != "" {
+			successor, err := bs.repo.GetByCode(ctx, successorCode)
+			if err != nil {
+				bs.logger.WithFields(map[string]interface{}{
+					"row":            i + 2,
+					"code":           bank.Code,
+					"successor_code": successorCode,
+				}).Warn("Successor bank not found")
+			} else {
+				successorID = &successor.ID
+			}
+		}
+
+		switch status {
+		case entities.BankStatusMerged:
+			if successorID != nil {
+				bank.MarkMerged(*successorID, *until)
+			}
+		case entities.BankStatusClosed:
+			bank.MarkClosed(successorID, *until)
+		}
+
+		if !bank.IsValid() {
+			bs.logger.WithFields(map[string]interface{}{
+				"row":    i + 2,
+				"code":   bank.Code,
+				"status": status,
+			}).Warn("Invalid bank lifecycle, a merged bank requires a successor")
+			continue
+		}
+
+		if err := bs.repo.Update(ctx, bank); err != nil {
+			bs.logger.WithError(err).WithFields(map[string]interface{}{
+				"row":  i + 2,
+				"code": bank.Code,
+			}).Warn("Failed to update bank lifecycle")
+		}
+	}
+}
+
+// seedPaymentNetworks seeds payment networks from tm_payment_networks.csv with columns
+// code,name,type,country_code,operator, or the built-in Indonesian networks when the file is absent
+func (bs *BankSeeder) seedPaymentNetworks(ctx context.Context, dataDir string) error {
+	csvFile := filepath.Join(dataDir, "tm_payment_networks.csv")
+
+	var networks []*entities.PaymentNetwork
+	file, err := os.Open(csvFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("failed to open payment networks CSV file: %w", err)
+		}
+		bs.logger.Info("Payment networks file not found, seeding built-in payment networks")
+		networks = entities.DefaultPaymentNetworks()
+	} else {
+		defer file.Close()
+
+		records, err := csv.NewReader(file).ReadAll()
+		if err != nil {
+			return fmt.Errorf("failed to read payment networks CSV: %w", err)
+		}
+
+		for i, record := range records {
+			if i == 0 {
+				continue // Skip header
+			}
+			if len(record) < 5 {
+				bs.logger.WithField("row", i+1).Warn("Payment network record has insufficient columns")
+				continue
+			}
+			networks = append(networks, entities.NewPaymentNetwork(
+				strings.TrimSpace(record[0]), strings.TrimSpace(record[1]), strings.TrimSpace(record[2]),
+				strings.TrimSpace(record[3]), strings.TrimSpace(record[4]),
+			))
+		}
+	}
+
+	successCount := 0
+	for _, network := range networks {
+		if !network.IsValid() {
+			bs.logger.WithField("code", network.Code).Warn("Invalid payment network")
+			continue
+		}
+
+		if existing, err := bs.networkRepo.GetByCode(ctx, network.Code); err == nil && existing != nil {
+			network.ID = existing.ID
+			err = bs.networkRepo.Update(ctx, network)
+			if err != nil {
+				bs.logger.WithError(err).WithField("code", network.Code).Warn("Failed to update payment network")
+				continue
+			}
+		} else if err := bs.networkRepo.Create(ctx, network); err != nil {
+			bs.logger.WithError(err).WithField("code", network.Code).Warn("Failed to create payment network")
+			continue
+		}
+		successCount++
+	}
+
+	fmt.Printf("✅ Payment networks seeding completed: %d of %d networks\n", successCount, len(networks))
+	return nil
+}
+
+// seedNetworkMemberships seeds bank network memberships from the optional tm_bank_network_memberships.csv file.
+// Columns are located by header name: bank_code, network_code, member_code and the optional status,
+// effective_from and effective_until (YYYY-MM-DD).
+func (bs *BankSeeder) seedNetworkMemberships(ctx context.Context, dataDir string) error {
+	csvFile := filepath.Join(dataDir, "tm_bank_network_memberships.csv")
+
+	file, err := os.Open(csvFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			bs.logger.Info("Bank network memberships file not found, skipping memberships seeding")
+			return nil
+		}
+		return fmt.Errorf("failed to open bank network memberships CSV file: %w", err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return fmt.Errorf("failed to read bank network memberships CSV: %w", err)
+	}
+
+	if len(records) < 2 {
+		return nil
+	}
+
+	columns := make(map[string]int)
+	for i, column := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, required := range []string{"bank_code", "network_code", "member_code"} {
+		if _, ok := columns[required]; !ok {
+			return fmt.Errorf("bank network memberships CSV file is missing the %s column", required)
+		}
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	date := func(raw string) (*time.Time, error) {
+		if raw == "" {
+			return nil, nil
+		}
+		parsed, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return nil, err
+		}
+		return &parsed, nil
+	}
+
+	successCount := 0
+	errorCount := 0
+
+	for i, record := range records[1:] { // Skip header
+		bankCode := value(record, "bank_code")
+
+		bank, err := bs.repo.GetByCode(ctx, bankCode)
+		if err != nil {
+			bs.logger.WithError(err).WithFields(map[string]interface{}{
+				"row":       i + 2,
+				"bank_code": bankCode,
+			}).Warn("Bank of network membership not found")
+			errorCount++
+			continue
+		}
+
+		membership := entities.NewBankNetworkMembership(bank.ID, value(record, "network_code"), value(record, "member_code"))
+		if status := value(record, "status"); status != "" {
+			membership.SetStatus(status)
+		}
+
+		from, fromErr := date(value(record, "effective_from"))
+		until, untilErr := date(value(record, "effective_until"))
+		membership.SetEffectivePeriod(from, until)
+
+		if fromErr != nil || untilErr != nil || !membership.IsValid() {
+			bs.logger.WithFields(map[string]interface{}{
+				"row":          i + 2,
+				"bank_code":    bankCode,
+				"network_code": membership.NetworkCode,
+			}).Warn("Invalid bank network membership")
+			errorCount++
+			continue
+		}
+
+		// Check if the bank already has a membership in the network
+		existing, err := bs.membershipRepo.GetByBankAndNetwork(ctx, bank.ID, membership.NetworkCode)
+		if err == nil && existing != nil {
+			membership.ID = existing.ID
+			err = bs.membershipRepo.Update(ctx, membership)
+		} else {
+			err = bs.membershipRepo.Create(ctx, membership)
+		}
+
+		if err != nil {
+			bs.logger.WithError(err).WithFields(map[string]interface{}{
+				"row":          i + 2,
+				"bank_code":    bankCode,
+				"network_code": membership.NetworkCode,
+			}).Warn("Failed to save bank network membership")
+			errorCount++
+			continue
+		}
+
+		successCount++
+	}
+
+	fmt.Printf("✅ Bank network memberships seeding completed: %d successful, %d errors\n", successCount, errorCount)
 	return nil
 }
 
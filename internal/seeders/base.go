@@ -19,6 +19,7 @@ type Seeder interface {
 type SeederManager struct {
 	logger  *logger.Logger
 	seeders map[string]Seeder
+	order   []string
 }
 
 // NewSeederManager creates a new seeder manager
@@ -27,18 +28,38 @@ func NewSeederManager(
 	bankRepo *pgx.BankRepository,
 	currencyRepo *pgx.CurrencyRepository,
 	languageRepo *pgx.LanguageRepository,
+	hierarchySchemaRepo *pgx.HierarchySchemaRepository,
+	geoTypeRepo *pgx.GeoTypeRepository,
+	ibanFormatRepo *pgx.IBANFormatRepository,
+	bankBranchRepo *pgx.BankBranchRepository,
+	bankAccountRuleRepo *pgx.BankAccountRuleRepository,
+	paymentNetworkRepo *pgx.PaymentNetworkRepository,
+	bankNetworkMembershipRepo *pgx.BankNetworkMembershipRepository,
+	countryCurrencyRepo *pgx.CountryCurrencyRepository,
+	localeRepo *pgx.LocaleRepository,
 	logger *logger.Logger,
 ) *SeederManager {
 	seeders := map[string]Seeder{
-		"languages":      NewLanguageSeeder(languageRepo, logger),
-		"banks":          NewBankSeeder(bankRepo, logger),
-		"currencies":     NewCurrencySeeder(currencyRepo, logger),
-		"geodirectories": NewGeodirectorySeeder(geodirectoryRepo, logger),
+		"languages":          NewLanguageSeeder(languageRepo, logger),
+		"banks":              NewBankSeeder(bankRepo, paymentNetworkRepo, bankNetworkMembershipRepo, logger),
+		"currencies":         NewCurrencySeeder(currencyRepo, logger),
+		"geodirectories":     NewGeodirectorySeeder(geodirectoryRepo, hierarchySchemaRepo, geoTypeRepo, logger),
+		"iban-formats":       NewIBANFormatSeeder(ibanFormatRepo, logger),
+		"bank-branches":      NewBankBranchSeeder(bankBranchRepo, bankRepo, geodirectoryRepo, logger),
+		"bank-account-rules": NewBankAccountRuleSeeder(bankAccountRuleRepo, bankRepo, logger),
+		"country-currencies": NewCountryCurrencySeeder(countryCurrencyRepo, geodirectoryRepo, currencyRepo, logger),
+		"locales":            NewLocaleSeeder(localeRepo, languageRepo, geodirectoryRepo, currencyRepo, logger),
 	}
 
+	// Seeding order matters: country currencies reference currencies and countries, locales reference
+	// languages, countries and currencies, bank branches reference banks and geodirectories, account rules
+	// reference banks
+	order := []string{"languages", "currencies", "geodirectories", "country-currencies", "locales", "banks", "bank-branches", "bank-account-rules", "iban-formats"}
+
 	return &SeederManager{
 		logger:  logger,
 		seeders: seeders,
+		order:   order,
 	}
 }
 
@@ -47,7 +68,7 @@ func (sm *SeederManager) Seed(ctx context.Context, dataDir string, name string)
 	if name != "" {
 		seeder, exists := sm.seeders[name]
 		if !exists {
-			return fmt.Errorf("unknown seeder '%s'. Available: languages, banks, currencies, geodirectories", name)
+			return fmt.Errorf("unknown seeder '%s'. Available: languages, banks, bank-branches, bank-account-rules, currencies, country-currencies, geodirectories, iban-formats, locales", name)
 		}
 
 		sm.logger.WithField("seeder", name).Info("Starting specific seeding")
@@ -56,7 +77,8 @@ func (sm *SeederManager) Seed(ctx context.Context, dataDir string, name string)
 
 	// Seed all data types
 	sm.logger.Info("Starting seeding for all data types")
-	for name, seeder := range sm.seeders {
+	for _, name := range sm.order {
+		seeder := sm.seeders[name]
 		sm.logger.WithField("seeder", name).Info("Starting seeding")
 		if err := seeder.Seed(ctx, dataDir); err != nil {
 			return fmt.Errorf("failed to seed %s: %w", name, err)
@@ -72,7 +94,7 @@ func (sm *SeederManager) Clear(ctx context.Context, name string) error {
 	if name != "" {
 		seeder, exists := sm.seeders[name]
 		if !exists {
-			return fmt.Errorf("unknown seeder '%s'. Available: languages, banks, currencies, geodirectories", name)
+			return fmt.Errorf("unknown seeder '%s'. Available: languages, banks, bank-branches, bank-account-rules, currencies, country-currencies, geodirectories, iban-formats, locales", name)
 		}
 
 		sm.logger.WithField("seeder", name).Info("Starting specific clearing using TRUNCATE")
@@ -81,7 +103,9 @@ func (sm *SeederManager) Clear(ctx context.Context, name string) error {
 
 	// Clear all data types using TRUNCATE for efficient bulk deletion
 	sm.logger.Info("Starting clearing for all data types using TRUNCATE")
-	for name, seeder := range sm.seeders {
+	for i := len(sm.order) - 1; i >= 0; i-- {
+		name := sm.order[i]
+		seeder := sm.seeders[name]
 		sm.logger.WithField("seeder", name).Info("Starting clearing with TRUNCATE")
 		if err := seeder.Clear(ctx); err != nil {
 			return fmt.Errorf("failed to clear %s: %w", name, err)
@@ -94,9 +118,7 @@ func (sm *SeederManager) Clear(ctx context.Context, name string) error {
 
 // GetAvailableSeeders returns list of available seeder names
 func (sm *SeederManager) GetAvailableSeeders() []string {
-	names := make([]string, 0, len(sm.seeders))
-	for name := range sm.seeders {
-		names = append(names, name)
-	}
+	names := make([]string, len(sm.order))
+	copy(names, sm.order)
 	return names
 }
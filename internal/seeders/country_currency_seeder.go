@@ -0,0 +1,302 @@
+package seeders
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database/pgx"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/pkg/logger"
+)
+
+// countryCurrencyRecord is a country currency identified by codes as read from the seed file
+type countryCurrencyRecord struct {
+	countryCode    string
+	currencyCode   string
+	legalTender    bool
+	primary        bool
+	effectiveFrom  string
+	effectiveUntil string
+}
+
+// defaultCountryCurrencies are seeded when no country currencies file is present. They cover the euro area
+// with the national currencies the euro replaced, countries using a foreign currency and common currencies.
+var defaultCountryCurrencies = []countryCurrencyRecord{
+	{"ID", "IDR", true, true, "", ""},
+	{"TL", "USD", true, true, "2000-01-24", ""},
+	{"US", "USD", true, true, "", ""},
+	{"EC", "ECS", true, true, "", "2000-09-09"},
+	{"EC", "USD", true, true, "2000-09-09", ""},
+	{"SV", "USD", true, true, "2001-01-01", ""},
+	{"PA", "PAB", true, false, "", ""},
+	{"PA", "USD", true, true, "", ""},
+	{"AT", "ATS", true, true, "", "1999-01-01"},
+	{"AT", "EUR", true, true, "1999-01-01", ""},
+	{"BE", "BEF", true, true, "", "1999-01-01"},
+	{"BE", "EUR", true, true, "1999-01-01", ""},
+	{"DE", "DEM", true, true, "", "1999-01-01"},
+	{"DE", "EUR", true, true, "1999-01-01", ""},
+	{"ES", "ESP", true, true, "", "1999-01-01"},
+	{"ES", "EUR", true, true, "1999-01-01", ""},
+	{"FI", "FIM", true, true, "", "1999-01-01"},
+	{"FI", "EUR", true, true, "1999-01-01", ""},
+	{"FR", "FRF", true, true, "", "1999-01-01"},
+	{"FR", "EUR", true, true, "1999-01-01", ""},
+	{"IE", "IEP", true, true, "", "1999-01-01"},
+	{"IE", "EUR", true, true, "1999-01-01", ""},
+	{"IT", "ITL", true, true, "", "1999-01-01"},
+	{"IT", "EUR", true, true, "1999-01-01", ""},
+	{"LU", "LUF", true, true, "", "1999-01-01"},
+	{"LU", "EUR", true, true, "1999-01-01", ""},
+	{"NL", "NLG", true, true, "", "1999-01-01"},
+	{"NL", "EUR", true, true, "1999-01-01", ""},
+	{"PT", "PTE", true, true, "", "1999-01-01"},
+	{"PT", "EUR", true, true, "1999-01-01", ""},
+	{"GR", "GRD", true, true, "", "2001-01-01"},
+	{"GR", "EUR", true, true, "2001-01-01", ""},
+	{"SI", "SIT", true, true, "", "2007-01-01"},
+	{"SI", "EUR", true, true, "2007-01-01", ""},
+	{"CY", "CYP", true, true, "", "2008-01-01"},
+	{"CY", "EUR", true, true, "2008-01-01", ""},
+	{"MT", "MTL", true, true, "", "2008-01-01"},
+	{"MT", "EUR", true, true, "2008-01-01", ""},
+	{"SK", "SKK", true, true, "", "2009-01-01"},
+	{"SK", "EUR", true, true, "2009-01-01", ""},
+	{"EE", "EEK", true, true, "", "2011-01-01"},
+	{"EE", "EUR", true, true, "2011-01-01", ""},
+	{"LV", "LVL", true, true, "", "2014-01-01"},
+	{"LV", "EUR", true, true, "2014-01-01", ""},
+	{"LT", "LTL", true, true, "", "2015-01-01"},
+	{"LT", "EUR", true, true, "2015-01-01", ""},
+	{"HR", "HRK", true, true, "", "2023-01-01"},
+	{"HR", "EUR", true, true, "2023-01-01", ""},
+	{"AD", "EUR", true, true, "", ""},
+	{"MC", "EUR", true, true, "", ""},
+	{"SM", "EUR", true, true, "", ""},
+	{"VA", "EUR", true, true, "", ""},
+	{"ME", "EUR", true, true, "", ""},
+	{"GB", "GBP", true, true, "", ""},
+	{"CH", "CHF", true, true, "", ""},
+	{"LI", "CHF", true, true, "", ""},
+	{"JP", "JPY", true, true, "", ""},
+	{"CN", "CNY", true, true, "", ""},
+	{"HK", "HKD", true, true, "", ""},
+	{"IN", "INR", true, true, "", ""},
+	{"BT", "BTN", true, true, "", ""},
+	{"BT", "INR", true, false, "", ""},
+	{"SG", "SGD", true, true, "", ""},
+	{"BN", "BND", true, true, "", ""},
+	{"BN", "SGD", true, false, "", ""},
+	{"MY", "MYR", true, true, "", ""},
+	{"TH", "THB", true, true, "", ""},
+	{"PH", "PHP", true, true, "", ""},
+	{"VN", "VND", true, true, "", ""},
+	{"KR", "KRW", true, true, "", ""},
+	{"AU", "AUD", true, true, "", ""},
+	{"NZ", "NZD", true, true, "", ""},
+	{"CA", "CAD", true, true, "", ""},
+	{"MX", "MXN", true, true, "", ""},
+	{"BR", "BRL", true, true, "", ""},
+	{"ZA", "ZAR", true, true, "", ""},
+	{"LS", "LSL", true, true, "", ""},
+	{"LS", "ZAR", true, false, "", ""},
+	{"NA", "NAD", true, true, "", ""},
+	{"NA", "ZAR", true, false, "", ""},
+	{"SA", "SAR", true, true, "", ""},
+	{"AE", "AED", true, true, "", ""},
+}
+
+// CountryCurrencySeeder handles seeding the currencies used in countries
+type CountryCurrencySeeder struct {
+	repo             *pgx.CountryCurrencyRepository
+	geodirectoryRepo *pgx.GeodirectoryRepository
+	currencyRepo     *pgx.CurrencyRepository
+	logger           *logger.Logger
+}
+
+// NewCountryCurrencySeeder creates a new country currency seeder
+func NewCountryCurrencySeeder(repo *pgx.CountryCurrencyRepository, geodirectoryRepo *pgx.GeodirectoryRepository, currencyRepo *pgx.CurrencyRepository, logger *logger.Logger) *CountryCurrencySeeder {
+	return &CountryCurrencySeeder{
+		repo:             repo,
+		geodirectoryRepo: geodirectoryRepo,
+		currencyRepo:     currencyRepo,
+		logger:           logger,
+	}
+}
+
+// Name returns the seeder name
+func (cs *CountryCurrencySeeder) Name() string {
+	return "country-currencies"
+}
+
+// Seed seeds country currencies from the optional tm_country_currencies.csv file, or the built-in country
+// currencies when that file is absent. Columns are located by header name: country_code, currency_code and
+// the optional legal_tender, primary, effective_from and effective_until (YYYY-MM-DD, exclusive).
+// Currencies and countries must be seeded first; rows of unknown countries or currencies are skipped.
+func (cs *CountryCurrencySeeder) Seed(ctx context.Context, dataDir string) error {
+	csvFile := filepath.Join(dataDir, "tm_country_currencies.csv")
+
+	records := defaultCountryCurrencies
+	if _, err := os.Stat(csvFile); os.IsNotExist(err) {
+		cs.logger.Info("Country currencies file not found, seeding built-in country currencies")
+	} else {
+		cs.logger.WithField("file", csvFile).Info("Starting country currencies seeding")
+
+		records, err = readCountryCurrencyCSV(csvFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	successCount := 0
+	errorCount := 0
+
+	fmt.Printf("💱 Processing %d country currency records...\n", len(records))
+
+	for _, record := range records {
+		fields := map[string]interface{}{
+			"country_code":  record.countryCode,
+			"currency_code": record.currencyCode,
+		}
+
+		country, err := cs.geodirectoryRepo.GetCountryByCode(ctx, record.countryCode)
+		if err != nil {
+			cs.logger.WithError(err).WithFields(fields).Warn("Country of country currency not found")
+			errorCount++
+			continue
+		}
+
+		currency, err := cs.currencyRepo.GetByCode(ctx, record.currencyCode)
+		if err != nil {
+			cs.logger.WithError(err).WithFields(fields).Warn("Currency of country currency not found")
+			errorCount++
+			continue
+		}
+
+		effectiveFrom, fromErr := parseOptionalDate(record.effectiveFrom)
+		effectiveUntil, untilErr := parseOptionalDate(record.effectiveUntil)
+
+		countryCurrency := entities.NewCountryCurrency(country.ID, currency.ID, record.legalTender, record.primary)
+		countryCurrency.SetEffectivePeriod(effectiveFrom, effectiveUntil)
+
+		if fromErr != nil || untilErr != nil || !countryCurrency.IsValid() {
+			cs.logger.WithFields(fields).Warn("Invalid country currency")
+			errorCount++
+			continue
+		}
+
+		// Check if the country already uses the currency
+		existing, err := cs.repo.GetByCountryAndCurrency(ctx, country.ID, currency.ID)
+		if err == nil && existing != nil {
+			countryCurrency.ID = existing.ID
+			err = cs.repo.Update(ctx, countryCurrency)
+		} else {
+			err = cs.repo.Create(ctx, countryCurrency)
+		}
+
+		if err != nil {
+			cs.logger.WithError(err).WithFields(fields).Warn("Failed to save country currency")
+			errorCount++
+			continue
+		}
+
+		successCount++
+	}
+
+	cs.logger.WithFields(map[string]interface{}{
+		"total_processed": len(records),
+		"successful":      successCount,
+		"errors":          errorCount,
+	}).Info("Country currencies seeding completed")
+
+	fmt.Printf("✅ Country currencies seeding completed: %d successful, %d errors\n", successCount, errorCount)
+	return nil
+}
+
+// Clear removes all country currency data
+func (cs *CountryCurrencySeeder) Clear(ctx context.Context) error {
+	cs.logger.Info("Clearing country currency data using TRUNCATE")
+
+	if err := cs.repo.Truncate(ctx); err != nil {
+		return fmt.Errorf("failed to truncate country currencies table: %w", err)
+	}
+
+	cs.logger.Info("Country currencies table truncated successfully")
+	return nil
+}
+
+// readCountryCurrencyCSV reads the country currencies CSV file. Currencies are legal tender unless the
+// legal_tender column says otherwise.
+func readCountryCurrencyCSV(csvFile string) ([]countryCurrencyRecord, error) {
+	file, err := os.Open(csvFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open country currencies CSV file: %w", err)
+	}
+	defer file.Close()
+
+	rows, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read country currencies CSV: %w", err)
+	}
+
+	if len(rows) < 2 {
+		return nil, fmt.Errorf("country currencies CSV file must contain at least a header and one data row")
+	}
+
+	columns := make(map[string]int)
+	for i, column := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range []string{"country_code", "currency_code"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("country currencies CSV file is missing the %s column", column)
+		}
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	records := make([]countryCurrencyRecord, 0, len(rows)-1)
+	for _, row := range rows[1:] { // Skip header
+		legalTender := true
+		if raw := value(row, "legal_tender"); raw != "" {
+			legalTender, _ = strconv.ParseBool(raw)
+		}
+		primary, _ := strconv.ParseBool(value(row, "primary"))
+
+		records = append(records, countryCurrencyRecord{
+			countryCode:    strings.ToUpper(value(row, "country_code")),
+			currencyCode:   strings.ToUpper(value(row, "currency_code")),
+			legalTender:    legalTender,
+			primary:        primary,
+			effectiveFrom:  value(row, "effective_from"),
+			effectiveUntil: value(row, "effective_until"),
+		})
+	}
+
+	return records, nil
+}
+
+// parseOptionalDate parses a YYYY-MM-DD date, returning nil for an empty value
+func parseOptionalDate(raw string) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
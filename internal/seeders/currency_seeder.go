@@ -6,13 +6,32 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database/pgx"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 	"github.com/turahe/master-data-rest-api/pkg/logger"
 )
 
+// defaultCashRoundingIncrements are the cash rounding increments applied to currencies whose row in
+// tm_currencies.csv has none: currencies whose smallest coins were withdrawn and, for the rupiah, the
+// rounding applied in practice to cash payments
+var defaultCashRoundingIncrements = map[string]string{
+	"AUD": "0.05",
+	"CAD": "0.05",
+	"CHF": "0.05",
+	"CZK": "1",
+	"DKK": "0.5",
+	"HUF": "5",
+	"IDR": "100",
+	"NOK": "1",
+	"NZD": "0.1",
+	"SEK": "1",
+}
+
 // CurrencySeeder handles seeding currency data
 type CurrencySeeder struct {
 	repo   *pgx.CurrencyRepository
@@ -32,27 +51,27 @@ func (cs *CurrencySeeder) Name() string {
 	return "currencies"
 }
 
-// Seed seeds currency data from CSV file
+// Seed seeds the active currencies from tm_currencies.csv and the withdrawn currencies from
+// tm_currencies_historical.csv, or the built-in withdrawn currencies when that file is absent
 func (cs *CurrencySeeder) Seed(ctx context.Context, dataDir string) error {
+	if err := cs.seedActive(ctx, dataDir); err != nil {
+		return err
+	}
+
+	return cs.seedWithdrawn(ctx, dataDir)
+}
+
+// seedActive seeds the active currencies. Columns are located by header name: iso_code, name and the
+// optional symbol, subunit_to_unit (minor units, e.g. 100 for 2 decimal places), iso_numeric and the cash
+// rounding columns cash_rounding_increment (in major units, e.g. 0.05) or smallest_denomination (in minor
+// units, e.g. 5) and cash_rounding_mode (half_up, half_even, half_down, up, down, ceiling or floor).
+func (cs *CurrencySeeder) seedActive(ctx context.Context, dataDir string) error {
 	csvFile := filepath.Join(dataDir, "tm_currencies.csv")
 	cs.logger.WithField("file", csvFile).Info("Starting currencies seeding")
 
-	// Open CSV file
-	file, err := os.Open(csvFile)
+	records, value, err := readCurrencyCSV(csvFile, "iso_code", "name")
 	if err != nil {
-		return fmt.Errorf("failed to open currencies CSV file: %w", err)
-	}
-	defer file.Close()
-
-	// Create CSV reader
-	reader := csv.NewReader(file)
-	records, err := reader.ReadAll()
-	if err != nil {
-		return fmt.Errorf("failed to read currencies CSV: %w", err)
-	}
-
-	if len(records) < 2 {
-		return fmt.Errorf("currencies CSV file must contain at least a header and one data row")
+		return err
 	}
 
 	// Skip header and process records
@@ -62,49 +81,42 @@ func (cs *CurrencySeeder) Seed(ctx context.Context, dataDir string) error {
 	fmt.Printf("💰 Processing %d currency records...\n", len(records)-1)
 
 	for i, record := range records[1:] { // Skip header
-		if len(record) < 3 {
-			cs.logger.WithField("row", i+2).Warn("Currency record has insufficient columns")
+		isoCode := strings.ToUpper(value(record, "iso_code"))
+		name := value(record, "name")
+
+		if isoCode == "" || name == "" {
+			cs.logger.WithField("row", i+2).Warn("Currency record is missing iso code or name")
 			errorCount++
 			continue
 		}
 
-		// Parse CSV fields: priority,iso_code,name,symbol,disambiguate_symbol,alternate_symbols,subunit,subunit_to_unit,symbol_first,html_entity,decimal_mark,thousands_separator,iso_numeric,smallest_denomination
-		isoCode := strings.TrimSpace(record[1])
-		name := strings.TrimSpace(record[2])
-		_ = strings.TrimSpace(record[3]) // symbol - not used in constructor
-
-		// Create currency entity (using iso_code as the code field, default 2 decimal places)
-		currency := entities.NewCurrency(name, isoCode, 2)
-
-		// Check if currency already exists by code
-		existing, err := cs.repo.GetByCode(ctx, isoCode)
-		if err == nil && existing != nil {
-			// Update existing currency
-			existing.SetName(name)
-			// Note: Symbol and decimal places cannot be updated via setters
-
-			err = cs.repo.Update(ctx, existing)
-			if err != nil {
-				cs.logger.WithError(err).WithFields(map[string]interface{}{
-					"row":      i + 2,
-					"iso_code": isoCode,
-					"name":     name,
-				}).Warn("Failed to update existing currency")
-				errorCount++
-				continue
-			}
+		currency := entities.NewCurrency(name, isoCode, decimalPlacesFromSubunits(value(record, "subunit_to_unit")))
+		if symbol := value(record, "symbol"); symbol != "" {
+			currency.SetSymbol(symbol)
+		}
+		if numericCode := padNumericCode(value(record, "iso_numeric")); numericCode != "" {
+			currency.SetNumericCode(numericCode)
+		}
+
+		increment, mode, err := cashRounding(isoCode, value(record, "cash_rounding_increment"),
+			value(record, "smallest_denomination"), value(record, "subunit_to_unit"), value(record, "cash_rounding_mode"))
+		if err != nil {
+			cs.logger.WithError(err).WithFields(map[string]interface{}{
+				"row":      i + 2,
+				"iso_code": isoCode,
+			}).Warn("Ignoring invalid cash rounding of currency")
 		} else {
-			// Create new currency
-			err = cs.repo.Create(ctx, currency)
-			if err != nil {
-				cs.logger.WithError(err).WithFields(map[string]interface{}{
-					"row":      i + 2,
-					"iso_code": isoCode,
-					"name":     name,
-				}).Warn("Failed to create currency")
-				errorCount++
-				continue
-			}
+			currency.SetCashRounding(increment, mode)
+		}
+
+		if err := cs.save(ctx, currency); err != nil {
+			cs.logger.WithError(err).WithFields(map[string]interface{}{
+				"row":      i + 2,
+				"iso_code": isoCode,
+				"name":     name,
+			}).Warn("Failed to save currency")
+			errorCount++
+			continue
 		}
 
 		successCount++
@@ -130,6 +142,98 @@ func (cs *CurrencySeeder) Seed(ctx context.Context, dataDir string) error {
 	return nil
 }
 
+// seedWithdrawn seeds the withdrawn currencies of ISO 4217 list three. Columns are located by header name:
+// code, name, withdrawn_at (YYYY-MM or YYYY-MM-DD) and the optional numeric_code, decimal_places and replaced_by.
+func (cs *CurrencySeeder) seedWithdrawn(ctx context.Context, dataDir string) error {
+	csvFile := filepath.Join(dataDir, "tm_currencies_historical.csv")
+
+	var currencies []*entities.Currency
+	errorCount := 0
+
+	if _, err := os.Stat(csvFile); os.IsNotExist(err) {
+		cs.logger.Info("Historical currencies file not found, seeding built-in withdrawn currencies")
+		currencies = entities.DefaultWithdrawnCurrencies()
+	} else {
+		cs.logger.WithField("file", csvFile).Info("Starting historical currencies seeding")
+
+		records, value, err := readCurrencyCSV(csvFile, "code", "name", "withdrawn_at")
+		if err != nil {
+			return err
+		}
+
+		for i, record := range records[1:] { // Skip header
+			code := strings.ToUpper(value(record, "code"))
+			name := value(record, "name")
+			withdrawnAt, err := parseWithdrawalDate(value(record, "withdrawn_at"))
+			if code == "" || name == "" || err != nil {
+				cs.logger.WithError(err).WithField("row", i+2).Warn("Historical currency record is missing code or name, or has an invalid withdrawal date")
+				errorCount++
+				continue
+			}
+
+			decimalPlaces := 2
+			if raw := value(record, "decimal_places"); raw != "" {
+				if parsed, err := strconv.Atoi(raw); err == nil && parsed >= 0 {
+					decimalPlaces = parsed
+				}
+			}
+
+			currency := entities.NewCurrency(name, code, decimalPlaces)
+			if numericCode := padNumericCode(value(record, "numeric_code")); numericCode != "" {
+				currency.SetNumericCode(numericCode)
+			}
+
+			var replacedBy *string
+			if raw := strings.ToUpper(value(record, "replaced_by")); raw != "" {
+				replacedBy = &raw
+			}
+			currency.Withdraw(withdrawnAt, replacedBy)
+
+			currencies = append(currencies, currency)
+		}
+	}
+
+	successCount := 0
+
+	fmt.Printf("🏛️ Processing %d withdrawn currency records...\n", len(currencies))
+
+	for _, currency := range currencies {
+		if !currency.IsValid() {
+			cs.logger.WithField("code", currency.Code).Warn("Invalid withdrawn currency")
+			errorCount++
+			continue
+		}
+
+		if err := cs.save(ctx, currency); err != nil {
+			cs.logger.WithError(err).WithField("code", currency.Code).Warn("Failed to save withdrawn currency")
+			errorCount++
+			continue
+		}
+
+		successCount++
+	}
+
+	cs.logger.WithFields(map[string]interface{}{
+		"successful": successCount,
+		"errors":     errorCount,
+	}).Info("Historical currencies seeding completed")
+
+	fmt.Printf("✅ Historical currencies seeding completed: %d successful, %d errors\n", successCount, errorCount)
+	return nil
+}
+
+// save creates the currency or updates the existing currency with the same code
+func (cs *CurrencySeeder) save(ctx context.Context, currency *entities.Currency) error {
+	existing, err := cs.repo.GetByCode(ctx, currency.Code)
+	if err == nil && existing != nil {
+		currency.ID = existing.ID
+		currency.CreatedAt = existing.CreatedAt
+		return cs.repo.Update(ctx, currency)
+	}
+
+	return cs.repo.Create(ctx, currency)
+}
+
 // Clear removes all currency data
 func (cs *CurrencySeeder) Clear(ctx context.Context) error {
 	cs.logger.Info("Clearing currency data using TRUNCATE")
@@ -141,3 +245,119 @@ func (cs *CurrencySeeder) Clear(ctx context.Context) error {
 	cs.logger.Info("Currencies table truncated successfully")
 	return nil
 }
+
+// readCurrencyCSV reads a currency CSV file and returns its records with a lookup of values by column name
+func readCurrencyCSV(csvFile string, required ...string) ([][]string, func(record []string, column string) string, error) {
+	file, err := os.Open(csvFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open currencies CSV file: %w", err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read currencies CSV: %w", err)
+	}
+
+	if len(records) < 2 {
+		return nil, nil, fmt.Errorf("currencies CSV file must contain at least a header and one data row")
+	}
+
+	columns := make(map[string]int)
+	for i, column := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range required {
+		if _, ok := columns[column]; !ok {
+			return nil, nil, fmt.Errorf("currencies CSV file %s is missing the %s column", filepath.Base(csvFile), column)
+		}
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	return records, value, nil
+}
+
+// decimalPlacesFromSubunits converts the number of minor units per unit (1, 10, 100, 1000) into decimal
+// places. Other values such as 5 for the Ouguiya and the Ariary fall back to the ISO 4217 default of 2.
+func decimalPlacesFromSubunits(raw string) int {
+	subunits, err := strconv.Atoi(raw)
+	if err != nil || subunits <= 0 {
+		return 2
+	}
+
+	places := 0
+	for subunits%10 == 0 {
+		subunits /= 10
+		places++
+	}
+	if subunits != 1 {
+		return 2
+	}
+	return places
+}
+
+// cashRounding determines the cash rounding increment and mode of a currency from an increment in major
+// units or a smallest denomination in minor units, falling back to defaultCashRoundingIncrements. A smallest
+// denomination of one minor unit means cash is not rounded further.
+func cashRounding(code, rawIncrement, rawDenomination, rawSubunits, rawMode string) (*valueobjects.Decimal, valueobjects.RoundingMode, error) {
+	mode, err := valueobjects.ParseRoundingMode(rawMode)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if rawIncrement == "" && rawDenomination == "" {
+		rawIncrement = defaultCashRoundingIncrements[code]
+	}
+
+	var increment valueobjects.Decimal
+	switch {
+	case rawIncrement != "":
+		increment, err = valueobjects.NewDecimal(rawIncrement)
+		if err != nil {
+			return nil, "", err
+		}
+	case rawDenomination != "":
+		denomination, err := valueobjects.NewDecimal(rawDenomination)
+		if err != nil {
+			return nil, "", err
+		}
+		if denomination.Cmp(valueobjects.NewDecimalFromInt(1)) == 0 {
+			return nil, mode, nil
+		}
+		subunits, err := strconv.Atoi(rawSubunits)
+		if err != nil || subunits <= 0 {
+			subunits = 1
+		}
+		increment, _ = denomination.Div(valueobjects.NewDecimalFromInt(int64(subunits)))
+	default:
+		return nil, mode, nil
+	}
+
+	if increment.Sign() <= 0 {
+		return nil, "", fmt.Errorf("cash rounding increment must be positive")
+	}
+	return &increment, mode, nil
+}
+
+// padNumericCode left-pads an ISO 4217 numeric code to 3 digits, returning "" when it is not numeric
+func padNumericCode(raw string) string {
+	if raw == "" || len(raw) > 3 || strings.Trim(raw, "0123456789") != "" {
+		return ""
+	}
+	return strings.Repeat("0", 3-len(raw)) + raw
+}
+
+// parseWithdrawalDate parses a withdrawal date given as YYYY-MM-DD or, as in ISO 4217 list three, YYYY-MM
+func parseWithdrawalDate(raw string) (time.Time, error) {
+	if parsed, err := time.Parse("2006-01-02", raw); err == nil {
+		return parsed, nil
+	}
+	return time.Parse("2006-01", raw)
+}
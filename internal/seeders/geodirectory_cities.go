@@ -77,6 +77,16 @@ func (gs *GeodirectorySeeder) seedCities(ctx context.Context, geoDir string) err
 				geoType = entities.GeoTypeCity
 			}
 
+			if !gs.allowsParent(geoType, parent.Type) {
+				gs.logger.WithFields(map[string]interface{}{
+					"code":        fullCode,
+					"type":        geoType,
+					"parent_type": parent.Type,
+				}).Warn("Skipping city/regency not allowed by country hierarchy schema")
+				errorCount++
+				continue
+			}
+
 			if existing != nil {
 				// Update existing city/regency
 				existing.Name = name
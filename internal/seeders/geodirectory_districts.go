@@ -44,6 +44,14 @@ func (gs *GeodirectorySeeder) seedDistricts(ctx context.Context, geoDir string)
 			continue
 		}
 
+		if !gs.allowsParent(entities.GeoTypeDistrict, parent.Type) {
+			gs.logger.WithFields(map[string]interface{}{
+				"parent_code": parentCityCode,
+				"parent_type": parent.Type,
+			}).Warn("Skipping districts not allowed under parent by country hierarchy schema")
+			continue
+		}
+
 		file, err := os.Open(filename)
 		if err != nil {
 			gs.logger.WithError(err).WithField("file", filename).Warn("Failed to open district file")
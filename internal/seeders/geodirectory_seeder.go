@@ -7,25 +7,35 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 
 	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database/pgx"
 	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
 	"github.com/turahe/master-data-rest-api/pkg/logger"
 )
 
 // GeodirectorySeeder handles seeding geodirectory data
 type GeodirectorySeeder struct {
-	repo   *pgx.GeodirectoryRepository
-	logger *logger.Logger
+	repo        *pgx.GeodirectoryRepository
+	schemaRepo  *pgx.HierarchySchemaRepository
+	geoTypeRepo *pgx.GeoTypeRepository
+	schema      *entities.HierarchySchema
+	logger      *logger.Logger
 }
 
 // NewGeodirectorySeeder creates a new geodirectory seeder
-func NewGeodirectorySeeder(repo *pgx.GeodirectoryRepository, logger *logger.Logger) *GeodirectorySeeder {
+func NewGeodirectorySeeder(
+	repo *pgx.GeodirectoryRepository,
+	schemaRepo *pgx.HierarchySchemaRepository,
+	geoTypeRepo *pgx.GeoTypeRepository,
+	logger *logger.Logger,
+) *GeodirectorySeeder {
 	return &GeodirectorySeeder{
-		repo:   repo,
-		logger: logger,
+		repo:        repo,
+		schemaRepo:  schemaRepo,
+		geoTypeRepo: geoTypeRepo,
+		logger:      logger,
 	}
 }
 
@@ -39,6 +49,22 @@ func (gs *GeodirectorySeeder) Seed(ctx context.Context, dataDir string) error {
 	geoDir := filepath.Join(dataDir, "geodirectories")
 	gs.logger.WithField("directory", geoDir).Info("Starting geodirectories seeding")
 
+	// Load the geo types registry so custom types can be used in hierarchy schemas
+	geoTypes, err := gs.geoTypeRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load geo types: %w", err)
+	}
+	entities.LoadGeoTypes(geoTypes)
+
+	// Load per-country hierarchy schemas first so imported nodes can be validated against them
+	if err := gs.seedHierarchySchemas(ctx, geoDir); err != nil {
+		return fmt.Errorf("failed to seed hierarchy schemas: %w", err)
+	}
+
+	if err := gs.loadHierarchySchema(ctx, indonesiaCountryCode); err != nil {
+		return fmt.Errorf("failed to load hierarchy schema: %w", err)
+	}
+
 	// Seed in hierarchical order: countries -> provinces -> cities -> districts -> villages
 	if err := gs.seedCountries(ctx, geoDir); err != nil {
 		return fmt.Errorf("failed to seed countries: %w", err)
@@ -64,6 +90,66 @@ func (gs *GeodirectorySeeder) Seed(ctx context.Context, dataDir string) error {
 	return nil
 }
 
+// seedHierarchySchemas seeds per-country hierarchy rules from the optional hierarchy_schemas.json file.
+// The file maps a country code to child types and their allowed parent types, e.g.
+// {"ID": {"PROVINCE": ["COUNTRY"], "REGENCY": ["PROVINCE"]}}
+func (gs *GeodirectorySeeder) seedHierarchySchemas(ctx context.Context, geoDir string) error {
+	schemaFile := filepath.Join(geoDir, "hierarchy_schemas.json")
+
+	file, err := os.Open(schemaFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			gs.logger.WithField("file", schemaFile).Info("No hierarchy schema file found, using default hierarchy")
+			return nil
+		}
+		return fmt.Errorf("failed to open hierarchy schema file: %w", err)
+	}
+	defer file.Close()
+
+	gs.logger.WithField("file", schemaFile).Info("Seeding hierarchy schemas")
+
+	var schemas map[string]map[entities.GeoType][]entities.GeoType
+	if err := json.NewDecoder(file).Decode(&schemas); err != nil {
+		return fmt.Errorf("failed to decode hierarchy schema JSON: %w", err)
+	}
+
+	for countryCode, parents := range schemas {
+		var rules []*entities.HierarchyRule
+		for childType, parentTypes := range parents {
+			for _, parentType := range parentTypes {
+				childNode := &entities.Geodirectory{Type: childType}
+				parentNode := &entities.Geodirectory{Type: parentType}
+				if !childNode.ValidateType() || !parentNode.ValidateType() {
+					return fmt.Errorf("invalid hierarchy rule for country %s: %s under %s", countryCode, childType, parentType)
+				}
+				rules = append(rules, entities.NewHierarchyRule(countryCode, parentType, childType))
+			}
+		}
+
+		if err := gs.schemaRepo.ReplaceRules(ctx, countryCode, rules); err != nil {
+			return fmt.Errorf("failed to store hierarchy rules for country %s: %w", countryCode, err)
+		}
+
+		gs.logger.WithFields(map[string]interface{}{
+			"country_code": countryCode,
+			"rules":        len(rules),
+		}).Info("Hierarchy schema seeded")
+	}
+
+	return nil
+}
+
+// loadHierarchySchema loads the schema used to validate imported nodes of a country
+func (gs *GeodirectorySeeder) loadHierarchySchema(ctx context.Context, countryCode string) error {
+	rules, err := gs.schemaRepo.GetRulesByCountry(ctx, countryCode)
+	if err != nil {
+		return err
+	}
+
+	gs.schema = entities.NewHierarchySchema(countryCode, rules)
+	return nil
+}
+
 // seedCountries seeds country data from countries.csv
 func (gs *GeodirectorySeeder) seedCountries(ctx context.Context, geoDir string) error {
 	countryFile := filepath.Join(geoDir, "countries.csv")
@@ -110,15 +196,13 @@ func (gs *GeodirectorySeeder) seedCountries(ctx context.Context, geoDir string)
 		name := strings.TrimSpace(record[3])
 
 		// Parse coordinates if provided
-		var latitude, longitude *float64
-		if latStr != "" {
-			if lat, err := strconv.ParseFloat(latStr, 64); err == nil {
-				latitude = &lat
-			}
-		}
-		if lonStr != "" {
-			if lon, err := strconv.ParseFloat(lonStr, 64); err == nil {
-				longitude = &lon
+		var coordinates *valueobjects.Coordinates
+		if latStr != "" || lonStr != "" {
+			parsed, parseErr := valueobjects.ParseCoordinates(latStr, lonStr)
+			if parseErr != nil {
+				gs.logger.WithError(parseErr).WithField("code", code).Warn("Ignoring invalid country coordinates")
+			} else {
+				coordinates = parsed
 			}
 		}
 
@@ -133,8 +217,8 @@ func (gs *GeodirectorySeeder) seedCountries(ctx context.Context, geoDir string)
 		if existing != nil {
 			// Update existing country
 			existing.Name = name
-			if latitude != nil && longitude != nil {
-				existing.SetCoordinates(strconv.FormatFloat(*latitude, 'f', -1, 64), strconv.FormatFloat(*longitude, 'f', -1, 64))
+			if coordinates != nil {
+				existing.SetCoordinates(coordinates)
 			}
 			existing.SetDepth(2) // Countries are depth 2
 			existing.SetOrderingID(orderingCounter)
@@ -148,8 +232,8 @@ func (gs *GeodirectorySeeder) seedCountries(ctx context.Context, geoDir string)
 			// Create new country
 			country := entities.NewGeodirectory(name, entities.GeoTypeCountry)
 			country.SetCode(code)
-			if latitude != nil && longitude != nil {
-				country.SetCoordinates(strconv.FormatFloat(*latitude, 'f', -1, 64), strconv.FormatFloat(*longitude, 'f', -1, 64))
+			if coordinates != nil {
+				country.SetCoordinates(coordinates)
 			}
 			country.SetDepth(2) // Countries are depth 2
 			country.SetOrderingID(orderingCounter)
@@ -189,9 +273,13 @@ func (gs *GeodirectorySeeder) seedProvinces(ctx context.Context, geoDir string)
 	gs.logger.WithField("file", provinceFile).Info("Seeding provinces")
 
 	// First, find Indonesia country to set as parent
-	indonesia, err := gs.repo.GetByCode(ctx, "ID")
+	indonesia, err := gs.repo.GetByCode(ctx, indonesiaCountryCode)
 	if err != nil {
-		return fmt.Errorf("failed to get Indonesia country (code: ID): %w", err)
+		return fmt.Errorf("failed to get Indonesia country (code: %s): %w", indonesiaCountryCode, err)
+	}
+
+	if !gs.allowsParent(entities.GeoTypeProvince, indonesia.Type) {
+		return fmt.Errorf("hierarchy schema of country %s does not allow %s under %s", indonesiaCountryCode, entities.GeoTypeProvince, indonesia.Type)
 	}
 
 	file, err := os.Open(provinceFile)
@@ -275,6 +363,10 @@ func (gs *GeodirectorySeeder) Clear(ctx context.Context) error {
 		return fmt.Errorf("failed to truncate geodirectories table: %w", err)
 	}
 
-	gs.logger.Info("Geodirectories table truncated successfully")
+	if err := gs.schemaRepo.Truncate(ctx); err != nil {
+		return fmt.Errorf("failed to truncate geo hierarchy rules table: %w", err)
+	}
+
+	gs.logger.Info("Geodirectories and hierarchy rules tables truncated successfully")
 	return nil
 }
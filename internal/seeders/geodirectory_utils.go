@@ -2,9 +2,23 @@ package seeders
 
 import (
 	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
 )
 
+// indonesiaCountryCode is the code of the country the bundled administrative data belongs to
+const indonesiaCountryCode = "ID"
+
 // isNotFoundError checks if the error is a "not found" error
 func isNotFoundError(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "not found")
 }
+
+// allowsParent checks an imported node against the loaded hierarchy schema, falling back to the default schema
+func (gs *GeodirectorySeeder) allowsParent(childType, parentType entities.GeoType) bool {
+	schema := gs.schema
+	if schema == nil {
+		schema = entities.DefaultHierarchySchema()
+	}
+	return schema.AllowsParent(childType, parentType)
+}
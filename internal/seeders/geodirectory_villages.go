@@ -47,6 +47,14 @@ func (gs *GeodirectorySeeder) seedVillages(ctx context.Context, geoDir string) e
 			continue
 		}
 
+		if !gs.allowsParent(entities.GeoTypeVillage, parent.Type) {
+			gs.logger.WithFields(map[string]interface{}{
+				"parent_code": parentDistrictCode,
+				"parent_type": parent.Type,
+			}).Warn("Skipping villages not allowed under parent by country hierarchy schema")
+			continue
+		}
+
 		file, err := os.Open(filename)
 		if err != nil {
 			gs.logger.WithError(err).WithField("file", filename).Warn("Failed to open village file")
@@ -0,0 +1,160 @@
+package seeders
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database/pgx"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/pkg/logger"
+)
+
+// IBANFormatSeeder handles seeding IBAN format data
+type IBANFormatSeeder struct {
+	repo   *pgx.IBANFormatRepository
+	logger *logger.Logger
+}
+
+// NewIBANFormatSeeder creates a new IBAN format seeder
+func NewIBANFormatSeeder(repo *pgx.IBANFormatRepository, logger *logger.Logger) *IBANFormatSeeder {
+	return &IBANFormatSeeder{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Name returns the seeder name
+func (is *IBANFormatSeeder) Name() string {
+	return "iban-formats"
+}
+
+// Seed seeds IBAN formats from the tm_iban_formats.csv file, or the built-in formats when the file does not exist.
+// Expected columns: country_code, length, bban_structure, bank_id_offset, bank_id_length,
+// branch_id_offset, branch_id_length, is_sepa, example
+func (is *IBANFormatSeeder) Seed(ctx context.Context, dataDir string) error {
+	csvFile := filepath.Join(dataDir, "tm_iban_formats.csv")
+	is.logger.WithField("file", csvFile).Info("Starting IBAN formats seeding")
+
+	formats, err := is.loadFormats(csvFile)
+	if err != nil {
+		return err
+	}
+
+	successCount := 0
+	errorCount := 0
+
+	fmt.Printf("💳 Processing %d IBAN formats...\n", len(formats))
+
+	for _, format := range formats {
+		if !format.IsValid() {
+			is.logger.WithField("country_code", format.CountryCode).Warn("Skipping invalid IBAN format")
+			errorCount++
+			continue
+		}
+
+		// Check if the country already has a format
+		existing, err := is.repo.GetByCountryCode(ctx, format.CountryCode)
+		if err == nil && existing != nil {
+			format.ID = existing.ID
+			err = is.repo.Update(ctx, format)
+		} else {
+			err = is.repo.Create(ctx, format)
+		}
+
+		if err != nil {
+			is.logger.WithError(err).WithField("country_code", format.CountryCode).Warn("Failed to save IBAN format")
+			errorCount++
+			continue
+		}
+
+		successCount++
+	}
+
+	is.logger.WithFields(map[string]interface{}{
+		"total_processed": len(formats),
+		"successful":      successCount,
+		"errors":          errorCount,
+	}).Info("IBAN formats seeding completed")
+
+	fmt.Printf("✅ IBAN formats seeding completed: %d successful, %d errors\n", successCount, errorCount)
+	return nil
+}
+
+// loadFormats reads IBAN formats from the CSV file, falling back to the built-in formats
+func (is *IBANFormatSeeder) loadFormats(csvFile string) ([]*entities.IBANFormat, error) {
+	file, err := os.Open(csvFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			is.logger.Info("IBAN formats file not found, seeding built-in IBAN formats")
+			return entities.DefaultIBANFormats(), nil
+		}
+		return nil, fmt.Errorf("failed to open IBAN formats CSV file: %w", err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read IBAN formats CSV: %w", err)
+	}
+
+	if len(records) < 2 {
+		return nil, fmt.Errorf("IBAN formats CSV file must contain at least a header and one data row")
+	}
+
+	var formats []*entities.IBANFormat
+	for i, record := range records[1:] { // Skip header
+		format, err := parseIBANFormatRecord(record)
+		if err != nil {
+			is.logger.WithError(err).WithField("row", i+2).Warn("Skipping invalid IBAN format record")
+			continue
+		}
+		formats = append(formats, format)
+	}
+
+	return formats, nil
+}
+
+// parseIBANFormatRecord converts a CSV record into an IBAN format
+func parseIBANFormatRecord(record []string) (*entities.IBANFormat, error) {
+	if len(record) < 9 {
+		return nil, fmt.Errorf("IBAN format record has insufficient columns")
+	}
+
+	numbers := make([]int, 0, 5)
+	for _, column := range []int{1, 3, 4, 5, 6} {
+		value, err := strconv.Atoi(strings.TrimSpace(record[column]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid number in column %d: %s", column+1, record[column])
+		}
+		numbers = append(numbers, value)
+	}
+
+	isSEPA, err := strconv.ParseBool(strings.TrimSpace(record[7]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid is_sepa value: %s", record[7])
+	}
+
+	format := entities.NewIBANFormat(strings.TrimSpace(record[0]), numbers[0], strings.TrimSpace(record[2]), numbers[1], numbers[2])
+	format.SetBranchIdentifier(numbers[3], numbers[4])
+	format.SetSEPA(isSEPA)
+	format.SetExample(strings.TrimSpace(record[8]))
+
+	return format, nil
+}
+
+// Clear removes all IBAN format data
+func (is *IBANFormatSeeder) Clear(ctx context.Context) error {
+	is.logger.Info("Clearing IBAN format data using TRUNCATE")
+
+	if err := is.repo.Truncate(ctx); err != nil {
+		return fmt.Errorf("failed to truncate IBAN formats table: %w", err)
+	}
+
+	is.logger.Info("IBAN formats table truncated successfully")
+	return nil
+}
@@ -13,6 +13,74 @@ import (
 	"github.com/turahe/master-data-rest-api/pkg/logger"
 )
 
+// languageCodes holds the ISO 639-2/T, ISO 639-2/B and ISO 639-3 codes and the default ISO 15924 script of a language
+type languageCodes struct {
+	iso6392T string
+	iso6392B string
+	iso6393  string
+	script   string
+}
+
+// defaultLanguageCodes fill in the ISO codes and scripts of common languages, keyed by ISO 639-1 code, when the
+// languages file does not provide them
+var defaultLanguageCodes = map[string]languageCodes{
+	"ar": {"ara", "ara", "ara", "Arab"},
+	"bn": {"ben", "ben", "ben", "Beng"},
+	"bo": {"bod", "tib", "bod", "Tibt"},
+	"bs": {"bos", "bos", "bos", "Latn"},
+	"cs": {"ces", "cze", "ces", "Latn"},
+	"cy": {"cym", "wel", "cym", "Latn"},
+	"da": {"dan", "dan", "dan", "Latn"},
+	"de": {"deu", "ger", "deu", "Latn"},
+	"dv": {"div", "div", "div", "Thaa"},
+	"el": {"ell", "gre", "ell", "Grek"},
+	"en": {"eng", "eng", "eng", "Latn"},
+	"es": {"spa", "spa", "spa", "Latn"},
+	"eu": {"eus", "baq", "eus", "Latn"},
+	"fa": {"fas", "per", "fas", "Arab"},
+	"fi": {"fin", "fin", "fin", "Latn"},
+	"fr": {"fra", "fre", "fra", "Latn"},
+	"he": {"heb", "heb", "heb", "Hebr"},
+	"hi": {"hin", "hin", "hin", "Deva"},
+	"hr": {"hrv", "hrv", "hrv", "Latn"},
+	"hu": {"hun", "hun", "hun", "Latn"},
+	"hy": {"hye", "arm", "hye", "Armn"},
+	"id": {"ind", "ind", "ind", "Latn"},
+	"is": {"isl", "ice", "isl", "Latn"},
+	"it": {"ita", "ita", "ita", "Latn"},
+	"ja": {"jpn", "jpn", "jpn", "Jpan"},
+	"jv": {"jav", "jav", "jav", "Latn"},
+	"ka": {"kat", "geo", "kat", "Geor"},
+	"km": {"khm", "khm", "khm", "Khmr"},
+	"ko": {"kor", "kor", "kor", "Kore"},
+	"mi": {"mri", "mao", "mri", "Latn"},
+	"mk": {"mkd", "mac", "mkd", "Cyrl"},
+	"ms": {"msa", "may", "msa", "Latn"},
+	"my": {"mya", "bur", "mya", "Mymr"},
+	"nl": {"nld", "dut", "nld", "Latn"},
+	"no": {"nor", "nor", "nor", "Latn"},
+	"pl": {"pol", "pol", "pol", "Latn"},
+	"ps": {"pus", "pus", "pus", "Arab"},
+	"pt": {"por", "por", "por", "Latn"},
+	"ro": {"ron", "rum", "ron", "Latn"},
+	"ru": {"rus", "rus", "rus", "Cyrl"},
+	"sk": {"slk", "slo", "slk", "Latn"},
+	"sq": {"sqi", "alb", "sqi", "Latn"},
+	"sr": {"srp", "srp", "srp", "Cyrl"},
+	"su": {"sun", "sun", "sun", "Latn"},
+	"sv": {"swe", "swe", "swe", "Latn"},
+	"sw": {"swa", "swa", "swa", "Latn"},
+	"ta": {"tam", "tam", "tam", "Taml"},
+	"th": {"tha", "tha", "tha", "Thai"},
+	"tl": {"tgl", "tgl", "tgl", "Latn"},
+	"tr": {"tur", "tur", "tur", "Latn"},
+	"uk": {"ukr", "ukr", "ukr", "Cyrl"},
+	"ur": {"urd", "urd", "urd", "Arab"},
+	"vi": {"vie", "vie", "vie", "Latn"},
+	"yi": {"yid", "yid", "yid", "Hebr"},
+	"zh": {"zho", "chi", "zho", "Hans"},
+}
+
 // LanguageSeeder handles seeding language data
 type LanguageSeeder struct {
 	repo   *pgx.LanguageRepository
@@ -32,7 +100,10 @@ func (ls *LanguageSeeder) Name() string {
 	return "languages"
 }
 
-// Seed seeds language data from CSV file
+// Seed seeds language data from CSV file. Columns are located by header name: code, name and the optional
+// native_name, iso639_1, iso639_2t, iso639_2b, iso639_3, script (ISO 15924) and direction (ltr or rtl); files
+// without a code header are read as code, name, native_name. Missing ISO codes and scripts of common languages
+// are filled in from built-in data, and the direction defaults to the direction of the script.
 func (ls *LanguageSeeder) Seed(ctx context.Context, dataDir string) error {
 	csvFile := filepath.Join(dataDir, "tm_languages.csv")
 	ls.logger.WithField("file", csvFile).Info("Reading languages from CSV file")
@@ -44,6 +115,7 @@ func (ls *LanguageSeeder) Seed(ctx context.Context, dataDir string) error {
 	defer file.Close()
 
 	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
 	records, err := reader.ReadAll()
 	if err != nil {
 		return fmt.Errorf("failed to read CSV records: %w", err)
@@ -53,26 +125,33 @@ func (ls *LanguageSeeder) Seed(ctx context.Context, dataDir string) error {
 		return fmt.Errorf("no records found in CSV file")
 	}
 
-	// Skip header row
-	if len(records) > 1 {
-		records = records[1:]
+	columns := make(map[string]int)
+	for i, column := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	if _, ok := columns["code"]; !ok {
+		columns = map[string]int{"code": 0, "name": 1, "native_name": 2}
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
 	}
 
+	// Skip header row
+	records = records[1:]
+
 	ls.logger.WithField("count", len(records)).Info("Processing language records")
 
 	successCount := 0
 	errorCount := 0
 
 	for i, record := range records {
-		if len(record) < 3 {
-			ls.logger.WithField("row", i+2).Warn("Skipping row with insufficient columns")
-			errorCount++
-			continue
-		}
-
-		code := strings.TrimSpace(record[0])
-		name := strings.TrimSpace(record[1])
-		_ = strings.TrimSpace(record[2]) // native name - not used in current entity
+		code := value(record, "code")
+		name := value(record, "name")
 
 		if code == "" || name == "" {
 			ls.logger.WithField("row", i+2).Warn("Skipping row with empty code or name")
@@ -80,8 +159,23 @@ func (ls *LanguageSeeder) Seed(ctx context.Context, dataDir string) error {
 			continue
 		}
 
-		// Create language entity
 		language := entities.NewLanguage(name, code)
+		language.SetNativeName(value(record, "native_name"))
+		setLanguageCodes(language, value(record, "iso639_1"), value(record, "iso639_2t"), value(record, "iso639_2b"),
+			value(record, "iso639_3"), value(record, "script"))
+		language.SetDirection(value(record, "direction"))
+
+		fields := map[string]interface{}{
+			"row":  i + 2,
+			"code": code,
+			"name": name,
+		}
+
+		if !language.IsValid() {
+			ls.logger.WithFields(fields).Warn("Skipping invalid language")
+			errorCount++
+			continue
+		}
 
 		// Check if language already exists
 		existing, getErr := ls.repo.GetByCode(ctx, code)
@@ -89,24 +183,17 @@ func (ls *LanguageSeeder) Seed(ctx context.Context, dataDir string) error {
 			// Language doesn't exist, create new one
 			err = ls.repo.Create(ctx, language)
 			if err != nil {
-				ls.logger.WithError(err).WithFields(map[string]interface{}{
-					"row":  i + 2,
-					"code": code,
-					"name": name,
-				}).Warn("Failed to create language")
+				ls.logger.WithError(err).WithFields(fields).Warn("Failed to create language")
 				errorCount++
 				continue
 			}
 		} else {
 			// Language exists, update it
-			existing.SetName(name)
-			updateErr := ls.repo.Update(ctx, existing)
+			language.ID = existing.ID
+			language.IsActive = existing.IsActive
+			updateErr := ls.repo.Update(ctx, language)
 			if updateErr != nil {
-				ls.logger.WithError(updateErr).WithFields(map[string]interface{}{
-					"row":  i + 2,
-					"code": code,
-					"name": name,
-				}).Warn("Failed to update existing language")
+				ls.logger.WithError(updateErr).WithFields(fields).Warn("Failed to update existing language")
 				errorCount++
 				continue
 			}
@@ -140,3 +227,33 @@ func (ls *LanguageSeeder) Clear(ctx context.Context) error {
 	ls.logger.Info("Languages table truncated successfully")
 	return nil
 }
+
+// setLanguageCodes sets the ISO codes and script of a language. A two or three letter code stands for the
+// ISO 639-1 or ISO 639-3 code when those are not given, and the built-in codes of the language fill the gaps.
+func setLanguageCodes(language *entities.Language, iso6391, iso6392T, iso6392B, iso6393, script string) {
+	code := strings.ToLower(language.Code)
+	if iso6391 == "" && len(code) == 2 {
+		iso6391 = code
+	}
+	if iso6393 == "" && len(code) == 3 {
+		iso6393 = code
+	}
+
+	if defaults, ok := defaultLanguageCodes[strings.ToLower(iso6391)]; ok {
+		if iso6392T == "" {
+			iso6392T = defaults.iso6392T
+		}
+		if iso6392B == "" {
+			iso6392B = defaults.iso6392B
+		}
+		if iso6393 == "" {
+			iso6393 = defaults.iso6393
+		}
+		if script == "" {
+			script = defaults.script
+		}
+	}
+
+	language.SetISOCodes(iso6391, iso6392T, iso6392B, iso6393)
+	language.SetScript(script)
+}
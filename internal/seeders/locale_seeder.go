@@ -0,0 +1,272 @@
+package seeders
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/turahe/master-data-rest-api/internal/adapters/secondary/database/pgx"
+	"github.com/turahe/master-data-rest-api/internal/domain/entities"
+	"github.com/turahe/master-data-rest-api/internal/domain/valueobjects"
+	"github.com/turahe/master-data-rest-api/pkg/logger"
+)
+
+// localeRecord is a locale identified by language, country and currency codes as read from the seed file
+type localeRecord struct {
+	tag              string
+	languageCode     string
+	countryCode      string
+	currencyCode     string
+	name             string
+	nativeName       string
+	firstDayOfWeek   int
+	datePattern      string
+	timePattern      string
+	decimalSeparator string
+	groupSeparator   string
+}
+
+// defaultLocales are seeded when no locales file is present. They cover the locales bundled for money
+// formatting, with CLDR short date and time patterns and the separators used for amounts.
+var defaultLocales = []localeRecord{
+	{"en-US", "en", "US", "USD", "English (United States)", "English (United States)", entities.WeekdaySunday, "M/d/yy", "h:mm a", ".", ","},
+	{"en-GB", "en", "GB", "GBP", "English (United Kingdom)", "English (United Kingdom)", entities.WeekdayMonday, "dd/MM/y", "HH:mm", ".", ","},
+	{"en-AU", "en", "AU", "AUD", "English (Australia)", "English (Australia)", entities.WeekdayMonday, "d/M/yy", "h:mm a", ".", ","},
+	{"en-SG", "en", "SG", "SGD", "English (Singapore)", "English (Singapore)", entities.WeekdaySunday, "d/M/yy", "h:mm a", ".", ","},
+	{"en-IN", "en", "IN", "INR", "English (India)", "English (India)", entities.WeekdaySunday, "dd/MM/yy", "h:mm a", ".", ","},
+	{"hi-IN", "hi", "IN", "INR", "Hindi (India)", "हिन्दी (भारत)", entities.WeekdaySunday, "d/M/yy", "h:mm a", ".", ","},
+	{"id-ID", "id", "ID", "IDR", "Indonesian (Indonesia)", "Indonesia (Indonesia)", entities.WeekdaySunday, "dd/MM/yy", "HH.mm", ",", "."},
+	{"ms-MY", "ms", "MY", "MYR", "Malay (Malaysia)", "Melayu (Malaysia)", entities.WeekdayMonday, "d/MM/yy", "h:mm a", ".", ","},
+	{"th-TH", "th", "TH", "THB", "Thai (Thailand)", "ไทย (ไทย)", entities.WeekdaySunday, "d/M/yy", "HH:mm", ".", ","},
+	{"vi-VN", "vi", "VN", "VND", "Vietnamese (Vietnam)", "Tiếng Việt (Việt Nam)", entities.WeekdayMonday, "dd/MM/y", "HH:mm", ",", "."},
+	{"zh-CN", "zh", "CN", "CNY", "Chinese (China)", "中文（中国）", entities.WeekdayMonday, "y/M/d", "HH:mm", ".", ","},
+	{"ja-JP", "ja", "JP", "JPY", "Japanese (Japan)", "日本語 (日本)", entities.WeekdaySunday, "y/MM/dd", "H:mm", ".", ","},
+	{"ko-KR", "ko", "KR", "KRW", "Korean (South Korea)", "한국어(대한민국)", entities.WeekdaySunday, "yy. M. d.", "a h:mm", ".", ","},
+	{"de-DE", "de", "DE", "EUR", "German (Germany)", "Deutsch (Deutschland)", entities.WeekdayMonday, "dd.MM.yy", "HH:mm", ",", "."},
+	{"de-CH", "de", "CH", "CHF", "German (Switzerland)", "Deutsch (Schweiz)", entities.WeekdayMonday, "dd.MM.yy", "HH:mm", ".", "’"},
+	{"fr-FR", "fr", "FR", "EUR", "French (France)", "français (France)", entities.WeekdayMonday, "dd/MM/y", "HH:mm", ",", "\u202f"},
+	{"es-ES", "es", "ES", "EUR", "Spanish (Spain)", "español (España)", entities.WeekdayMonday, "d/M/yy", "H:mm", ",", "."},
+	{"it-IT", "it", "IT", "EUR", "Italian (Italy)", "italiano (Italia)", entities.WeekdayMonday, "dd/MM/yy", "HH:mm", ",", "."},
+	{"nl-NL", "nl", "NL", "EUR", "Dutch (Netherlands)", "Nederlands (Nederland)", entities.WeekdayMonday, "dd-MM-y", "HH:mm", ",", "."},
+	{"pt-BR", "pt", "BR", "BRL", "Portuguese (Brazil)", "português (Brasil)", entities.WeekdaySunday, "dd/MM/y", "HH:mm", ",", "."},
+	{"pt-PT", "pt", "PT", "EUR", "Portuguese (Portugal)", "português (Portugal)", entities.WeekdaySunday, "dd/MM/yy", "HH:mm", ",", "\u00a0"},
+	{"ru-RU", "ru", "RU", "RUB", "Russian (Russia)", "русский (Россия)", entities.WeekdayMonday, "dd.MM.y", "HH:mm", ",", "\u00a0"},
+	{"sv-SE", "sv", "SE", "SEK", "Swedish (Sweden)", "svenska (Sverige)", entities.WeekdayMonday, "y-MM-dd", "HH:mm", ",", "\u00a0"},
+	{"tr-TR", "tr", "TR", "TRY", "Turkish (Türkiye)", "Türkçe (Türkiye)", entities.WeekdayMonday, "d.MM.y", "HH:mm", ",", "."},
+}
+
+// LocaleSeeder handles seeding locales
+type LocaleSeeder struct {
+	repo             *pgx.LocaleRepository
+	languageRepo     *pgx.LanguageRepository
+	geodirectoryRepo *pgx.GeodirectoryRepository
+	currencyRepo     *pgx.CurrencyRepository
+	logger           *logger.Logger
+}
+
+// NewLocaleSeeder creates a new locale seeder
+func NewLocaleSeeder(repo *pgx.LocaleRepository, languageRepo *pgx.LanguageRepository, geodirectoryRepo *pgx.GeodirectoryRepository, currencyRepo *pgx.CurrencyRepository, logger *logger.Logger) *LocaleSeeder {
+	return &LocaleSeeder{
+		repo:             repo,
+		languageRepo:     languageRepo,
+		geodirectoryRepo: geodirectoryRepo,
+		currencyRepo:     currencyRepo,
+		logger:           logger,
+	}
+}
+
+// Name returns the seeder name
+func (ls *LocaleSeeder) Name() string {
+	return "locales"
+}
+
+// Seed seeds locales from the optional tm_locales.csv file, or the built-in locales when that file is absent.
+// Columns are located by header name: tag, language_code, country_code, name and the optional currency_code,
+// native_name, first_day_of_week (1 for Monday to 7 for Sunday), date_pattern, time_pattern, decimal_separator
+// and group_separator. Languages, countries and currencies must be seeded first; rows of unknown languages or
+// countries are skipped and unknown currencies are left empty.
+func (ls *LocaleSeeder) Seed(ctx context.Context, dataDir string) error {
+	csvFile := filepath.Join(dataDir, "tm_locales.csv")
+
+	records := defaultLocales
+	if _, err := os.Stat(csvFile); os.IsNotExist(err) {
+		ls.logger.Info("Locales file not found, seeding built-in locales")
+	} else {
+		ls.logger.WithField("file", csvFile).Info("Starting locales seeding")
+
+		records, err = readLocaleCSV(csvFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	successCount := 0
+	errorCount := 0
+
+	fmt.Printf("🌐 Processing %d locale records...\n", len(records))
+
+	for _, record := range records {
+		fields := map[string]interface{}{
+			"tag":           record.tag,
+			"language_code": record.languageCode,
+			"country_code":  record.countryCode,
+		}
+
+		tag, err := valueobjects.NormalizeLanguageTag(record.tag)
+		if err != nil {
+			ls.logger.WithError(err).WithFields(fields).Warn("Invalid locale tag")
+			errorCount++
+			continue
+		}
+
+		language, err := ls.languageRepo.GetByAnyCode(ctx, record.languageCode)
+		if err != nil {
+			ls.logger.WithError(err).WithFields(fields).Warn("Language of locale not found")
+			errorCount++
+			continue
+		}
+
+		country, err := ls.geodirectoryRepo.GetCountryByCode(ctx, record.countryCode)
+		if err != nil {
+			ls.logger.WithError(err).WithFields(fields).Warn("Country of locale not found")
+			errorCount++
+			continue
+		}
+
+		locale := entities.NewLocale(tag, language.ID, country.ID, record.name)
+		locale.SetDisplayNames(record.name, record.nativeName)
+		if record.firstDayOfWeek != 0 {
+			locale.SetFirstDayOfWeek(record.firstDayOfWeek)
+		}
+		if record.datePattern != "" && record.timePattern != "" {
+			locale.SetPatterns(record.datePattern, record.timePattern)
+		}
+		if record.decimalSeparator != "" && record.groupSeparator != "" {
+			locale.SetSeparators(record.decimalSeparator, record.groupSeparator)
+		}
+
+		if record.currencyCode != "" {
+			currency, err := ls.currencyRepo.GetByCode(ctx, record.currencyCode)
+			if err != nil {
+				ls.logger.WithError(err).WithFields(fields).Warn("Currency of locale not found, seeding without a default currency")
+			} else {
+				locale.SetDefaultCurrency(&currency.ID)
+			}
+		}
+
+		if !locale.IsValid() {
+			ls.logger.WithFields(fields).Warn("Invalid locale")
+			errorCount++
+			continue
+		}
+
+		// Check if locale already exists
+		existing, err := ls.repo.GetByTag(ctx, locale.Tag)
+		if err == nil && existing != nil {
+			locale.ID = existing.ID
+			locale.IsActive = existing.IsActive
+			err = ls.repo.Update(ctx, locale)
+		} else {
+			err = ls.repo.Create(ctx, locale)
+		}
+
+		if err != nil {
+			ls.logger.WithError(err).WithFields(fields).Warn("Failed to save locale")
+			errorCount++
+			continue
+		}
+
+		successCount++
+	}
+
+	ls.logger.WithFields(map[string]interface{}{
+		"total_processed": len(records),
+		"successful":      successCount,
+		"errors":          errorCount,
+	}).Info("Locales seeding completed")
+
+	fmt.Printf("✅ Locales seeding completed: %d successful, %d errors\n", successCount, errorCount)
+	return nil
+}
+
+// Clear removes all locale data
+func (ls *LocaleSeeder) Clear(ctx context.Context) error {
+	ls.logger.Info("Clearing locale data using TRUNCATE")
+
+	if err := ls.repo.Truncate(ctx); err != nil {
+		return fmt.Errorf("failed to truncate locales table: %w", err)
+	}
+
+	ls.logger.Info("Locales table truncated successfully")
+	return nil
+}
+
+// readLocaleCSV reads the locales CSV file. Empty optional columns keep the ISO 8601 defaults of NewLocale.
+func readLocaleCSV(csvFile string) ([]localeRecord, error) {
+	file, err := os.Open(csvFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open locales CSV file: %w", err)
+	}
+	defer file.Close()
+
+	rows, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read locales CSV: %w", err)
+	}
+
+	if len(rows) < 2 {
+		return nil, fmt.Errorf("locales CSV file must contain at least a header and one data row")
+	}
+
+	columns := make(map[string]int)
+	for i, column := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range []string{"tag", "language_code", "country_code", "name"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("locales CSV file is missing the %s column", column)
+		}
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	// Separators such as a space are significant and are not trimmed
+	separator := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return record[i]
+	}
+
+	records := make([]localeRecord, 0, len(rows)-1)
+	for _, row := range rows[1:] { // Skip header
+		firstDayOfWeek, _ := strconv.Atoi(value(row, "first_day_of_week"))
+
+		records = append(records, localeRecord{
+			tag:              value(row, "tag"),
+			languageCode:     strings.ToLower(value(row, "language_code")),
+			countryCode:      strings.ToUpper(value(row, "country_code")),
+			currencyCode:     strings.ToUpper(value(row, "currency_code")),
+			name:             value(row, "name"),
+			nativeName:       value(row, "native_name"),
+			firstDayOfWeek:   firstDayOfWeek,
+			datePattern:      value(row, "date_pattern"),
+			timePattern:      value(row, "time_pattern"),
+			decimalSeparator: separator(row, "decimal_separator"),
+			groupSeparator:   separator(row, "group_separator"),
+		})
+	}
+
+	return records, nil
+}
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS tm_geo_hierarchy_rules;
@@ -0,0 +1,14 @@
+-- Per-country administrative hierarchy rules
+-- Each row allows nodes of child_type to be placed under nodes of parent_type within a country.
+-- Countries without rows fall back to the default hierarchy defined in the application.
+CREATE TABLE IF NOT EXISTS tm_geo_hierarchy_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    country_code VARCHAR(10) NOT NULL,               -- Code of the COUNTRY geodirectory the rule belongs to
+    parent_type geo_type NOT NULL,                   -- Allowed parent type
+    child_type geo_type NOT NULL,                    -- Child type the rule applies to
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_tm_geo_hierarchy_rules UNIQUE (country_code, parent_type, child_type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_country_code_geo_hierarchy_rules ON tm_geo_hierarchy_rules(country_code);
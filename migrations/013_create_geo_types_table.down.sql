@@ -0,0 +1,36 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'geo_type') THEN
+        CREATE TYPE geo_type AS ENUM (
+            'CONTINENT',
+            'SUBCONTINENT',
+            'COUNTRY',
+            'STATE',
+            'PROVINCE',
+            'REGENCY',
+            'CITY',
+            'DISTRICT',
+            'SUBDISTRICT',
+            'VILLAGE'
+        );
+    END IF;
+END
+$$;
+
+DROP INDEX IF EXISTS tm_geodirectories_type_index;
+
+ALTER TABLE tm_geo_hierarchy_rules
+    DROP CONSTRAINT IF EXISTS fk_tm_geo_hierarchy_rules_parent_type,
+    DROP CONSTRAINT IF EXISTS fk_tm_geo_hierarchy_rules_child_type;
+ALTER TABLE tm_geodirectories DROP CONSTRAINT IF EXISTS fk_tm_geodirectories_type;
+
+-- Rows using types registered after the ENUM was dropped cannot be converted back
+DELETE FROM tm_geo_hierarchy_rules
+WHERE parent_type NOT IN (SELECT unnest(enum_range(NULL::geo_type))::text)
+   OR child_type NOT IN (SELECT unnest(enum_range(NULL::geo_type))::text);
+
+ALTER TABLE tm_geo_hierarchy_rules ALTER COLUMN parent_type TYPE geo_type USING parent_type::geo_type;
+ALTER TABLE tm_geo_hierarchy_rules ALTER COLUMN child_type TYPE geo_type USING child_type::geo_type;
+ALTER TABLE tm_geodirectories ALTER COLUMN "type" TYPE geo_type USING "type"::geo_type;
+
+DROP TABLE IF EXISTS tm_geo_types;
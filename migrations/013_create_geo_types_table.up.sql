@@ -0,0 +1,42 @@
+-- Registry of geodirectory types
+-- Replaces the geo_type ENUM so new types (e.g. RW, RT, ISLAND, SPECIAL_REGION) can be added with an INSERT
+CREATE TABLE IF NOT EXISTS tm_geo_types (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(50) NOT NULL UNIQUE,                -- Type code stored on geodirectories (e.g., COUNTRY, CITY)
+    label VARCHAR(255) NOT NULL,                     -- Human readable label
+    default_depth INTEGER NOT NULL DEFAULT 0,        -- Default record depth for nodes of this type
+    hierarchy_rank INTEGER NOT NULL,                 -- Position in the hierarchy (lower is higher up)
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_hierarchy_rank_geo_types ON tm_geo_types(hierarchy_rank);
+
+-- Register the types previously defined by the geo_type ENUM
+INSERT INTO tm_geo_types (code, label, default_depth, hierarchy_rank) VALUES
+    ('CONTINENT', 'Continent', 1, 1),
+    ('SUBCONTINENT', 'Subcontinent', 2, 2),
+    ('COUNTRY', 'Country', 3, 3),
+    ('STATE', 'State', 4, 4),
+    ('PROVINCE', 'Province', 4, 4),
+    ('REGENCY', 'Regency', 5, 5),
+    ('CITY', 'City', 6, 6),
+    ('DISTRICT', 'District', 7, 7),
+    ('SUBDISTRICT', 'Subdistrict', 8, 8),
+    ('VILLAGE', 'Village', 9, 9)
+ON CONFLICT (code) DO NOTHING;
+
+-- Convert ENUM columns to plain codes referencing the registry
+ALTER TABLE tm_geodirectories ALTER COLUMN "type" TYPE VARCHAR(50) USING "type"::text;
+ALTER TABLE tm_geodirectories
+    ADD CONSTRAINT fk_tm_geodirectories_type FOREIGN KEY ("type") REFERENCES tm_geo_types(code) ON UPDATE CASCADE;
+
+ALTER TABLE tm_geo_hierarchy_rules ALTER COLUMN parent_type TYPE VARCHAR(50) USING parent_type::text;
+ALTER TABLE tm_geo_hierarchy_rules ALTER COLUMN child_type TYPE VARCHAR(50) USING child_type::text;
+ALTER TABLE tm_geo_hierarchy_rules
+    ADD CONSTRAINT fk_tm_geo_hierarchy_rules_parent_type FOREIGN KEY (parent_type) REFERENCES tm_geo_types(code) ON UPDATE CASCADE ON DELETE CASCADE,
+    ADD CONSTRAINT fk_tm_geo_hierarchy_rules_child_type FOREIGN KEY (child_type) REFERENCES tm_geo_types(code) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS tm_geodirectories_type_index ON tm_geodirectories ("type");
+
+DROP TYPE IF EXISTS geo_type;
@@ -0,0 +1,29 @@
+DROP INDEX IF EXISTS tm_geodirectories_coordinates_index;
+
+ALTER TABLE tm_geodirectories
+    DROP CONSTRAINT IF EXISTS chk_tm_geodirectories_latitude,
+    DROP CONSTRAINT IF EXISTS chk_tm_geodirectories_longitude;
+
+ALTER TABLE tm_geodirectories ADD COLUMN IF NOT EXISTS latitude_text VARCHAR(255) DEFAULT NULL;
+ALTER TABLE tm_geodirectories ADD COLUMN IF NOT EXISTS longitude_text VARCHAR(255) DEFAULT NULL;
+
+UPDATE tm_geodirectories
+SET latitude_text = TRIM(TRAILING '.' FROM TRIM(TRAILING '0' FROM latitude::TEXT)),
+    longitude_text = TRIM(TRAILING '.' FROM TRIM(TRAILING '0' FROM longitude::TEXT))
+WHERE latitude IS NOT NULL AND longitude IS NOT NULL;
+
+-- Restore the original values of rows that were flagged during the conversion
+UPDATE tm_geodirectories g
+SET latitude_text = i.raw_latitude, longitude_text = i.raw_longitude
+FROM tm_geodirectory_coordinate_issues i
+WHERE i.geodirectory_id = g.id AND g.latitude IS NULL AND g.longitude IS NULL;
+
+ALTER TABLE tm_geodirectories DROP COLUMN latitude;
+ALTER TABLE tm_geodirectories DROP COLUMN longitude;
+ALTER TABLE tm_geodirectories RENAME COLUMN latitude_text TO latitude;
+ALTER TABLE tm_geodirectories RENAME COLUMN longitude_text TO longitude;
+
+CREATE INDEX IF NOT EXISTS tm_geodirectories_longitude_index ON "tm_geodirectories" ("longitude");
+CREATE INDEX IF NOT EXISTS tm_geodirectories_latitude_index ON "tm_geodirectories" ("latitude");
+
+DROP TABLE IF EXISTS tm_geodirectory_coordinate_issues;
@@ -0,0 +1,89 @@
+-- Convert geodirectory coordinates from free-form VARCHAR to validated NUMERIC columns
+-- Rows whose coordinates cannot be parsed or are out of range are flagged in tm_geodirectory_coordinate_issues
+-- and their coordinates are cleared, so they can be reviewed and corrected manually.
+CREATE TABLE IF NOT EXISTS tm_geodirectory_coordinate_issues (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    geodirectory_id UUID NOT NULL,                   -- Geodirectory whose coordinates failed to convert
+    raw_latitude VARCHAR(255) DEFAULT NULL,          -- Original latitude value
+    raw_longitude VARCHAR(255) DEFAULT NULL,         -- Original longitude value
+    reason VARCHAR(255) NOT NULL,                    -- Why the conversion failed
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_geodirectory_id_coordinate_issues ON tm_geodirectory_coordinate_issues(geodirectory_id);
+
+ALTER TABLE tm_geodirectories ADD COLUMN IF NOT EXISTS latitude_numeric NUMERIC(10, 7) DEFAULT NULL;
+ALTER TABLE tm_geodirectories ADD COLUMN IF NOT EXISTS longitude_numeric NUMERIC(10, 7) DEFAULT NULL;
+
+DO $$
+DECLARE
+    rec RECORD;
+    lat_text TEXT;
+    lon_text TEXT;
+    lat_value NUMERIC;
+    lon_value NUMERIC;
+    issue TEXT;
+BEGIN
+    FOR rec IN
+        SELECT id, latitude, longitude
+        FROM tm_geodirectories
+        WHERE NULLIF(TRIM(latitude), '') IS NOT NULL OR NULLIF(TRIM(longitude), '') IS NOT NULL
+    LOOP
+        issue := NULL;
+        lat_text := TRIM(COALESCE(rec.latitude, ''));
+        lon_text := TRIM(COALESCE(rec.longitude, ''));
+
+        -- Accept a comma as decimal separator when the value contains no dot
+        IF POSITION('.' IN lat_text) = 0 AND LENGTH(lat_text) - LENGTH(REPLACE(lat_text, ',', '')) = 1 THEN
+            lat_text := REPLACE(lat_text, ',', '.');
+        END IF;
+        IF POSITION('.' IN lon_text) = 0 AND LENGTH(lon_text) - LENGTH(REPLACE(lon_text, ',', '')) = 1 THEN
+            lon_text := REPLACE(lon_text, ',', '.');
+        END IF;
+
+        IF lat_text = '' OR lon_text = '' THEN
+            issue := 'latitude or longitude is missing';
+        ELSIF lat_text !~ '^[-+]?[0-9]+(\.[0-9]+)?$' THEN
+            issue := 'latitude is not a decimal number';
+        ELSIF lon_text !~ '^[-+]?[0-9]+(\.[0-9]+)?$' THEN
+            issue := 'longitude is not a decimal number';
+        ELSE
+            lat_value := ROUND(lat_text::NUMERIC, 7);
+            lon_value := ROUND(lon_text::NUMERIC, 7);
+
+            IF lat_value NOT BETWEEN -90 AND 90 THEN
+                IF lon_value BETWEEN -90 AND 90 AND lat_value BETWEEN -180 AND 180 THEN
+                    issue := 'latitude out of range, latitude and longitude appear to be swapped';
+                ELSE
+                    issue := 'latitude must be between -90 and 90';
+                END IF;
+            ELSIF lon_value NOT BETWEEN -180 AND 180 THEN
+                issue := 'longitude must be between -180 and 180';
+            END IF;
+        END IF;
+
+        IF issue IS NULL THEN
+            UPDATE tm_geodirectories
+            SET latitude_numeric = lat_value, longitude_numeric = lon_value
+            WHERE id = rec.id;
+        ELSE
+            INSERT INTO tm_geodirectory_coordinate_issues (geodirectory_id, raw_latitude, raw_longitude, reason)
+            VALUES (rec.id, rec.latitude, rec.longitude, issue);
+        END IF;
+    END LOOP;
+END
+$$;
+
+DROP INDEX IF EXISTS tm_geodirectories_longitude_index;
+DROP INDEX IF EXISTS tm_geodirectories_latitude_index;
+
+ALTER TABLE tm_geodirectories DROP COLUMN latitude;
+ALTER TABLE tm_geodirectories DROP COLUMN longitude;
+ALTER TABLE tm_geodirectories RENAME COLUMN latitude_numeric TO latitude;
+ALTER TABLE tm_geodirectories RENAME COLUMN longitude_numeric TO longitude;
+
+ALTER TABLE tm_geodirectories
+    ADD CONSTRAINT chk_tm_geodirectories_latitude CHECK (latitude BETWEEN -90 AND 90),
+    ADD CONSTRAINT chk_tm_geodirectories_longitude CHECK (longitude BETWEEN -180 AND 180);
+
+CREATE INDEX IF NOT EXISTS tm_geodirectories_coordinates_index ON "tm_geodirectories" ("latitude", "longitude");
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_bic_banks;
+DROP INDEX IF EXISTS idx_bic8_banks;
+
+ALTER TABLE tm_banks DROP CONSTRAINT IF EXISTS chk_banks_bic_format;
+ALTER TABLE tm_banks DROP COLUMN IF EXISTS bic;
@@ -0,0 +1,9 @@
+-- Add the optional ISO 9362 BIC (SWIFT code) to banks
+ALTER TABLE tm_banks ADD COLUMN IF NOT EXISTS bic VARCHAR(11) DEFAULT NULL;
+
+ALTER TABLE tm_banks ADD CONSTRAINT chk_banks_bic_format
+    CHECK (bic IS NULL OR bic ~ '^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$');
+
+-- Lookups by the 8-character form match every office of an institution
+CREATE INDEX IF NOT EXISTS idx_bic8_banks ON tm_banks(LEFT(bic, 8));
+CREATE INDEX IF NOT EXISTS idx_bic_banks ON tm_banks(bic);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS tm_iban_formats;
@@ -0,0 +1,16 @@
+-- Country specific IBAN formats (SWIFT IBAN registry), used by IBAN validation
+CREATE TABLE IF NOT EXISTS tm_iban_formats (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    country_code CHAR(2) NOT NULL UNIQUE,           -- ISO 3166-1 alpha-2 country code
+    length SMALLINT NOT NULL,                       -- Total IBAN length including country code and check digits
+    bban_structure VARCHAR(50) NOT NULL,            -- BBAN structure in SWIFT registry notation, e.g. 4!a6!n8!n
+    bank_id_offset SMALLINT NOT NULL DEFAULT 0,     -- Bank identifier position within the BBAN
+    bank_id_length SMALLINT NOT NULL,
+    branch_id_offset SMALLINT NOT NULL DEFAULT 0,   -- Branch identifier position within the BBAN, length 0 when absent
+    branch_id_length SMALLINT NOT NULL DEFAULT 0,
+    is_sepa BOOLEAN NOT NULL DEFAULT FALSE,
+    example VARCHAR(34) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_iban_formats_length CHECK (length BETWEEN 15 AND 34)
+);
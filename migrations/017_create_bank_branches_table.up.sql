@@ -0,0 +1,19 @@
+-- Bank branches, each belonging to a bank and located in a geodirectory node (city, district, ...)
+CREATE TABLE IF NOT EXISTS tm_bank_branches (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    bank_id CHAR(36) NOT NULL REFERENCES tm_banks(id) ON DELETE CASCADE,
+    geodirectory_id UUID DEFAULT NULL REFERENCES tm_geodirectories(id) ON DELETE SET NULL,
+    name VARCHAR(255) NOT NULL,
+    code VARCHAR(50) NOT NULL,                       -- Branch code, unique within the bank
+    address TEXT NOT NULL DEFAULT '',
+    bic VARCHAR(11) DEFAULT NULL,                    -- Optional ISO 9362 BIC of the branch
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_tm_bank_branches_code UNIQUE (bank_id, code),
+    CONSTRAINT chk_bank_branches_bic_format
+        CHECK (bic IS NULL OR bic ~ '^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$')
+);
+
+CREATE INDEX IF NOT EXISTS idx_bank_id_bank_branches ON tm_bank_branches(bank_id);
+CREATE INDEX IF NOT EXISTS idx_geodirectory_id_bank_branches ON tm_bank_branches(geodirectory_id);
+CREATE INDEX IF NOT EXISTS idx_name_bank_branches ON tm_bank_branches(name);
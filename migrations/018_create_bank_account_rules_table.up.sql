@@ -0,0 +1,13 @@
+-- Account number format rules per bank, used to validate account numbers before disbursement
+CREATE TABLE IF NOT EXISTS tm_bank_account_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    bank_id CHAR(36) NOT NULL REFERENCES tm_banks(id) ON DELETE CASCADE,
+    lengths INTEGER[] NOT NULL DEFAULT '{}',          -- Allowed account number lengths, empty for any length
+    digits_only BOOLEAN NOT NULL DEFAULT TRUE,
+    check_digit_algorithm VARCHAR(20) NOT NULL DEFAULT '', -- '', 'luhn' or 'mod11'
+    prefix_patterns TEXT[] NOT NULL DEFAULT '{}',     -- Regular expressions matched against the start of the account number
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_tm_bank_account_rules_bank UNIQUE (bank_id),
+    CONSTRAINT chk_bank_account_rules_algorithm CHECK (check_digit_algorithm IN ('', 'luhn', 'mod11'))
+);
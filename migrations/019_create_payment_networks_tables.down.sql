@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS tm_bank_network_memberships;
+DROP TABLE IF EXISTS tm_payment_networks;
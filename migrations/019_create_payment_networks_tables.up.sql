@@ -0,0 +1,31 @@
+-- Clearing and payment networks banks participate in (RTGS, SKN, BI-FAST, card switches)
+CREATE TABLE IF NOT EXISTS tm_payment_networks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(20) NOT NULL,                       -- rtgs, clearing, instant or card_switch
+    country_code CHAR(2) NOT NULL,
+    operator VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_payment_networks_type CHECK (type IN ('rtgs', 'clearing', 'instant', 'card_switch'))
+);
+
+-- Membership of a bank in a payment network under the network specific member code
+CREATE TABLE IF NOT EXISTS tm_bank_network_memberships (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    bank_id CHAR(36) NOT NULL REFERENCES tm_banks(id) ON DELETE CASCADE,
+    network_code VARCHAR(50) NOT NULL REFERENCES tm_payment_networks(code) ON UPDATE CASCADE ON DELETE CASCADE,
+    member_code VARCHAR(50) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',    -- active, suspended or terminated
+    effective_from DATE DEFAULT NULL,
+    effective_until DATE DEFAULT NULL,               -- Exclusive end date, NULL while the membership is open ended
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_tm_bank_network_memberships UNIQUE (bank_id, network_code),
+    CONSTRAINT chk_bank_network_memberships_status CHECK (status IN ('active', 'suspended', 'terminated')),
+    CONSTRAINT chk_bank_network_memberships_period
+        CHECK (effective_from IS NULL OR effective_until IS NULL OR effective_until > effective_from)
+);
+
+CREATE INDEX IF NOT EXISTS idx_network_member_code_bank_network_memberships ON tm_bank_network_memberships(network_code, member_code);
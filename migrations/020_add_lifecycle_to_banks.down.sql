@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS tm_bank_history;
+
+DROP INDEX IF EXISTS idx_successor_id_banks;
+DROP INDEX IF EXISTS idx_status_banks;
+
+ALTER TABLE tm_banks
+    DROP CONSTRAINT IF EXISTS chk_banks_merged_successor,
+    DROP CONSTRAINT IF EXISTS chk_banks_successor,
+    DROP CONSTRAINT IF EXISTS chk_banks_status,
+    DROP COLUMN IF EXISTS successor_id,
+    DROP COLUMN IF EXISTS effective_until,
+    DROP COLUMN IF EXISTS effective_from,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,32 @@
+-- Bank lifecycle: merged and closed banks are kept with a link to their successor
+ALTER TABLE tm_banks
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active',
+    ADD COLUMN IF NOT EXISTS effective_from DATE DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS effective_until DATE DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS successor_id CHAR(36) DEFAULT NULL REFERENCES tm_banks(id) ON DELETE SET NULL;
+
+ALTER TABLE tm_banks
+    ADD CONSTRAINT chk_banks_status CHECK (status IN ('active', 'merged', 'closed')),
+    ADD CONSTRAINT chk_banks_successor CHECK (successor_id IS NULL OR successor_id <> id),
+    ADD CONSTRAINT chk_banks_merged_successor CHECK (status <> 'merged' OR successor_id IS NOT NULL);
+
+CREATE INDEX IF NOT EXISTS idx_status_banks ON tm_banks(status);
+CREATE INDEX IF NOT EXISTS idx_successor_id_banks ON tm_banks(successor_id);
+
+-- Former identities of banks (renames, code changes, status changes) with their validity period
+CREATE TABLE IF NOT EXISTS tm_bank_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    bank_id CHAR(36) NOT NULL REFERENCES tm_banks(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    alias VARCHAR(255) NOT NULL DEFAULT '',
+    company VARCHAR(255) NOT NULL DEFAULT '',
+    code VARCHAR(50) NOT NULL,
+    bic VARCHAR(11) DEFAULT NULL,
+    status VARCHAR(20) NOT NULL,
+    valid_from TIMESTAMP WITHOUT TIME ZONE DEFAULT NULL,
+    valid_until TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_bank_id_bank_history ON tm_bank_history(bank_id, valid_until);
+CREATE INDEX IF NOT EXISTS idx_code_bank_history ON tm_bank_history(code);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS tm_exchange_rates;
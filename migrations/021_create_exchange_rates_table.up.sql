@@ -0,0 +1,17 @@
+-- Exchange rates: units of the quote currency per one unit of the base currency on a date, by source
+CREATE TABLE IF NOT EXISTS tm_exchange_rates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    base_currency CHAR(3) NOT NULL,
+    quote_currency CHAR(3) NOT NULL,
+    rate NUMERIC(30, 12) NOT NULL,
+    rate_date DATE NOT NULL,
+    source VARCHAR(50) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_exchange_rates_pair_date_source UNIQUE (base_currency, quote_currency, rate_date, source),
+    CONSTRAINT chk_exchange_rates_rate CHECK (rate > 0),
+    CONSTRAINT chk_exchange_rates_pair CHECK (base_currency <> quote_currency)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pair_date_exchange_rates ON tm_exchange_rates(base_currency, quote_currency, rate_date DESC);
+CREATE INDEX IF NOT EXISTS idx_rate_date_exchange_rates ON tm_exchange_rates(rate_date);
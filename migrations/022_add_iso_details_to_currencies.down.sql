@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_withdrawn_at_currencies;
+DROP INDEX IF EXISTS idx_numeric_code_currencies;
+
+ALTER TABLE tm_currencies
+    DROP CONSTRAINT IF EXISTS chk_currencies_replaced_by,
+    DROP CONSTRAINT IF EXISTS chk_currencies_numeric_code,
+    DROP COLUMN IF EXISTS replaced_by,
+    DROP COLUMN IF EXISTS withdrawn_at,
+    DROP COLUMN IF EXISTS numeric_code;
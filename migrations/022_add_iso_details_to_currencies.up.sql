@@ -0,0 +1,12 @@
+-- ISO 4217 numeric codes and withdrawn currencies (list three) with the code of their replacement
+ALTER TABLE tm_currencies
+    ADD COLUMN IF NOT EXISTS numeric_code CHAR(3) DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS withdrawn_at DATE DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS replaced_by VARCHAR(3) DEFAULT NULL;
+
+ALTER TABLE tm_currencies
+    ADD CONSTRAINT chk_currencies_numeric_code CHECK (numeric_code IS NULL OR numeric_code ~ '^[0-9]{3}$'),
+    ADD CONSTRAINT chk_currencies_replaced_by CHECK (replaced_by IS NULL OR (withdrawn_at IS NOT NULL AND replaced_by <> code));
+
+CREATE INDEX IF NOT EXISTS idx_numeric_code_currencies ON tm_currencies(numeric_code);
+CREATE INDEX IF NOT EXISTS idx_withdrawn_at_currencies ON tm_currencies(withdrawn_at);
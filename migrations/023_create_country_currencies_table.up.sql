@@ -0,0 +1,17 @@
+-- Currencies used in each country, with their legal tender status and the period they are used in
+CREATE TABLE IF NOT EXISTS tm_country_currencies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    country_id UUID NOT NULL REFERENCES tm_geodirectories(id) ON DELETE CASCADE,
+    currency_id CHAR(36) NOT NULL REFERENCES tm_currencies(id) ON DELETE CASCADE,
+    is_legal_tender BOOLEAN NOT NULL DEFAULT TRUE,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,       -- The currency prices are normally quoted in
+    effective_from DATE DEFAULT NULL,
+    effective_until DATE DEFAULT NULL,               -- Exclusive end date, NULL while the currency is in use
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_tm_country_currencies UNIQUE (country_id, currency_id),
+    CONSTRAINT chk_country_currencies_period
+        CHECK (effective_from IS NULL OR effective_until IS NULL OR effective_until > effective_from)
+);
+
+CREATE INDEX IF NOT EXISTS idx_currency_id_country_currencies ON tm_country_currencies(currency_id);
@@ -0,0 +1,5 @@
+ALTER TABLE tm_currencies
+    DROP CONSTRAINT IF EXISTS chk_currencies_cash_rounding_mode,
+    DROP CONSTRAINT IF EXISTS chk_currencies_cash_rounding_increment,
+    DROP COLUMN IF EXISTS cash_rounding_mode,
+    DROP COLUMN IF EXISTS cash_rounding_increment;
@@ -0,0 +1,10 @@
+-- Cash rounding: the increment cash payments are rounded to (e.g. 0.05 CHF) and the rounding mode
+ALTER TABLE tm_currencies
+    ADD COLUMN IF NOT EXISTS cash_rounding_increment NUMERIC(20,10) DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS cash_rounding_mode VARCHAR(20) NOT NULL DEFAULT 'half_up';
+
+ALTER TABLE tm_currencies
+    ADD CONSTRAINT chk_currencies_cash_rounding_increment
+        CHECK (cash_rounding_increment IS NULL OR cash_rounding_increment > 0),
+    ADD CONSTRAINT chk_currencies_cash_rounding_mode
+        CHECK (cash_rounding_mode IN ('half_up', 'half_even', 'half_down', 'up', 'down', 'ceiling', 'floor'));
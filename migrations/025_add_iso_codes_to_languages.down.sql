@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_iso639_3_languages;
+DROP INDEX IF EXISTS idx_iso639_2b_languages;
+DROP INDEX IF EXISTS idx_iso639_2t_languages;
+DROP INDEX IF EXISTS idx_iso639_1_languages;
+
+ALTER TABLE tm_languages
+    DROP CONSTRAINT IF EXISTS chk_languages_direction,
+    DROP COLUMN IF EXISTS direction,
+    DROP COLUMN IF EXISTS script,
+    DROP COLUMN IF EXISTS iso639_3,
+    DROP COLUMN IF EXISTS iso639_2b,
+    DROP COLUMN IF EXISTS iso639_2t,
+    DROP COLUMN IF EXISTS iso639_1,
+    DROP COLUMN IF EXISTS native_name;
@@ -0,0 +1,21 @@
+-- ISO 639 codes, native name, default ISO 15924 script and text direction of languages
+ALTER TABLE tm_languages
+    ADD COLUMN IF NOT EXISTS native_name VARCHAR(255) DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS iso639_1 CHAR(2) DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS iso639_2t CHAR(3) DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS iso639_2b CHAR(3) DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS iso639_3 CHAR(3) DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS script CHAR(4) DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS direction VARCHAR(3) NOT NULL DEFAULT 'ltr';
+
+ALTER TABLE tm_languages
+    ADD CONSTRAINT chk_languages_direction CHECK (direction IN ('ltr', 'rtl'));
+
+-- Existing two and three letter codes are ISO 639-1 and ISO 639-3 codes
+UPDATE tm_languages SET iso639_1 = LOWER(code) WHERE iso639_1 IS NULL AND code ~ '^[A-Za-z]{2}$';
+UPDATE tm_languages SET iso639_3 = LOWER(code) WHERE iso639_3 IS NULL AND code ~ '^[A-Za-z]{3}$';
+
+CREATE INDEX IF NOT EXISTS idx_iso639_1_languages ON tm_languages(iso639_1);
+CREATE INDEX IF NOT EXISTS idx_iso639_2t_languages ON tm_languages(iso639_2t);
+CREATE INDEX IF NOT EXISTS idx_iso639_2b_languages ON tm_languages(iso639_2b);
+CREATE INDEX IF NOT EXISTS idx_iso639_3_languages ON tm_languages(iso639_3);
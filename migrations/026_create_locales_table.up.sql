@@ -0,0 +1,25 @@
+-- Locales: a language spoken in a country with its display names, calendar, date, time and number conventions
+CREATE TABLE IF NOT EXISTS tm_locales (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    tag VARCHAR(35) NOT NULL,                        -- BCP 47 language tag, e.g. id-ID
+    language_id CHAR(36) NOT NULL REFERENCES tm_languages(id) ON DELETE CASCADE,
+    country_id UUID NOT NULL REFERENCES tm_geodirectories(id) ON DELETE CASCADE,
+    currency_id CHAR(36) DEFAULT NULL REFERENCES tm_currencies(id) ON DELETE SET NULL,
+    name VARCHAR(255) NOT NULL,
+    native_name VARCHAR(255) DEFAULT NULL,
+    first_day_of_week SMALLINT NOT NULL DEFAULT 1,   -- ISO 8601: 1 is Monday, 7 is Sunday
+    date_pattern VARCHAR(50) NOT NULL,               -- CLDR short date pattern, e.g. dd/MM/yy
+    time_pattern VARCHAR(50) NOT NULL,               -- CLDR short time pattern, e.g. HH.mm
+    decimal_separator VARCHAR(5) NOT NULL,
+    group_separator VARCHAR(5) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITHOUT TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_tm_locales_tag UNIQUE (tag),
+    CONSTRAINT chk_locales_first_day_of_week CHECK (first_day_of_week BETWEEN 1 AND 7),
+    CONSTRAINT chk_locales_separators CHECK (decimal_separator <> group_separator)
+);
+
+CREATE INDEX IF NOT EXISTS idx_language_id_locales ON tm_locales(language_id);
+CREATE INDEX IF NOT EXISTS idx_country_id_locales ON tm_locales(country_id);
+CREATE INDEX IF NOT EXISTS idx_is_active_locales ON tm_locales(is_active);
@@ -0,0 +1,15 @@
+-- Translations of the translatable fields of master data, keyed by entity type, entity ID, field and language code.
+-- Language codes are validated against tm_languages by the application, as tm_languages.code is not unique.
+CREATE TABLE IF NOT EXISTS tm_translations (
+    id CHAR(36) PRIMARY KEY,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    field VARCHAR(50) NOT NULL,
+    language_code VARCHAR(10) NOT NULL,
+    value TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_translations_key UNIQUE (entity_type, entity_id, field, language_code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_translations_language ON tm_translations(entity_type, language_code, entity_id);
@@ -0,0 +1,16 @@
+-- Plaintext keys cannot be recovered from their hashes: the key column is restored with the hashes,
+-- so keys created or migrated since the up migration have to be reissued.
+ALTER TABLE tm_api_keys ADD COLUMN IF NOT EXISTS key VARCHAR(255);
+
+UPDATE tm_api_keys SET key = key_hash WHERE key IS NULL;
+
+ALTER TABLE tm_api_keys
+    ALTER COLUMN key SET NOT NULL,
+    ADD CONSTRAINT tm_api_keys_key_key UNIQUE (key);
+
+CREATE INDEX IF NOT EXISTS idx_tm_api_keys_key ON tm_api_keys(key) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_tm_api_keys_key_hash;
+ALTER TABLE tm_api_keys
+    DROP COLUMN IF EXISTS key_prefix,
+    DROP COLUMN IF EXISTS key_hash;
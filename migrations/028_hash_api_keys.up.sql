@@ -0,0 +1,17 @@
+-- API keys are stored as a SHA-256 hash with a short visible prefix instead of in plaintext.
+-- Existing keys are hashed in place so that they keep working.
+ALTER TABLE tm_api_keys
+    ADD COLUMN IF NOT EXISTS key_hash CHAR(64),
+    ADD COLUMN IF NOT EXISTS key_prefix VARCHAR(16) NOT NULL DEFAULT '';
+
+UPDATE tm_api_keys
+SET key_hash = encode(sha256(convert_to(key, 'UTF8')), 'hex'),
+    key_prefix = left(key, 8)
+WHERE key_hash IS NULL;
+
+ALTER TABLE tm_api_keys ALTER COLUMN key_hash SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tm_api_keys_key_hash ON tm_api_keys(key_hash);
+
+DROP INDEX IF EXISTS idx_tm_api_keys_key;
+ALTER TABLE tm_api_keys DROP COLUMN IF EXISTS key;
@@ -0,0 +1 @@
+ALTER TABLE tm_api_keys DROP COLUMN IF EXISTS scopes;
@@ -0,0 +1,7 @@
+-- Scopes restrict API keys to groups of endpoints, e.g. geo:read, banks:write or admin:api-keys
+ALTER TABLE tm_api_keys ADD COLUMN IF NOT EXISTS scopes TEXT[] NOT NULL DEFAULT '{}';
+
+-- Existing keys keep read access to all master data; write and admin scopes have to be granted explicitly
+UPDATE tm_api_keys
+SET scopes = ARRAY['geo:read', 'banks:read', 'currencies:read', 'languages:read', 'translations:read']
+WHERE scopes = '{}';
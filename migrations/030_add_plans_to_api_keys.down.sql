@@ -0,0 +1,8 @@
+ALTER TABLE tm_api_keys
+    DROP CONSTRAINT IF EXISTS chk_api_keys_quota,
+    DROP CONSTRAINT IF EXISTS chk_api_keys_rate,
+    DROP COLUMN IF EXISTS quota_period,
+    DROP COLUMN IF EXISTS quota,
+    DROP COLUMN IF EXISTS burst,
+    DROP COLUMN IF EXISTS requests_per_minute,
+    DROP COLUMN IF EXISTS plan_name;
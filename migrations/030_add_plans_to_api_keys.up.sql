@@ -0,0 +1,13 @@
+-- Rate limit plans of API keys: requests per minute with a burst, and an optional daily or monthly quota
+ALTER TABLE tm_api_keys
+    ADD COLUMN IF NOT EXISTS plan_name VARCHAR(50) NOT NULL DEFAULT 'standard',
+    ADD COLUMN IF NOT EXISTS requests_per_minute INTEGER NOT NULL DEFAULT 1000,
+    ADD COLUMN IF NOT EXISTS burst INTEGER NOT NULL DEFAULT 100,
+    ADD COLUMN IF NOT EXISTS quota BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS quota_period VARCHAR(10) NOT NULL DEFAULT '';
+
+ALTER TABLE tm_api_keys
+    ADD CONSTRAINT chk_api_keys_rate CHECK (requests_per_minute > 0 AND burst > 0),
+    ADD CONSTRAINT chk_api_keys_quota CHECK (
+        (quota = 0 AND quota_period = '') OR (quota > 0 AND quota_period IN ('day', 'month'))
+    );
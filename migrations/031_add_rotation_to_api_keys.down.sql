@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_tm_api_keys_rotation_deadline;
+DROP INDEX IF EXISTS idx_tm_api_keys_replaced_by_id;
+DROP INDEX IF EXISTS idx_tm_api_keys_identity_id;
+
+ALTER TABLE tm_api_keys
+    DROP COLUMN IF EXISTS rotation_deadline,
+    DROP COLUMN IF EXISTS replaced_by_id,
+    DROP COLUMN IF EXISTS rotated_from_id,
+    DROP COLUMN IF EXISTS identity_id;
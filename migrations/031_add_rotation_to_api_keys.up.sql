@@ -0,0 +1,16 @@
+-- Key rotation: a rotated key is replaced by a successor sharing its identity and stays valid until its
+-- rotation deadline, after which it is deactivated
+ALTER TABLE tm_api_keys
+    ADD COLUMN IF NOT EXISTS identity_id UUID,
+    ADD COLUMN IF NOT EXISTS rotated_from_id UUID REFERENCES tm_api_keys(id),
+    ADD COLUMN IF NOT EXISTS replaced_by_id UUID REFERENCES tm_api_keys(id),
+    ADD COLUMN IF NOT EXISTS rotation_deadline TIMESTAMP WITH TIME ZONE;
+
+-- Existing keys start their own identity
+UPDATE tm_api_keys SET identity_id = id WHERE identity_id IS NULL;
+
+ALTER TABLE tm_api_keys ALTER COLUMN identity_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tm_api_keys_identity_id ON tm_api_keys(identity_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tm_api_keys_replaced_by_id ON tm_api_keys(replaced_by_id) WHERE replaced_by_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_tm_api_keys_rotation_deadline ON tm_api_keys(rotation_deadline) WHERE is_active = true AND rotation_deadline IS NOT NULL;
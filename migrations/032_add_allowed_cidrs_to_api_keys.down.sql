@@ -0,0 +1,2 @@
+ALTER TABLE tm_api_keys
+    DROP COLUMN IF EXISTS allowed_cidrs;
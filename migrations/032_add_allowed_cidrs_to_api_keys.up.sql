@@ -0,0 +1,3 @@
+-- IPv4 and IPv6 ranges an API key may be used from; an empty list allows any address
+ALTER TABLE tm_api_keys
+    ADD COLUMN IF NOT EXISTS allowed_cidrs TEXT[] NOT NULL DEFAULT '{}';
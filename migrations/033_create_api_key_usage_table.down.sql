@@ -0,0 +1 @@
+DROP TABLE IF EXISTS tm_api_key_usage;
@@ -0,0 +1,12 @@
+-- Requests per API key, route and status class, aggregated per hour and per day (UTC)
+CREATE TABLE IF NOT EXISTS tm_api_key_usage (
+    api_key_id UUID NOT NULL REFERENCES tm_api_keys(id),
+    granularity VARCHAR(10) NOT NULL,
+    period_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    route VARCHAR(255) NOT NULL,
+    status_class VARCHAR(3) NOT NULL,
+    requests BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (api_key_id, granularity, period_start, route, status_class),
+    CONSTRAINT chk_api_key_usage_granularity CHECK (granularity IN ('hour', 'day'))
+);
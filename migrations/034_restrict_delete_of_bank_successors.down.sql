@@ -0,0 +1,3 @@
+ALTER TABLE tm_banks
+    DROP CONSTRAINT IF EXISTS tm_banks_successor_id_fkey,
+    ADD CONSTRAINT tm_banks_successor_id_fkey FOREIGN KEY (successor_id) REFERENCES tm_banks(id) ON DELETE SET NULL;
@@ -0,0 +1,5 @@
+-- A merged bank must keep its successor: deleting a bank that succeeds another is rejected instead of
+-- clearing successor_id, which would violate chk_banks_merged_successor
+ALTER TABLE tm_banks
+    DROP CONSTRAINT IF EXISTS tm_banks_successor_id_fkey,
+    ADD CONSTRAINT tm_banks_successor_id_fkey FOREIGN KEY (successor_id) REFERENCES tm_banks(id) ON DELETE RESTRICT;